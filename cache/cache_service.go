@@ -0,0 +1,23 @@
+package cache
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/SomeHowMicroservice/product/common"
+)
+
+type CacheService interface {
+	Get(ctx context.Context, key string, dest any) (bool, error)
+
+	Set(ctx context.Context, key string, value any, ttl time.Duration) error
+
+	Delete(ctx context.Context, keys ...string) error
+}
+
+func InvalidateCategoryCache(ctx context.Context, c CacheService) {
+	if err := c.Delete(ctx, common.CategoryTreeCacheKey); err != nil {
+		log.Printf("xóa cache danh mục thất bại: %v", err)
+	}
+}
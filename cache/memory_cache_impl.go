@@ -0,0 +1,75 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bytedance/sonic"
+)
+
+type memoryEntry struct {
+	data      []byte
+	expiresAt time.Time
+}
+
+type memoryCacheImpl struct {
+	mu      sync.RWMutex
+	entries map[string]memoryEntry
+}
+
+func NewMemoryCache() CacheService {
+	return &memoryCacheImpl{
+		entries: make(map[string]memoryEntry),
+	}
+}
+
+func (c *memoryCacheImpl) Get(ctx context.Context, key string, dest any) (bool, error) {
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+
+	if !ok {
+		return false, nil
+	}
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		c.mu.Lock()
+		delete(c.entries, key)
+		c.mu.Unlock()
+		return false, nil
+	}
+
+	if err := sonic.Unmarshal(entry.data, dest); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (c *memoryCacheImpl) Set(ctx context.Context, key string, value any, ttl time.Duration) error {
+	data, err := sonic.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	entry := memoryEntry{data: data}
+	if ttl > 0 {
+		entry.expiresAt = time.Now().Add(ttl)
+	}
+
+	c.mu.Lock()
+	c.entries[key] = entry
+	c.mu.Unlock()
+
+	return nil
+}
+
+func (c *memoryCacheImpl) Delete(ctx context.Context, keys ...string) error {
+	c.mu.Lock()
+	for _, key := range keys {
+		delete(c.entries, key)
+	}
+	c.mu.Unlock()
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type cachedValue struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func TestMemoryCache(t *testing.T) {
+	ctx := context.Background()
+	value := &cachedValue{Name: "áo thun", Count: 3}
+
+	tests := []struct {
+		name      string
+		ttl       time.Duration
+		wait      time.Duration
+		deleteKey string
+		wantFound bool
+	}{
+		{name: "get sau khi set", ttl: time.Minute, wantFound: true},
+		{name: "không có ttl thì không hết hạn", ttl: 0, wantFound: true},
+		{name: "hết hạn sau ttl", ttl: 10 * time.Millisecond, wait: 30 * time.Millisecond, wantFound: false},
+		{name: "delete key đã set", ttl: time.Minute, deleteKey: "key", wantFound: false},
+		{name: "delete key khác", ttl: time.Minute, deleteKey: "other", wantFound: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewMemoryCache()
+			if err := c.Set(ctx, "key", value, tt.ttl); err != nil {
+				t.Fatalf("Set() error = %v", err)
+			}
+			if tt.wait > 0 {
+				time.Sleep(tt.wait)
+			}
+			if tt.deleteKey != "" {
+				if err := c.Delete(ctx, tt.deleteKey); err != nil {
+					t.Fatalf("Delete() error = %v", err)
+				}
+			}
+
+			var got cachedValue
+			found, err := c.Get(ctx, "key", &got)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if found != tt.wantFound {
+				t.Fatalf("Get() found = %v, want %v", found, tt.wantFound)
+			}
+			if found && got != *value {
+				t.Errorf("Get() = %+v, want %+v", got, *value)
+			}
+		})
+	}
+}
+
+func TestMemoryCacheGetMissingKey(t *testing.T) {
+	var got cachedValue
+	found, err := NewMemoryCache().Get(context.Background(), "missing", &got)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if found {
+		t.Errorf("Get() found = true, want false")
+	}
+}
+
+func TestMemoryCacheDeleteMultipleKeys(t *testing.T) {
+	ctx := context.Background()
+	c := NewMemoryCache()
+	for _, key := range []string{"a", "b", "c"} {
+		if err := c.Set(ctx, key, key, time.Minute); err != nil {
+			t.Fatalf("Set(%q) error = %v", key, err)
+		}
+	}
+
+	if err := c.Delete(ctx, "a", "b"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	tests := []struct {
+		key       string
+		wantFound bool
+	}{
+		{"a", false},
+		{"b", false},
+		{"c", true},
+	}
+	for _, tt := range tests {
+		var got string
+		found, err := c.Get(ctx, tt.key, &got)
+		if err != nil {
+			t.Fatalf("Get(%q) error = %v", tt.key, err)
+		}
+		if found != tt.wantFound {
+			t.Errorf("Get(%q) found = %v, want %v", tt.key, found, tt.wantFound)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/redis/go-redis/v9"
+)
+
+type redisCacheImpl struct {
+	client redis.UniversalClient
+}
+
+func NewRedisCache(client redis.UniversalClient) CacheService {
+	return &redisCacheImpl{client}
+}
+
+func (c *redisCacheImpl) Get(ctx context.Context, key string, dest any) (bool, error) {
+	data, err := c.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err = sonic.Unmarshal(data, dest); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (c *redisCacheImpl) Set(ctx context.Context, key string, value any, ttl time.Duration) error {
+	data, err := sonic.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return c.client.Set(ctx, key, data, ttl).Err()
+}
+
+func (c *redisCacheImpl) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	return c.client.Del(ctx, keys...).Err()
+}
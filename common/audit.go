@@ -0,0 +1,83 @@
+package common
+
+import (
+	"context"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+type auditSnapshotsKey struct{}
+
+type AuditCapture func(ctx context.Context, tx *gorm.DB) (map[string]map[string]any, error)
+
+type AuditSnapshots struct {
+	mu      sync.Mutex
+	capture AuditCapture
+	before  map[string]map[string]any
+	after   map[string]map[string]any
+}
+
+func WithAuditSnapshots(ctx context.Context, capture AuditCapture) (context.Context, *AuditSnapshots) {
+	snapshots := &AuditSnapshots{
+		capture: capture,
+		before:  make(map[string]map[string]any),
+		after:   make(map[string]map[string]any),
+	}
+
+	return context.WithValue(ctx, auditSnapshotsKey{}, snapshots), snapshots
+}
+
+func AuditSnapshotsFromContext(ctx context.Context) *AuditSnapshots {
+	snapshots, _ := ctx.Value(auditSnapshotsKey{}).(*AuditSnapshots)
+	return snapshots
+}
+
+func CaptureAuditBeforeTx(ctx context.Context, tx *gorm.DB) error {
+	snapshots := AuditSnapshotsFromContext(ctx)
+	if snapshots == nil || snapshots.capture == nil {
+		return nil
+	}
+
+	before, err := snapshots.capture(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	snapshots.mu.Lock()
+	defer snapshots.mu.Unlock()
+
+	snapshots.before = before
+	return nil
+}
+
+func (a *AuditSnapshots) Record(entityID string, before, after map[string]string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.before[entityID] = toAuditFields(before)
+	a.after[entityID] = toAuditFields(after)
+}
+
+func (a *AuditSnapshots) Before() map[string]map[string]any {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.before
+}
+
+func (a *AuditSnapshots) After() map[string]map[string]any {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.after
+}
+
+func toAuditFields(fields map[string]string) map[string]any {
+	result := make(map[string]any, len(fields))
+	for field, value := range fields {
+		result[field] = value
+	}
+
+	return result
+}
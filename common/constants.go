@@ -5,4 +5,127 @@ const (
 	DeleteTopic = "product.image.delete"
 	Exchange    = "product.image"
 	UploadedTopic = "product.image.uploaded"
+	PriceDropTopic = "product.wishlist.price_drop"
+	ProductPublishedTopic = "product.status.published"
+	ProductUnpublishedTopic = "product.status.unpublished"
+)
+
+const (
+	ProductTypeSimple = "simple"
+	ProductTypeBundle = "bundle"
+)
+
+const (
+	ProductStatusDraft     = "draft"
+	ProductStatusScheduled = "scheduled"
+	ProductStatusPublished = "published"
+	ProductStatusArchived  = "archived"
+)
+
+const (
+	RelationTypeRelated   = "related"
+	RelationTypeCrossSell = "cross_sell"
+	RelationTypeUpSell    = "up_sell"
+	RelationTypeAccessory = "accessory"
+	RelationTypeSimilar   = "similar"
+)
+
+const (
+	ReviewStatusPending  = "pending"
+	ReviewStatusApproved = "approved"
+	ReviewStatusRejected = "rejected"
+)
+
+const (
+	ModerationStatusPending  = "pending"
+	ModerationStatusApproved = "approved"
+	ModerationStatusRejected = "rejected"
+)
+
+const (
+	RoleAdmin = "admin"
+	RoleStaff = "staff"
+)
+
+const (
+	CategoryTreeCacheKey = "product:categories:tree"
+	ColorsCacheKey       = "product:colors:all"
+	SizesCacheKey        = "product:sizes:all"
+	TagsCacheKey         = "product:tags:all"
+)
+
+const (
+	SlugModeStrict     = "strict"
+	SlugModeAutoSuffix = "auto_suffix"
+)
+
+const (
+	SlugHashLength       = 6
+	MaxSlugNumericSuffix = 99
+	MaxSlugHashAttempts  = 5
+)
+
+var (
+	ProductSlugTarget  = SlugTarget{Table: "products", HistoryTable: "product_slug_history", OwnerColumn: "product_id", MaxLength: 255}
+	CategorySlugTarget = SlugTarget{Table: "categories", HistoryTable: "category_slug_history", OwnerColumn: "category_id", MaxLength: 100}
+	TagSlugTarget      = SlugTarget{Table: "tags", MaxLength: 50}
+	ColorSlugTarget    = SlugTarget{Table: "colors", MaxLength: 20}
+	SizeSlugTarget     = SlugTarget{Table: "sizes", MaxLength: 20}
+)
+
+const (
+	AuditActionCreate            = "create"
+	AuditActionUpdate            = "update"
+	AuditActionDelete            = "delete"
+	AuditActionRestore           = "restore"
+	AuditActionPermanentlyDelete = "permanently_delete"
+	AuditActionModerate          = "moderate"
+)
+
+const (
+	AuditEntityProduct  = "product"
+	AuditEntityCategory = "category"
+	AuditEntityColor    = "color"
+	AuditEntitySize     = "size"
+	AuditEntityTag      = "tag"
+	AuditEntityReview   = "review"
+	AuditEntityQuestion = "question"
+	AuditEntityAnswer   = "answer"
+)
+
+const RequestIDHeader = "x-request-id"
+
+const (
+	AuthorizationHeader   = "authorization"
+	ForwardedUserIDHeader = "x-user-id"
+	UserRolesCacheKey     = "product:auth:roles:%s"
+)
+
+const (
+	DefaultLocale        = "vi"
+	LocaleHeader         = "x-locale"
+	AcceptLanguageHeader = "accept-language"
+)
+
+const (
+	TranslationEntityProduct  = "product"
+	TranslationEntityCategory = "category"
+	TranslationEntityTag      = "tag"
+	TranslationEntityColor    = "color"
+	TranslationEntitySize     = "size"
+)
+
+const (
+	IdempotencyKeyHeader       = "idempotency-key"
+	IdempotencyReplayedHeader  = "idempotency-replayed"
+	IdempotencyStatusPending   = "pending"
+	IdempotencyStatusCompleted = "completed"
+)
+
+const ServiceName = "product"
+
+const (
+	TracingExporterNone   = "none"
+	TracingExporterStdout = "stdout"
+	TracingExporterOTLP   = "otlp"
 )
@@ -0,0 +1,29 @@
+package common
+
+type FieldViolation struct {
+	Field       string
+	Description string
+	Reason      string
+}
+
+type DetailedError struct {
+	Err        error
+	Metadata   map[string]string
+	Violations []*FieldViolation
+}
+
+func NewDetailedError(err error, metadata map[string]string, violations ...*FieldViolation) *DetailedError {
+	return &DetailedError{
+		err,
+		metadata,
+		violations,
+	}
+}
+
+func (e *DetailedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *DetailedError) Unwrap() error {
+	return e.Err
+}
@@ -50,4 +50,91 @@ var (
 	ErrHasUserNotFound = errors.New("có người dùng không tìm thấy")
 
 	ErrUserNotFound = errors.New("không tìm thấy người dùng")
+
+	ErrNotBundleProduct = errors.New("sản phẩm không phải là combo")
+
+	ErrBundleHasVariants = errors.New("sản phẩm combo không được có biến thể riêng")
+
+	ErrInvalidBundleItem = errors.New("thành phần combo không hợp lệ")
+
+	ErrBundleItemsNotAllowed = errors.New("chỉ sản phẩm combo mới có thành phần combo")
+
+	ErrInsufficientStock = errors.New("không đủ tồn kho")
+
+	ErrInsufficientReservedStock = errors.New("số lượng giữ chỗ không đủ để hủy")
+
+	ErrInvalidRelationType = errors.New("loại liên kết sản phẩm không hợp lệ")
+
+	ErrSelfRelation = errors.New("sản phẩm không thể liên kết với chính nó")
+
+	ErrRelationAlreadyExists = errors.New("liên kết sản phẩm đã tồn tại")
+
+	ErrHasRelationNotFound = errors.New("có liên kết sản phẩm không tìm thấy")
+
+	ErrInvalidRating = errors.New("điểm đánh giá phải từ 1 đến 5")
+
+	ErrReviewNotFound = errors.New("không tìm thấy đánh giá sản phẩm")
+
+	ErrReviewAlreadyExists = errors.New("người dùng đã đánh giá sản phẩm này")
+
+	ErrInvalidReviewStatus = errors.New("trạng thái đánh giá không hợp lệ")
+
+	ErrInvalidModerationStatus = errors.New("trạng thái kiểm duyệt không hợp lệ")
+
+	ErrQuestionNotFound = errors.New("không tìm thấy câu hỏi sản phẩm")
+
+	ErrAnswerNotFound = errors.New("không tìm thấy câu trả lời")
+
+	ErrAlreadyUpvoted = errors.New("người dùng đã bình chọn nội dung này")
+
+	ErrWishlistItemNotFound = errors.New("không tìm thấy sản phẩm trong danh sách yêu thích")
+
+	ErrWishlistItemAlreadyExists = errors.New("sản phẩm đã có trong danh sách yêu thích")
+
+	ErrInvalidCategoryMove = errors.New("di chuyển danh mục sản phẩm không hợp lệ")
+
+	ErrCategoryNotChildOfParent = errors.New("danh mục sản phẩm không thuộc danh mục cha này")
+
+	ErrInvalidChildrenOrder = errors.New("danh sách thứ tự danh mục con không hợp lệ")
+
+	ErrInvalidPrimaryCategory = errors.New("danh mục chính phải thuộc danh sách danh mục của sản phẩm")
+
+	ErrInvalidSlugMode = errors.New("chế độ tạo slug không hợp lệ")
+
+	ErrInvalidSlug = errors.New("slug không hợp lệ")
+
+	ErrInvalidSKUTemplate = errors.New("mẫu SKU của sản phẩm sao chép không hợp lệ")
+
+	ErrInvalidProductStatus = errors.New("trạng thái sản phẩm không hợp lệ")
+
+	ErrInvalidProductSchedule = errors.New("lịch đăng bán sản phẩm không hợp lệ")
+
+	ErrProductRevisionNotFound = errors.New("không tìm thấy phiên bản sản phẩm")
+
+	ErrRevisionImagesMissing = errors.New("ảnh của phiên bản sản phẩm đã bị xóa, không thể khôi phục")
+
+	ErrRevisionVariantsMissing = errors.New("biến thể của phiên bản sản phẩm đã bị xóa, không thể khôi phục")
+
+	ErrUnauthenticated = errors.New("yêu cầu chưa được xác thực")
+
+	ErrInvalidToken = errors.New("token không hợp lệ hoặc đã hết hạn")
+
+	ErrPermissionDenied = errors.New("không có quyền thực hiện thao tác này")
+
+	ErrUserServiceUnavailable = errors.New("user service không khả dụng")
+
+	ErrInvalidRequest = errors.New("dữ liệu yêu cầu không hợp lệ")
+
+
+	ErrInvalidTranslation = errors.New("bản dịch không hợp lệ")
+
+
+	ErrIdempotencyKeyReused = errors.New("idempotency key đã được sử dụng với dữ liệu yêu cầu khác")
+
+	ErrIdempotencyRequestInProgress = errors.New("yêu cầu với idempotency key này đang được xử lý")
+
+
+	ErrProductVersionConflict = errors.New("sản phẩm đã được người khác cập nhật, vui lòng tải lại dữ liệu mới nhất")
+
+	ErrCategoryVersionConflict = errors.New("danh mục sản phẩm đã được người khác cập nhật, vui lòng tải lại dữ liệu mới nhất")
 )
\ No newline at end of file
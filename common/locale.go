@@ -0,0 +1,60 @@
+package common
+
+import (
+	"context"
+	"slices"
+	"strings"
+)
+
+type localesKey struct{}
+
+var translatableFields = map[string][]string{
+	TranslationEntityProduct:  {"title", "description"},
+	TranslationEntityCategory: {"name", "description", "meta_title", "meta_description"},
+	TranslationEntityTag:      {"name"},
+	TranslationEntityColor:    {"name"},
+	TranslationEntitySize:     {"name"},
+}
+
+func WithLocales(ctx context.Context, locales []string) context.Context {
+	return context.WithValue(ctx, localesKey{}, locales)
+}
+
+func LocalesFromContext(ctx context.Context) []string {
+	if locales, ok := ctx.Value(localesKey{}).([]string); ok && len(locales) > 0 {
+		return locales
+	}
+
+	return []string{DefaultLocale}
+}
+
+func NormalizeLocale(locale string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(locale)), "_", "-")
+}
+
+func ParseLocales(values []string, fallback string) []string {
+	var locales []string
+	add := func(locale string) {
+		if locale != "" && locale != "*" && !slices.Contains(locales, locale) {
+			locales = append(locales, locale)
+		}
+	}
+
+	for _, value := range values {
+		for _, part := range strings.Split(value, ",") {
+			locale := NormalizeLocale(strings.Split(part, ";")[0])
+			add(locale)
+			if base, _, ok := strings.Cut(locale, "-"); ok {
+				add(base)
+			}
+		}
+	}
+	add(NormalizeLocale(fallback))
+	add(DefaultLocale)
+
+	return locales
+}
+
+func IsTranslatableField(entity, field string) bool {
+	return slices.Contains(translatableFields[entity], field)
+}
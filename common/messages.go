@@ -0,0 +1,79 @@
+package common
+
+var messageCatalogs = map[string]map[string]string{
+	"en": {
+		"SLUG_ALREADY_EXISTS":             "slug already exists",
+		"SKU_CONFLICT":                    "SKU already exists",
+		"COLOR_ALREADY_EXISTS":            "color already exists",
+		"SIZE_ALREADY_EXISTS":             "size already exists",
+		"TAG_ALREADY_EXISTS":              "tag already exists",
+		"RELATION_ALREADY_EXISTS":         "product relation already exists",
+		"REVIEW_ALREADY_EXISTS":           "user has already reviewed this product",
+		"ALREADY_UPVOTED":                 "user has already upvoted this content",
+		"WISHLIST_ITEM_ALREADY_EXISTS":    "product is already in the wishlist",
+		"CATEGORY_NOT_FOUND":              "category not found",
+		"TAG_NOT_FOUND":                   "tag not found",
+		"PRODUCT_NOT_FOUND":               "product not found",
+		"COLOR_NOT_FOUND":                 "color not found",
+		"SIZE_NOT_FOUND":                  "size not found",
+		"IMAGE_NOT_FOUND":                 "product image not found",
+		"VARIANT_NOT_FOUND":               "product variant not found",
+		"INVENTORY_NOT_FOUND":             "variant inventory not found",
+		"USER_NOT_FOUND":                  "user not found",
+		"RELATION_NOT_FOUND":              "product relation not found",
+		"REVIEW_NOT_FOUND":                "review not found",
+		"QUESTION_NOT_FOUND":              "question not found",
+		"ANSWER_NOT_FOUND":                "answer not found",
+		"WISHLIST_ITEM_NOT_FOUND":         "wishlist item not found",
+		"PRODUCT_REVISION_NOT_FOUND":      "product revision not found",
+		"UNSUPPORTED_FILE_TYPE":           "file type is not supported",
+		"NOT_BUNDLE_PRODUCT":              "product is not a bundle",
+		"INVALID_BUNDLE_ITEM":             "invalid bundle item",
+		"BUNDLE_ITEMS_NOT_ALLOWED":        "only bundle products can have bundle items",
+		"INVALID_RELATION_TYPE":           "invalid product relation type",
+		"SELF_RELATION":                   "a product cannot be related to itself",
+		"INVALID_RATING":                  "rating must be between 1 and 5",
+		"INVALID_REVIEW_STATUS":           "invalid review status",
+		"INVALID_MODERATION_STATUS":       "invalid moderation status",
+		"INVALID_CATEGORY_MOVE":           "invalid category move",
+		"CATEGORY_NOT_CHILD_OF_PARENT":    "category is not a child of this parent",
+		"INVALID_CHILDREN_ORDER":          "invalid child category order",
+		"INVALID_PRIMARY_CATEGORY":        "primary category must be one of the product categories",
+		"INVALID_SLUG_MODE":               "invalid slug mode",
+		"INVALID_SLUG":                    "invalid slug",
+		"INVALID_SKU_TEMPLATE":            "invalid SKU template for the duplicated product",
+		"INVALID_PRODUCT_STATUS":          "invalid product status",
+		"INVALID_PRODUCT_SCHEDULE":        "invalid product publishing schedule",
+		"INVALID_REQUEST":                 "invalid request data",
+		"INVALID_TRANSLATION":             "invalid translation",
+		"DUPLICATE_SKU":                   "SKU is duplicated within the request",
+		"DEFAULT_LOCALE_TRANSLATION":      "content is already in the default locale and needs no translation",
+		"UNSUPPORTED_TRANSLATION_FIELD":   "field does not support translation",
+		"BUNDLE_HAS_VARIANTS":             "a bundle product cannot have its own variants",
+		"INSUFFICIENT_STOCK":              "insufficient stock",
+		"INSUFFICIENT_RESERVED_STOCK":     "reserved quantity is not enough to release",
+		"REVISION_IMAGES_MISSING":         "images of this revision were deleted and cannot be restored",
+		"REVISION_VARIANTS_MISSING":       "variants of this revision were deleted and cannot be restored",
+		"VERSION_CONFLICT":                "the resource was modified by someone else, reload the latest version and try again",
+		"IDEMPOTENCY_KEY_TOO_LONG":        "idempotency key must not exceed 255 characters",
+		"IDEMPOTENCY_KEY_REUSED":          "idempotency key was already used with a different request payload",
+		"IDEMPOTENCY_REQUEST_IN_PROGRESS": "a request with this idempotency key is still being processed",
+		"UNAUTHENTICATED":                 "request is not authenticated",
+		"INVALID_TOKEN":                   "token is invalid or expired",
+		"PERMISSION_DENIED":               "permission denied",
+		"USER_SERVICE_UNAVAILABLE":        "user service is unavailable",
+	},
+}
+
+func LocalizedMessage(locales []string, reason string) (string, bool) {
+	for _, locale := range locales {
+		if locale == DefaultLocale {
+			return "", false
+		}
+		if message, ok := messageCatalogs[locale][reason]; ok {
+			return message, true
+		}
+	}
+
+	return "", false
+}
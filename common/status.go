@@ -0,0 +1,197 @@
+package common
+
+import (
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+const ErrorDomain = "product.somehow"
+
+const ReasonInternal = "INTERNAL"
+
+type ErrorSpec struct {
+	Code   codes.Code
+	Reason string
+	Field  string
+}
+
+var errorSpecs = map[error]ErrorSpec{
+	ErrSlugAlreadyExists:            {codes.AlreadyExists, "SLUG_ALREADY_EXISTS", "slug"},
+	ErrSKUAlreadyExists:             {codes.AlreadyExists, "SKU_CONFLICT", "sku"},
+	ErrHasSKUAlreadyExists:          {codes.AlreadyExists, "SKU_CONFLICT", "sku"},
+	ErrColorAlreadyExists:           {codes.AlreadyExists, "COLOR_ALREADY_EXISTS", "name"},
+	ErrSizeAlreadyExists:            {codes.AlreadyExists, "SIZE_ALREADY_EXISTS", "name"},
+	ErrTagAlreadyExists:             {codes.AlreadyExists, "TAG_ALREADY_EXISTS", "name"},
+	ErrRelationAlreadyExists:        {codes.AlreadyExists, "RELATION_ALREADY_EXISTS", "relations"},
+	ErrReviewAlreadyExists:          {codes.AlreadyExists, "REVIEW_ALREADY_EXISTS", ""},
+	ErrAlreadyUpvoted:               {codes.AlreadyExists, "ALREADY_UPVOTED", ""},
+	ErrWishlistItemAlreadyExists:    {codes.AlreadyExists, "WISHLIST_ITEM_ALREADY_EXISTS", ""},
+	ErrCategoryNotFound:             {codes.NotFound, "CATEGORY_NOT_FOUND", ""},
+	ErrHasCategoryNotFound:          {codes.NotFound, "CATEGORY_NOT_FOUND", ""},
+	ErrTagNotFound:                  {codes.NotFound, "TAG_NOT_FOUND", ""},
+	ErrHasTagNotFound:               {codes.NotFound, "TAG_NOT_FOUND", ""},
+	ErrProductNotFound:              {codes.NotFound, "PRODUCT_NOT_FOUND", ""},
+	ErrHasProductNotFound:           {codes.NotFound, "PRODUCT_NOT_FOUND", ""},
+	ErrColorNotFound:                {codes.NotFound, "COLOR_NOT_FOUND", ""},
+	ErrHasColorNotFound:             {codes.NotFound, "COLOR_NOT_FOUND", ""},
+	ErrSizeNotFound:                 {codes.NotFound, "SIZE_NOT_FOUND", ""},
+	ErrHasSizeNotFound:              {codes.NotFound, "SIZE_NOT_FOUND", ""},
+	ErrImageNotFound:                {codes.NotFound, "IMAGE_NOT_FOUND", ""},
+	ErrHasImageNotFound:             {codes.NotFound, "IMAGE_NOT_FOUND", ""},
+	ErrVariantNotFound:              {codes.NotFound, "VARIANT_NOT_FOUND", ""},
+	ErrHasVariantNotFound:           {codes.NotFound, "VARIANT_NOT_FOUND", ""},
+	ErrInventoryNotFound:            {codes.NotFound, "INVENTORY_NOT_FOUND", ""},
+	ErrUserNotFound:                 {codes.NotFound, "USER_NOT_FOUND", ""},
+	ErrHasUserNotFound:              {codes.NotFound, "USER_NOT_FOUND", ""},
+	ErrHasRelationNotFound:          {codes.NotFound, "RELATION_NOT_FOUND", ""},
+	ErrReviewNotFound:               {codes.NotFound, "REVIEW_NOT_FOUND", ""},
+	ErrQuestionNotFound:             {codes.NotFound, "QUESTION_NOT_FOUND", ""},
+	ErrAnswerNotFound:               {codes.NotFound, "ANSWER_NOT_FOUND", ""},
+	ErrWishlistItemNotFound:         {codes.NotFound, "WISHLIST_ITEM_NOT_FOUND", ""},
+	ErrProductRevisionNotFound:      {codes.NotFound, "PRODUCT_REVISION_NOT_FOUND", ""},
+	ErrUnSupportedFileType:          {codes.InvalidArgument, "UNSUPPORTED_FILE_TYPE", "file_name"},
+	ErrNotBundleProduct:             {codes.InvalidArgument, "NOT_BUNDLE_PRODUCT", "bundle_id"},
+	ErrInvalidBundleItem:            {codes.InvalidArgument, "INVALID_BUNDLE_ITEM", "items"},
+	ErrBundleItemsNotAllowed:        {codes.InvalidArgument, "BUNDLE_ITEMS_NOT_ALLOWED", "bundle_items"},
+	ErrInvalidRelationType:          {codes.InvalidArgument, "INVALID_RELATION_TYPE", "relations.type"},
+	ErrSelfRelation:                 {codes.InvalidArgument, "SELF_RELATION", "relations.related_product_id"},
+	ErrInvalidRating:                {codes.InvalidArgument, "INVALID_RATING", "rating"},
+	ErrInvalidReviewStatus:          {codes.InvalidArgument, "INVALID_REVIEW_STATUS", "status"},
+	ErrInvalidModerationStatus:      {codes.InvalidArgument, "INVALID_MODERATION_STATUS", "status"},
+	ErrInvalidCategoryMove:          {codes.InvalidArgument, "INVALID_CATEGORY_MOVE", "to_parent_id"},
+	ErrCategoryNotChildOfParent:     {codes.InvalidArgument, "CATEGORY_NOT_CHILD_OF_PARENT", "from_parent_id"},
+	ErrInvalidChildrenOrder:         {codes.InvalidArgument, "INVALID_CHILDREN_ORDER", "child_ids"},
+	ErrInvalidPrimaryCategory:       {codes.InvalidArgument, "INVALID_PRIMARY_CATEGORY", "primary_category_id"},
+	ErrInvalidSlugMode:              {codes.InvalidArgument, "INVALID_SLUG_MODE", "slug_mode"},
+	ErrInvalidSlug:                  {codes.InvalidArgument, "INVALID_SLUG", "slug"},
+	ErrInvalidSKUTemplate:           {codes.InvalidArgument, "INVALID_SKU_TEMPLATE", "sku_template"},
+	ErrInvalidProductStatus:         {codes.InvalidArgument, "INVALID_PRODUCT_STATUS", "status"},
+	ErrInvalidProductSchedule:       {codes.InvalidArgument, "INVALID_PRODUCT_SCHEDULE", "publish_at"},
+	ErrInvalidRequest:               {codes.InvalidArgument, "INVALID_REQUEST", ""},
+	ErrInvalidTranslation:           {codes.InvalidArgument, "INVALID_TRANSLATION", "translations"},
+	ErrBundleHasVariants:            {codes.FailedPrecondition, "BUNDLE_HAS_VARIANTS", ""},
+	ErrInsufficientStock:            {codes.FailedPrecondition, "INSUFFICIENT_STOCK", ""},
+	ErrInsufficientReservedStock:    {codes.FailedPrecondition, "INSUFFICIENT_RESERVED_STOCK", "quantity"},
+	ErrRevisionImagesMissing:        {codes.FailedPrecondition, "REVISION_IMAGES_MISSING", "revision_id"},
+	ErrRevisionVariantsMissing:      {codes.FailedPrecondition, "REVISION_VARIANTS_MISSING", "revision_id"},
+	ErrProductVersionConflict:       {codes.Aborted, "VERSION_CONFLICT", "expected_version"},
+	ErrCategoryVersionConflict:      {codes.Aborted, "VERSION_CONFLICT", "expected_version"},
+	ErrIdempotencyKeyReused:         {codes.FailedPrecondition, "IDEMPOTENCY_KEY_REUSED", ""},
+	ErrIdempotencyRequestInProgress: {codes.Aborted, "IDEMPOTENCY_REQUEST_IN_PROGRESS", ""},
+	ErrUnauthenticated:              {codes.Unauthenticated, "UNAUTHENTICATED", ""},
+	ErrInvalidToken:                 {codes.Unauthenticated, "INVALID_TOKEN", ""},
+	ErrPermissionDenied:             {codes.PermissionDenied, "PERMISSION_DENIED", ""},
+	ErrUserServiceUnavailable:       {codes.Unavailable, "USER_SERVICE_UNAVAILABLE", ""},
+}
+
+func GetErrorSpec(err error) ErrorSpec {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if spec, ok := errorSpecs[e]; ok {
+			return spec
+		}
+	}
+
+	return ErrorSpec{codes.Internal, ReasonInternal, ""}
+}
+
+func ToStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	spec := GetErrorSpec(err)
+	st := status.New(spec.Code, err.Error())
+
+	metadata := map[string]string{}
+	var violations []*FieldViolation
+	var detailedErr *DetailedError
+	if errors.As(err, &detailedErr) {
+		for key, value := range detailedErr.Metadata {
+			metadata[key] = value
+		}
+		violations = detailedErr.Violations
+	}
+	if len(violations) == 0 && spec.Code == codes.InvalidArgument {
+		violations = []*FieldViolation{{spec.Field, err.Error(), spec.Reason}}
+	}
+
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{
+			Reason:   spec.Reason,
+			Domain:   ErrorDomain,
+			Metadata: metadata,
+		},
+	}
+	if len(violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range violations {
+			reason := v.Reason
+			if reason == "" {
+				reason = spec.Reason
+			}
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+				Reason:      reason,
+			})
+		}
+		details = append(details, badRequest)
+	}
+
+	detailedSt, detailErr := st.WithDetails(details...)
+	if detailErr != nil {
+		return st.Err()
+	}
+
+	return detailedSt.Err()
+}
+
+func LocalizeStatusError(err error, locales []string) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() == codes.OK {
+		return err
+	}
+
+	message := st.Message()
+	localized := false
+	details := make([]protoadapt.MessageV1, 0, len(st.Details()))
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if msg, ok := LocalizedMessage(locales, d.Reason); ok {
+				message = msg
+				localized = true
+			}
+			details = append(details, d)
+		case *errdetails.BadRequest:
+			badRequest := proto.Clone(d).(*errdetails.BadRequest)
+			for _, v := range badRequest.FieldViolations {
+				if msg, ok := LocalizedMessage(locales, v.Reason); ok {
+					v.Description = msg
+					localized = true
+				}
+			}
+			details = append(details, badRequest)
+		case protoadapt.MessageV1:
+			details = append(details, d)
+		}
+	}
+	if !localized {
+		return err
+	}
+
+	localizedSt, detailErr := status.New(st.Code(), message).WithDetails(details...)
+	if detailErr != nil {
+		return err
+	}
+
+	return localizedSt.Err()
+}
@@ -1,11 +1,17 @@
 package common
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type Base64UploadRequest struct {
 	ProductID   string `json:"product_id"`
+	CategoryID  string `json:"category_id"`
 	ImageID     string `json:"image_id"`
 	Base64Data  string `json:"base64_data"`
+	SourceURL   string `json:"source_url"`
 	FileName    string `json:"file_name"`
 	Folder      string `json:"folder"`
 	UserID      string `json:"user_id"`
@@ -24,6 +30,23 @@ type ImageUploadedEvent struct {
 	ProductID string `json:"product_id"`
 }
 
+type PriceDropEvent struct {
+	ProductID string   `json:"product_id"`
+	Title     string   `json:"title"`
+	Slug      string   `json:"slug"`
+	OldPrice  float32  `json:"old_price"`
+	NewPrice  float32  `json:"new_price"`
+	UserIDs   []string `json:"user_ids"`
+}
+
+type ProductStatusChangedEvent struct {
+	ProductID string    `json:"product_id"`
+	Title     string    `json:"title"`
+	Slug      string    `json:"slug"`
+	Status    string    `json:"status"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
 type Preload struct {
 	Relation string
 	Scope    func(*gorm.DB) *gorm.DB
@@ -40,6 +63,7 @@ type PaginationQuery struct {
 	Sort       string `json:"sort"`
 	Order      string `json:"order"`
 	IsActive   *bool  `json:"is_active"`
+	Status     string `json:"status"`
 	Search     string `json:"search"`
 	CategoryID string `json:"category_id"`
 }
@@ -52,3 +76,25 @@ type PaginationMeta struct {
 	HasNext    bool  `json:"has_next"`
 	HasPrev    bool  `json:"has_prev"`
 }
+
+type SlugTarget struct {
+	Table        string
+	HistoryTable string
+	OwnerColumn  string
+	MaxLength    int
+}
+
+type AuditLogFilter struct {
+	ActorID    string
+	Action     string
+	EntityType string
+	EntityID   string
+	RequestID  string
+	From       *time.Time
+	To         *time.Time
+}
+
+type InventoryStockLevels struct {
+	OutOfStock int64
+	LowStock   int64
+}
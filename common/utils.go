@@ -10,6 +10,15 @@ func GenerateSlug(str string) string {
 	return slug.Make(str)
 }
 
+func ParseDateTime(str string) (time.Time, error) {
+	parsedTime, err := time.Parse(time.RFC3339, str)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return parsedTime, nil
+}
+
 func ParseDate(str string) (time.Time, error) {
 	parsedDate, err := time.Parse("2006-01-02", str)
 	if err != nil {
@@ -1,11 +1,16 @@
 package config
 
-import "github.com/spf13/viper"
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
 
 type Config struct {
 	App struct {
-		ServerHost string `mapstructure:"server_host"`
-		GRPCPort   int    `mapstructure:"grpc_port"`
+		ServerHost       string `mapstructure:"server_host"`
+		GRPCPort         int    `mapstructure:"grpc_port"`
+		EnableReflection bool   `mapstructure:"enable_reflection"`
 	} `mapstructure:"app"`
 
 	Services struct {
@@ -34,6 +39,50 @@ type Config struct {
 		URLEndpoint string `mapstructure:"url_endpoint"`
 		Folder      string `mapstructure:"folder"`
 	} `mapstructure:"imagekit"`
+
+	Cache struct {
+		RedisAddr     string        `mapstructure:"redis_addr"`
+		RedisPassword string        `mapstructure:"redis_password"`
+		RedisDB       int           `mapstructure:"redis_db"`
+		TTL           time.Duration `mapstructure:"ttl"`
+	} `mapstructure:"cache"`
+
+	Scheduler struct {
+		Interval time.Duration `mapstructure:"interval"`
+	} `mapstructure:"scheduler"`
+
+	Auth struct {
+		JWTSecret              string        `mapstructure:"jwt_secret"`
+		JWTIssuer              string        `mapstructure:"jwt_issuer"`
+		TrustForwardedIdentity bool          `mapstructure:"trust_forwarded_identity"`
+		RoleCacheTTL           time.Duration `mapstructure:"role_cache_ttl"`
+	} `mapstructure:"auth"`
+
+	Locale struct {
+		Fallback string `mapstructure:"fallback"`
+	} `mapstructure:"locale"`
+
+	Idempotency struct {
+		TTL             time.Duration `mapstructure:"ttl"`
+		Lease           time.Duration `mapstructure:"lease"`
+		CleanupInterval time.Duration `mapstructure:"cleanup_interval"`
+	} `mapstructure:"idempotency"`
+
+	Health struct {
+		Interval time.Duration `mapstructure:"interval"`
+		Timeout  time.Duration `mapstructure:"timeout"`
+	} `mapstructure:"health"`
+
+	Metrics struct {
+		Port int `mapstructure:"port"`
+	} `mapstructure:"metrics"`
+
+	Tracing struct {
+		Exporter    string  `mapstructure:"exporter"`
+		Endpoint    string  `mapstructure:"endpoint"`
+		Insecure    bool    `mapstructure:"insecure"`
+		SampleRatio float64 `mapstructure:"sample_ratio"`
+	} `mapstructure:"tracing"`
 }
 
 func LoadConfig() (*Config, error) {
@@ -1,18 +1,29 @@
 package container
 
 import (
+	"github.com/SomeHowMicroservice/product/cache"
 	"github.com/SomeHowMicroservice/product/config"
 	"github.com/SomeHowMicroservice/product/handler"
 	"github.com/SomeHowMicroservice/product/imagekit"
 	userpb "github.com/SomeHowMicroservice/product/protobuf/user"
+	answerRepo "github.com/SomeHowMicroservice/product/repository/answer"
+	auditRepo "github.com/SomeHowMicroservice/product/repository/audit"
+	bundleRepo "github.com/SomeHowMicroservice/product/repository/bundle"
 	categoryRepo "github.com/SomeHowMicroservice/product/repository/category"
 	colorRepo "github.com/SomeHowMicroservice/product/repository/color"
 	imageRepo "github.com/SomeHowMicroservice/product/repository/image"
 	inventoryRepo "github.com/SomeHowMicroservice/product/repository/inventory"
 	productRepo "github.com/SomeHowMicroservice/product/repository/product"
+	questionRepo "github.com/SomeHowMicroservice/product/repository/question"
+	relationRepo "github.com/SomeHowMicroservice/product/repository/relation"
+	reviewRepo "github.com/SomeHowMicroservice/product/repository/review"
+	revisionRepo "github.com/SomeHowMicroservice/product/repository/revision"
 	sizeRepo "github.com/SomeHowMicroservice/product/repository/size"
+	slugRepo "github.com/SomeHowMicroservice/product/repository/slug"
+	slugHistoryRepo "github.com/SomeHowMicroservice/product/repository/slughistory"
 	tagRepo "github.com/SomeHowMicroservice/product/repository/tag"
 	variantRepo "github.com/SomeHowMicroservice/product/repository/variant"
+	wishlistRepo "github.com/SomeHowMicroservice/product/repository/wishlist"
 	"github.com/SomeHowMicroservice/product/service"
 	"github.com/ThreeDotsLabs/watermill/message"
 	"google.golang.org/grpc"
@@ -20,12 +31,14 @@ import (
 )
 
 type Container struct {
-	GRPCHandler *handler.GRPCHandler
-	ImageRepo   imageRepo.ImageRepository
-	ImageKit    imagekit.ImageKitService
+	GRPCHandler  *handler.GRPCHandler
+	ImageRepo    imageRepo.ImageRepository
+	ImageKit     imagekit.ImageKitService
+	CategoryRepo categoryRepo.CategoryRepository
+	ProductRepo  productRepo.ProductRepository
 }
 
-func NewContainer(cfg *config.Config, db *gorm.DB, publisher message.Publisher, grpcServer *grpc.Server, userClient userpb.UserServiceClient) *Container {
+func NewContainer(cfg *config.Config, db *gorm.DB, publisher message.Publisher, grpcServer *grpc.Server, userClient userpb.UserServiceClient, cache cache.CacheService, auditRepo auditRepo.AuditRepository) *Container {
 	imageKit := imagekit.NewImageKitService(cfg)
 	categoryRepo := categoryRepo.NewCategoryRepository(db)
 	productRepo := productRepo.NewProductRepository(db)
@@ -35,11 +48,22 @@ func NewContainer(cfg *config.Config, db *gorm.DB, publisher message.Publisher,
 	variantRepo := variantRepo.NewVariantRepository(db)
 	inventoryRepo := inventoryRepo.NewInventoryRepository(db)
 	imageRepo := imageRepo.NewImageRepository(db)
-	svc := service.NewProductService(cfg, db, userClient, publisher, categoryRepo, productRepo, tagRepo, colorRepo, sizeRepo, variantRepo, inventoryRepo, imageRepo)
+	bundleRepo := bundleRepo.NewBundleRepository(db)
+	relationRepo := relationRepo.NewRelationRepository(db)
+	reviewRepo := reviewRepo.NewReviewRepository(db)
+	questionRepo := questionRepo.NewQuestionRepository(db)
+	answerRepo := answerRepo.NewAnswerRepository(db)
+	wishlistRepo := wishlistRepo.NewWishlistRepository(db)
+	slugHistoryRepo := slugHistoryRepo.NewSlugHistoryRepository(db)
+	slugRepo := slugRepo.NewSlugRepository(db)
+	revisionRepo := revisionRepo.NewRevisionRepository(db)
+	svc := service.NewProductService(cfg, db, userClient, publisher, categoryRepo, productRepo, tagRepo, colorRepo, sizeRepo, variantRepo, inventoryRepo, imageRepo, bundleRepo, relationRepo, reviewRepo, questionRepo, answerRepo, wishlistRepo, slugHistoryRepo, slugRepo, revisionRepo, auditRepo, cache)
 	hdl := handler.NewGRPCHandler(grpcServer, svc)
 	return &Container{
 		hdl,
 		imageRepo,
 		imageKit,
+		categoryRepo,
+		productRepo,
 	}
 }
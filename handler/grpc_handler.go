@@ -2,14 +2,13 @@ package handler
 
 import (
 	"context"
+	"time"
 
 	"github.com/SomeHowMicroservice/product/common"
 	"github.com/SomeHowMicroservice/product/model"
 	productpb "github.com/SomeHowMicroservice/product/protobuf/product"
 	"github.com/SomeHowMicroservice/product/service"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -25,14 +24,7 @@ func NewGRPCHandler(grpcServer *grpc.Server, svc service.ProductService) *GRPCHa
 func (h *GRPCHandler) CreateCategory(ctx context.Context, req *productpb.CreateCategoryRequest) (*productpb.CreatedResponse, error) {
 	categoryID, err := h.svc.CreateCategory(ctx, req)
 	if err != nil {
-		switch err {
-		case common.ErrSlugAlreadyExists:
-			return nil, status.Error(codes.AlreadyExists, err.Error())
-		case common.ErrCategoryNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.CreatedResponse{
@@ -43,7 +35,7 @@ func (h *GRPCHandler) CreateCategory(ctx context.Context, req *productpb.CreateC
 func (h *GRPCHandler) GetCategoryTree(ctx context.Context, req *productpb.GetAllRequest) (*productpb.CategoryTreeResponse, error) {
 	categoryTree, err := h.svc.GetCategoryTree(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, common.ToStatusError(err)
 	}
 
 	return toCategoryTreeResponse(categoryTree), nil
@@ -52,7 +44,7 @@ func (h *GRPCHandler) GetCategoryTree(ctx context.Context, req *productpb.GetAll
 func (h *GRPCHandler) GetCategoriesNoProduct(ctx context.Context, req *productpb.GetAllRequest) (*productpb.BaseCategoriesResponse, error) {
 	categories, err := h.svc.GetCategoriesNoProduct(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.BaseCategoriesResponse{
@@ -61,28 +53,23 @@ func (h *GRPCHandler) GetCategoriesNoProduct(ctx context.Context, req *productpb
 }
 
 func (h *GRPCHandler) GetProductBySlug(ctx context.Context, req *productpb.GetProductBySlugRequest) (*productpb.ProductPublicResponse, error) {
-	product, err := h.svc.GetProductBySlug(ctx, req.Slug)
+	product, redirectTo, err := h.svc.GetProductBySlug(ctx, req.Slug)
 	if err != nil {
-		switch err {
-		case common.ErrProductNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
+	}
+
+	resp := toProductPublicResponse(product)
+	if redirectTo != "" {
+		resp.RedirectTo = &redirectTo
 	}
 
-	return toProductPublicResponse(product), nil
+	return resp, nil
 }
 
 func (h *GRPCHandler) CreateColor(ctx context.Context, req *productpb.CreateColorRequest) (*productpb.CreatedResponse, error) {
 	colorID, err := h.svc.CreateColor(ctx, req)
 	if err != nil {
-		switch err {
-		case common.ErrColorAlreadyExists:
-			return nil, status.Error(codes.AlreadyExists, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.CreatedResponse{
@@ -93,12 +80,7 @@ func (h *GRPCHandler) CreateColor(ctx context.Context, req *productpb.CreateColo
 func (h *GRPCHandler) CreateSize(ctx context.Context, req *productpb.CreateSizeRequest) (*productpb.CreatedResponse, error) {
 	sizeID, err := h.svc.CreateSize(ctx, req)
 	if err != nil {
-		switch err {
-		case common.ErrSizeAlreadyExists:
-			return nil, status.Error(codes.AlreadyExists, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.CreatedResponse{
@@ -109,12 +91,7 @@ func (h *GRPCHandler) CreateSize(ctx context.Context, req *productpb.CreateSizeR
 func (h *GRPCHandler) GetProductsByCategory(ctx context.Context, req *productpb.GetProductsByCategoryRequest) (*productpb.ProductsPublicResponse, error) {
 	products, err := h.svc.GetProductsByCategory(ctx, req.Slug)
 	if err != nil {
-		switch err {
-		case common.ErrCategoryNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return toProductsPublicResponse(products), nil
@@ -123,12 +100,7 @@ func (h *GRPCHandler) GetProductsByCategory(ctx context.Context, req *productpb.
 func (h *GRPCHandler) CreateTag(ctx context.Context, req *productpb.CreateTagRequest) (*productpb.CreatedResponse, error) {
 	tagID, err := h.svc.CreateTag(ctx, req)
 	if err != nil {
-		switch err {
-		case common.ErrTagAlreadyExists:
-			return nil, status.Error(codes.AlreadyExists, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.CreatedResponse{
@@ -139,12 +111,7 @@ func (h *GRPCHandler) CreateTag(ctx context.Context, req *productpb.CreateTagReq
 func (h *GRPCHandler) GetAllCategoriesAdmin(ctx context.Context, req *productpb.GetAllRequest) (*productpb.BaseCategoriesResponse, error) {
 	categories, err := h.svc.GetAllCategories(ctx)
 	if err != nil {
-		switch err {
-		case common.ErrHasUserNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.BaseCategoriesResponse{
@@ -155,12 +122,7 @@ func (h *GRPCHandler) GetAllCategoriesAdmin(ctx context.Context, req *productpb.
 func (h *GRPCHandler) GetCategoryById(ctx context.Context, req *productpb.GetOneRequest) (*productpb.CategoryAdminDetailsResponse, error) {
 	convertedCategory, err := h.svc.GetCategoryByID(ctx, req.Id)
 	if err != nil {
-		switch err {
-		case common.ErrUserNotFound, common.ErrCategoryNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return convertedCategory, nil
@@ -169,12 +131,7 @@ func (h *GRPCHandler) GetCategoryById(ctx context.Context, req *productpb.GetOne
 func (h *GRPCHandler) UpdateCategory(ctx context.Context, req *productpb.UpdateCategoryRequest) (*productpb.CategoryAdminDetailsResponse, error) {
 	convertedCategory, err := h.svc.UpdateCategory(ctx, req)
 	if err != nil {
-		switch err {
-		case common.ErrUserNotFound, common.ErrHasCategoryNotFound, common.ErrCategoryNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return convertedCategory, nil
@@ -183,12 +140,7 @@ func (h *GRPCHandler) UpdateCategory(ctx context.Context, req *productpb.UpdateC
 func (h *GRPCHandler) GetAllColorsAdmin(ctx context.Context, req *productpb.GetAllRequest) (*productpb.ColorsAdminResponse, error) {
 	convertedColors, err := h.svc.GetAllColorsAdmin(ctx)
 	if err != nil {
-		switch err {
-		case common.ErrHasUserNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return convertedColors, nil
@@ -197,7 +149,7 @@ func (h *GRPCHandler) GetAllColorsAdmin(ctx context.Context, req *productpb.GetA
 func (h *GRPCHandler) GetAllColors(ctx context.Context, req *productpb.GetAllRequest) (*productpb.ColorsPublicResponse, error) {
 	colors, err := h.svc.GetAllColors(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, common.ToStatusError(err)
 	}
 
 	return toColorsPublicResponse(colors), nil
@@ -206,12 +158,7 @@ func (h *GRPCHandler) GetAllColors(ctx context.Context, req *productpb.GetAllReq
 func (h *GRPCHandler) GetAllSizesAdmin(ctx context.Context, req *productpb.GetAllRequest) (*productpb.SizesAdminResponse, error) {
 	convertedSizes, err := h.svc.GetAllSizesAdmin(ctx)
 	if err != nil {
-		switch err {
-		case common.ErrHasUserNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return convertedSizes, nil
@@ -220,7 +167,7 @@ func (h *GRPCHandler) GetAllSizesAdmin(ctx context.Context, req *productpb.GetAl
 func (h *GRPCHandler) GetAllSizes(ctx context.Context, req *productpb.GetAllRequest) (*productpb.SizesPublicResponse, error) {
 	sizes, err := h.svc.GetAllSizes(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, common.ToStatusError(err)
 	}
 
 	return toSizesPublicResponse(sizes), nil
@@ -229,12 +176,7 @@ func (h *GRPCHandler) GetAllSizes(ctx context.Context, req *productpb.GetAllRequ
 func (h *GRPCHandler) GetAllTagsAdmin(ctx context.Context, req *productpb.GetAllRequest) (*productpb.TagsAdminResponse, error) {
 	convertedTags, err := h.svc.GetAllTagsAdmin(ctx)
 	if err != nil {
-		switch err {
-		case common.ErrHasUserNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return convertedTags, nil
@@ -243,7 +185,7 @@ func (h *GRPCHandler) GetAllTagsAdmin(ctx context.Context, req *productpb.GetAll
 func (h *GRPCHandler) GetAllTags(ctx context.Context, req *productpb.GetAllRequest) (*productpb.TagsPublicResponse, error) {
 	tags, err := h.svc.GetAllTags(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, common.ToStatusError(err)
 	}
 
 	var baseTags []*productpb.BaseTagResponse
@@ -261,14 +203,7 @@ func (h *GRPCHandler) GetAllTags(ctx context.Context, req *productpb.GetAllReque
 
 func (h *GRPCHandler) UpdateTag(ctx context.Context, req *productpb.UpdateTagRequest) (*productpb.UpdatedResponse, error) {
 	if err := h.svc.UpdateTag(ctx, req); err != nil {
-		switch err {
-		case common.ErrTagNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		case common.ErrTagAlreadyExists:
-			return nil, status.Error(codes.AlreadyExists, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.UpdatedResponse{
@@ -279,14 +214,7 @@ func (h *GRPCHandler) UpdateTag(ctx context.Context, req *productpb.UpdateTagReq
 func (h *GRPCHandler) CreateProduct(ctx context.Context, req *productpb.CreateProductRequest) (*productpb.CreatedResponse, error) {
 	productID, err := h.svc.CreateProduct(ctx, req)
 	if err != nil {
-		switch err {
-		case common.ErrSlugAlreadyExists:
-			return nil, status.Error(codes.AlreadyExists, err.Error())
-		case common.ErrHasCategoryNotFound, common.ErrHasTagNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.CreatedResponse{
@@ -297,12 +225,7 @@ func (h *GRPCHandler) CreateProduct(ctx context.Context, req *productpb.CreatePr
 func (h *GRPCHandler) GetCategoriesNoChild(ctx context.Context, req *productpb.GetAllRequest) (*productpb.BaseCategoriesResponse, error) {
 	categories, err := h.svc.GetCategoriesNoChild(ctx)
 	if err != nil {
-		switch err {
-		case common.ErrHasUserNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.BaseCategoriesResponse{
@@ -313,12 +236,7 @@ func (h *GRPCHandler) GetCategoriesNoChild(ctx context.Context, req *productpb.G
 func (h *GRPCHandler) GetProductById(ctx context.Context, req *productpb.GetOneRequest) (*productpb.ProductAdminDetailsResponse, error) {
 	convertedProduct, err := h.svc.GetProductByID(ctx, req.Id)
 	if err != nil {
-		switch err {
-		case common.ErrProductNotFound, common.ErrUserNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return convertedProduct, nil
@@ -327,7 +245,7 @@ func (h *GRPCHandler) GetProductById(ctx context.Context, req *productpb.GetOneR
 func (h *GRPCHandler) GetImagesByProductId(ctx context.Context, req *productpb.GetByProductId) (*productpb.ImagesResponse, error) {
 	images, err := h.svc.GetImagesByProductID(ctx, req.ProductId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, common.ToStatusError(err)
 	}
 
 	imageResponses := []*productpb.BaseImageResponse{}
@@ -343,7 +261,7 @@ func (h *GRPCHandler) GetImagesByProductId(ctx context.Context, req *productpb.G
 func (h *GRPCHandler) GetAllProductsAdmin(ctx context.Context, req *productpb.GetAllProductsAdminRequest) (*productpb.ProductsAdminResponse, error) {
 	products, meta, err := h.svc.GetAllProductsAdmin(ctx, req)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, common.ToStatusError(err)
 	}
 
 	return toProductsAdminResponse(products, meta), nil
@@ -352,14 +270,7 @@ func (h *GRPCHandler) GetAllProductsAdmin(ctx context.Context, req *productpb.Ge
 func (h *GRPCHandler) UpdateProduct(ctx context.Context, req *productpb.UpdateProductRequest) (*productpb.ProductAdminDetailsResponse, error) {
 	convertedProduct, err := h.svc.UpdateProduct(ctx, req)
 	if err != nil {
-		switch err {
-		case common.ErrSlugAlreadyExists, common.ErrHasSKUAlreadyExists:
-			return nil, status.Error(codes.AlreadyExists, err.Error())
-		case common.ErrUserNotFound, common.ErrHasCategoryNotFound, common.ErrHasTagNotFound, common.ErrHasImageNotFound, common.ErrHasVariantNotFound, common.ErrProductNotFound, common.ErrVariantNotFound, common.ErrImageNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return convertedProduct, nil
@@ -367,12 +278,7 @@ func (h *GRPCHandler) UpdateProduct(ctx context.Context, req *productpb.UpdatePr
 
 func (h *GRPCHandler) DeleteProduct(ctx context.Context, req *productpb.DeleteOneRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.DeleteProduct(ctx, req); err != nil {
-		switch err {
-		case common.ErrProductNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -382,12 +288,7 @@ func (h *GRPCHandler) DeleteProduct(ctx context.Context, req *productpb.DeleteOn
 
 func (h *GRPCHandler) DeleteProducts(ctx context.Context, req *productpb.DeleteManyRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.DeleteProducts(ctx, req); err != nil {
-		switch err {
-		case common.ErrHasProductNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -397,12 +298,7 @@ func (h *GRPCHandler) DeleteProducts(ctx context.Context, req *productpb.DeleteM
 
 func (h *GRPCHandler) PermanentlyDeleteCategory(ctx context.Context, req *productpb.PermanentlyDeleteOneRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.PermanentlyDeleteCategory(ctx, req); err != nil {
-		switch err {
-		case common.ErrCategoryNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -412,12 +308,7 @@ func (h *GRPCHandler) PermanentlyDeleteCategory(ctx context.Context, req *produc
 
 func (h *GRPCHandler) PermanentlyDeleteCategories(ctx context.Context, req *productpb.PermanentlyDeleteManyRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.PermanentlyDeleteCategories(ctx, req); err != nil {
-		switch err {
-		case common.ErrHasCategoryNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -427,14 +318,7 @@ func (h *GRPCHandler) PermanentlyDeleteCategories(ctx context.Context, req *prod
 
 func (h *GRPCHandler) UpdateColor(ctx context.Context, req *productpb.UpdateColorRequest) (*productpb.UpdatedResponse, error) {
 	if err := h.svc.UpdateColor(ctx, req); err != nil {
-		switch err {
-		case common.ErrColorNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		case common.ErrColorAlreadyExists:
-			return nil, status.Error(codes.AlreadyExists, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.UpdatedResponse{
@@ -444,14 +328,7 @@ func (h *GRPCHandler) UpdateColor(ctx context.Context, req *productpb.UpdateColo
 
 func (h *GRPCHandler) UpdateSize(ctx context.Context, req *productpb.UpdateSizeRequest) (*productpb.UpdatedResponse, error) {
 	if err := h.svc.UpdateSize(ctx, req); err != nil {
-		switch err {
-		case common.ErrSizeNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		case common.ErrSizeAlreadyExists:
-			return nil, status.Error(codes.AlreadyExists, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.UpdatedResponse{
@@ -461,12 +338,7 @@ func (h *GRPCHandler) UpdateSize(ctx context.Context, req *productpb.UpdateSizeR
 
 func (h *GRPCHandler) DeleteColor(ctx context.Context, req *productpb.DeleteOneRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.DeleteColor(ctx, req); err != nil {
-		switch err {
-		case common.ErrColorNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -476,12 +348,7 @@ func (h *GRPCHandler) DeleteColor(ctx context.Context, req *productpb.DeleteOneR
 
 func (h *GRPCHandler) DeleteSize(ctx context.Context, req *productpb.DeleteOneRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.DeleteSize(ctx, req); err != nil {
-		switch err {
-		case common.ErrSizeNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -491,12 +358,7 @@ func (h *GRPCHandler) DeleteSize(ctx context.Context, req *productpb.DeleteOneRe
 
 func (h *GRPCHandler) DeleteColors(ctx context.Context, req *productpb.DeleteManyRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.DeleteColors(ctx, req); err != nil {
-		switch err {
-		case common.ErrHasColorNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -506,12 +368,7 @@ func (h *GRPCHandler) DeleteColors(ctx context.Context, req *productpb.DeleteMan
 
 func (h *GRPCHandler) DeleteSizes(ctx context.Context, req *productpb.DeleteManyRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.DeleteSizes(ctx, req); err != nil {
-		switch err {
-		case common.ErrHasSizeNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -522,7 +379,7 @@ func (h *GRPCHandler) DeleteSizes(ctx context.Context, req *productpb.DeleteMany
 func (h *GRPCHandler) GetDeletedProducts(ctx context.Context, req *productpb.GetAllProductsAdminRequest) (*productpb.ProductsAdminResponse, error) {
 	products, meta, err := h.svc.GetDeletedProducts(ctx, req)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, common.ToStatusError(err)
 	}
 
 	return toProductsAdminResponse(products, meta), nil
@@ -531,12 +388,7 @@ func (h *GRPCHandler) GetDeletedProducts(ctx context.Context, req *productpb.Get
 func (h *GRPCHandler) GetDeletedProductById(ctx context.Context, req *productpb.GetOneRequest) (*productpb.ProductAdminDetailsResponse, error) {
 	convertedProduct, err := h.svc.GetDeletedProductByID(ctx, req.Id)
 	if err != nil {
-		switch err {
-		case common.ErrProductNotFound, common.ErrUserNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return convertedProduct, nil
@@ -545,12 +397,7 @@ func (h *GRPCHandler) GetDeletedProductById(ctx context.Context, req *productpb.
 func (h *GRPCHandler) GetDeletedColors(ctx context.Context, req *productpb.GetAllRequest) (*productpb.ColorsAdminResponse, error) {
 	convertedColors, err := h.svc.GetDeletedColors(ctx)
 	if err != nil {
-		switch err {
-		case common.ErrHasUserNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return convertedColors, nil
@@ -559,12 +406,7 @@ func (h *GRPCHandler) GetDeletedColors(ctx context.Context, req *productpb.GetAl
 func (h *GRPCHandler) GetDeletedSizes(ctx context.Context, req *productpb.GetAllRequest) (*productpb.SizesAdminResponse, error) {
 	convertedSizes, err := h.svc.GetDeletedSizes(ctx)
 	if err != nil {
-		switch err {
-		case common.ErrHasUserNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return convertedSizes, nil
@@ -573,12 +415,7 @@ func (h *GRPCHandler) GetDeletedSizes(ctx context.Context, req *productpb.GetAll
 func (h *GRPCHandler) GetDeletedTags(ctx context.Context, req *productpb.GetAllRequest) (*productpb.TagsAdminResponse, error) {
 	convertedTags, err := h.svc.GetDeletedTags(ctx)
 	if err != nil {
-		switch err {
-		case common.ErrHasUserNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return convertedTags, nil
@@ -586,12 +423,7 @@ func (h *GRPCHandler) GetDeletedTags(ctx context.Context, req *productpb.GetAllR
 
 func (h *GRPCHandler) DeleteTag(ctx context.Context, req *productpb.DeleteOneRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.DeleteTag(ctx, req); err != nil {
-		switch err {
-		case common.ErrTagNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -601,12 +433,7 @@ func (h *GRPCHandler) DeleteTag(ctx context.Context, req *productpb.DeleteOneReq
 
 func (h *GRPCHandler) DeleteTags(ctx context.Context, req *productpb.DeleteManyRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.DeleteTags(ctx, req); err != nil {
-		switch err {
-		case common.ErrHasTagNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -616,12 +443,7 @@ func (h *GRPCHandler) DeleteTags(ctx context.Context, req *productpb.DeleteManyR
 
 func (h *GRPCHandler) RestoreProduct(ctx context.Context, req *productpb.RestoreOneRequest) (*productpb.RestoredResponse, error) {
 	if err := h.svc.RestoreProduct(ctx, req); err != nil {
-		switch err {
-		case common.ErrProductNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.RestoredResponse{
@@ -631,12 +453,7 @@ func (h *GRPCHandler) RestoreProduct(ctx context.Context, req *productpb.Restore
 
 func (h *GRPCHandler) RestoreProducts(ctx context.Context, req *productpb.RestoreManyRequest) (*productpb.RestoredResponse, error) {
 	if err := h.svc.RestoreProducts(ctx, req); err != nil {
-		switch err {
-		case common.ErrHasProductNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.RestoredResponse{
@@ -646,12 +463,7 @@ func (h *GRPCHandler) RestoreProducts(ctx context.Context, req *productpb.Restor
 
 func (h *GRPCHandler) RestoreColor(ctx context.Context, req *productpb.RestoreOneRequest) (*productpb.RestoredResponse, error) {
 	if err := h.svc.RestoreColor(ctx, req); err != nil {
-		switch err {
-		case common.ErrColorNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.RestoredResponse{
@@ -661,12 +473,7 @@ func (h *GRPCHandler) RestoreColor(ctx context.Context, req *productpb.RestoreOn
 
 func (h *GRPCHandler) RestoreColors(ctx context.Context, req *productpb.RestoreManyRequest) (*productpb.RestoredResponse, error) {
 	if err := h.svc.RestoreColors(ctx, req); err != nil {
-		switch err {
-		case common.ErrHasColorNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.RestoredResponse{
@@ -676,12 +483,7 @@ func (h *GRPCHandler) RestoreColors(ctx context.Context, req *productpb.RestoreM
 
 func (h *GRPCHandler) RestoreSize(ctx context.Context, req *productpb.RestoreOneRequest) (*productpb.RestoredResponse, error) {
 	if err := h.svc.RestoreSize(ctx, req); err != nil {
-		switch err {
-		case common.ErrSizeNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.RestoredResponse{
@@ -691,12 +493,7 @@ func (h *GRPCHandler) RestoreSize(ctx context.Context, req *productpb.RestoreOne
 
 func (h *GRPCHandler) RestoreSizes(ctx context.Context, req *productpb.RestoreManyRequest) (*productpb.RestoredResponse, error) {
 	if err := h.svc.RestoreSizes(ctx, req); err != nil {
-		switch err {
-		case common.ErrHasSizeNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.RestoredResponse{
@@ -706,12 +503,7 @@ func (h *GRPCHandler) RestoreSizes(ctx context.Context, req *productpb.RestoreMa
 
 func (h *GRPCHandler) RestoreTag(ctx context.Context, req *productpb.RestoreOneRequest) (*productpb.RestoredResponse, error) {
 	if err := h.svc.RestoreTag(ctx, req); err != nil {
-		switch err {
-		case common.ErrTagNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.RestoredResponse{
@@ -721,12 +513,7 @@ func (h *GRPCHandler) RestoreTag(ctx context.Context, req *productpb.RestoreOneR
 
 func (h *GRPCHandler) RestoreTags(ctx context.Context, req *productpb.RestoreManyRequest) (*productpb.RestoredResponse, error) {
 	if err := h.svc.RestoreTags(ctx, req); err != nil {
-		switch err {
-		case common.ErrHasTagNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.RestoredResponse{
@@ -736,12 +523,7 @@ func (h *GRPCHandler) RestoreTags(ctx context.Context, req *productpb.RestoreMan
 
 func (h *GRPCHandler) PermanentlyDeleteProduct(ctx context.Context, req *productpb.PermanentlyDeleteOneRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.PermanentlyDeleteProduct(ctx, req); err != nil {
-		switch err {
-		case common.ErrProductNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -751,12 +533,7 @@ func (h *GRPCHandler) PermanentlyDeleteProduct(ctx context.Context, req *product
 
 func (h *GRPCHandler) PermanentlyDeleteProducts(ctx context.Context, req *productpb.PermanentlyDeleteManyRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.PermanentlyDeleteProducts(ctx, req); err != nil {
-		switch err {
-		case common.ErrHasProductNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -766,12 +543,7 @@ func (h *GRPCHandler) PermanentlyDeleteProducts(ctx context.Context, req *produc
 
 func (h *GRPCHandler) PermanentlyDeleteColor(ctx context.Context, req *productpb.PermanentlyDeleteOneRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.PermanentlyDeleteColor(ctx, req); err != nil {
-		switch err {
-		case common.ErrColorNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -781,12 +553,7 @@ func (h *GRPCHandler) PermanentlyDeleteColor(ctx context.Context, req *productpb
 
 func (h *GRPCHandler) PermanentlyDeleteColors(ctx context.Context, req *productpb.PermanentlyDeleteManyRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.PermanentlyDeleteColors(ctx, req); err != nil {
-		switch err {
-		case common.ErrHasColorNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -796,12 +563,7 @@ func (h *GRPCHandler) PermanentlyDeleteColors(ctx context.Context, req *productp
 
 func (h *GRPCHandler) PermanentlyDeleteSize(ctx context.Context, req *productpb.PermanentlyDeleteOneRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.PermanentlyDeleteSize(ctx, req); err != nil {
-		switch err {
-		case common.ErrSizeNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -811,12 +573,7 @@ func (h *GRPCHandler) PermanentlyDeleteSize(ctx context.Context, req *productpb.
 
 func (h *GRPCHandler) PermanentlyDeleteSizes(ctx context.Context, req *productpb.PermanentlyDeleteManyRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.PermanentlyDeleteSizes(ctx, req); err != nil {
-		switch err {
-		case common.ErrHasSizeNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -826,12 +583,7 @@ func (h *GRPCHandler) PermanentlyDeleteSizes(ctx context.Context, req *productpb
 
 func (h *GRPCHandler) PermanentlyDeleteTag(ctx context.Context, req *productpb.PermanentlyDeleteOneRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.PermanentlyDeleteTag(ctx, req); err != nil {
-		switch err {
-		case common.ErrTagNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -841,12 +593,283 @@ func (h *GRPCHandler) PermanentlyDeleteTag(ctx context.Context, req *productpb.P
 
 func (h *GRPCHandler) PermanentlyDeleteTags(ctx context.Context, req *productpb.PermanentlyDeleteManyRequest) (*productpb.DeletedResponse, error) {
 	if err := h.svc.PermanentlyDeleteTags(ctx, req); err != nil {
-		switch err {
-		case common.ErrHasTagNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.DeletedResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) UpdateBundleItems(ctx context.Context, req *productpb.UpdateBundleItemsRequest) (*productpb.UpdatedResponse, error) {
+	if err := h.svc.UpdateBundleItems(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.UpdatedResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) GetBundleItems(ctx context.Context, req *productpb.GetByProductId) (*productpb.BundleItemsResponse, error) {
+	bundle, err := h.svc.GetBundleItems(ctx, req.ProductId)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	stock := bundle.GetBundleStock()
+
+	return &productpb.BundleItemsResponse{
+		Items:   service.ToBaseBundleItemsResponse(bundle.BundleItems),
+		Stock:   int64(stock),
+		IsStock: proto.Bool(stock > 0),
+	}, nil
+}
+
+func (h *GRPCHandler) SellBundle(ctx context.Context, req *productpb.SellBundleRequest) (*productpb.UpdatedResponse, error) {
+	if err := h.svc.SellBundle(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.UpdatedResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) ReserveBundle(ctx context.Context, req *productpb.ReserveBundleRequest) (*productpb.UpdatedResponse, error) {
+	if err := h.svc.ReserveBundle(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.UpdatedResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) ReleaseBundle(ctx context.Context, req *productpb.ReleaseBundleRequest) (*productpb.UpdatedResponse, error) {
+	if err := h.svc.ReleaseBundle(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.UpdatedResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) AddProductRelations(ctx context.Context, req *productpb.AddProductRelationsRequest) (*productpb.UpdatedResponse, error) {
+	if err := h.svc.AddProductRelations(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.UpdatedResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) RemoveProductRelations(ctx context.Context, req *productpb.RemoveProductRelationsRequest) (*productpb.DeletedResponse, error) {
+	if err := h.svc.RemoveProductRelations(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.DeletedResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) GetProductRelations(ctx context.Context, req *productpb.GetByProductId) (*productpb.ProductRelationsResponse, error) {
+	relations, err := h.svc.GetProductRelations(ctx, req.ProductId)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	relationResponses := make([]*productpb.ProductRelationResponse, 0, len(relations))
+	for _, rel := range relations {
+		relationResponses = append(relationResponses, &productpb.ProductRelationResponse{
+			Id:        rel.ID,
+			Type:      rel.Type,
+			SortOrder: int32(rel.SortOrder),
+			Product:   toBaseProductResponse(rel.RelatedProduct),
+		})
+	}
+
+	return &productpb.ProductRelationsResponse{
+		Relations: relationResponses,
+	}, nil
+}
+
+func (h *GRPCHandler) GetRelatedProducts(ctx context.Context, req *productpb.GetRelatedProductsRequest) (*productpb.RelatedProductsResponse, error) {
+	relations, err := h.svc.GetRelatedProducts(ctx, req)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	productResponses := make([]*productpb.RelatedProductResponse, 0, len(relations))
+	for _, rel := range relations {
+		productResponses = append(productResponses, &productpb.RelatedProductResponse{
+			Type:      rel.Type,
+			Product:   toBaseProductResponse(rel.RelatedProduct),
+			Price:     rel.RelatedProduct.Price,
+			IsSale:    &rel.RelatedProduct.IsSale,
+			SalePrice: rel.RelatedProduct.SalePrice,
+		})
+	}
+
+	return &productpb.RelatedProductsResponse{
+		Products: productResponses,
+	}, nil
+}
+
+func (h *GRPCHandler) CreateReview(ctx context.Context, req *productpb.CreateReviewRequest) (*productpb.CreatedResponse, error) {
+	reviewID, err := h.svc.CreateReview(ctx, req)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.CreatedResponse{
+		Id: reviewID,
+	}, nil
+}
+
+func (h *GRPCHandler) GetProductReviews(ctx context.Context, req *productpb.GetProductReviewsRequest) (*productpb.ReviewsPublicResponse, error) {
+	convertedReviews, err := h.svc.GetProductReviews(ctx, req)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return convertedReviews, nil
+}
+
+func (h *GRPCHandler) GetAllReviewsAdmin(ctx context.Context, req *productpb.GetAllReviewsAdminRequest) (*productpb.ReviewsAdminResponse, error) {
+	convertedReviews, err := h.svc.GetAllReviewsAdmin(ctx, req)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return convertedReviews, nil
+}
+
+func (h *GRPCHandler) ModerateReview(ctx context.Context, req *productpb.ModerateReviewRequest) (*productpb.UpdatedResponse, error) {
+	if err := h.svc.ModerateReview(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.UpdatedResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) CreateQuestion(ctx context.Context, req *productpb.CreateQuestionRequest) (*productpb.CreatedResponse, error) {
+	id, err := h.svc.CreateQuestion(ctx, req)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.CreatedResponse{
+		Id: id,
+	}, nil
+}
+
+func (h *GRPCHandler) CreateAnswer(ctx context.Context, req *productpb.CreateAnswerRequest) (*productpb.CreatedResponse, error) {
+	id, err := h.svc.CreateAnswer(ctx, req)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.CreatedResponse{
+		Id: id,
+	}, nil
+}
+
+func (h *GRPCHandler) UpvoteQuestion(ctx context.Context, req *productpb.UpvoteRequest) (*productpb.UpdatedResponse, error) {
+	if err := h.svc.UpvoteQuestion(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.UpdatedResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) UpvoteAnswer(ctx context.Context, req *productpb.UpvoteRequest) (*productpb.UpdatedResponse, error) {
+	if err := h.svc.UpvoteAnswer(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.UpdatedResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) GetProductQuestions(ctx context.Context, req *productpb.GetProductQuestionsRequest) (*productpb.QuestionsPublicResponse, error) {
+	res, err := h.svc.GetProductQuestions(ctx, req)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return res, nil
+}
+
+func (h *GRPCHandler) GetQuestionAnswers(ctx context.Context, req *productpb.GetQuestionAnswersRequest) (*productpb.AnswersPublicResponse, error) {
+	res, err := h.svc.GetQuestionAnswers(ctx, req)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return res, nil
+}
+
+func (h *GRPCHandler) GetAllQuestionsAdmin(ctx context.Context, req *productpb.GetAllQuestionsAdminRequest) (*productpb.QuestionsAdminResponse, error) {
+	res, err := h.svc.GetAllQuestionsAdmin(ctx, req)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return res, nil
+}
+
+func (h *GRPCHandler) GetAllAnswersAdmin(ctx context.Context, req *productpb.GetAllAnswersAdminRequest) (*productpb.AnswersAdminResponse, error) {
+	res, err := h.svc.GetAllAnswersAdmin(ctx, req)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return res, nil
+}
+
+func (h *GRPCHandler) ModerateQuestion(ctx context.Context, req *productpb.ModerateRequest) (*productpb.UpdatedResponse, error) {
+	if err := h.svc.ModerateQuestion(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.UpdatedResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) ModerateAnswer(ctx context.Context, req *productpb.ModerateRequest) (*productpb.UpdatedResponse, error) {
+	if err := h.svc.ModerateAnswer(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.UpdatedResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) AddToWishlist(ctx context.Context, req *productpb.AddToWishlistRequest) (*productpb.CreatedResponse, error) {
+	wishlistID, err := h.svc.AddToWishlist(ctx, req)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.CreatedResponse{
+		Id: wishlistID,
+	}, nil
+}
+
+func (h *GRPCHandler) RemoveFromWishlist(ctx context.Context, req *productpb.RemoveFromWishlistRequest) (*productpb.DeletedResponse, error) {
+	if err := h.svc.RemoveFromWishlist(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
 	}
 
 	return &productpb.DeletedResponse{
@@ -854,6 +877,175 @@ func (h *GRPCHandler) PermanentlyDeleteTags(ctx context.Context, req *productpb.
 	}, nil
 }
 
+func (h *GRPCHandler) GetWishlist(ctx context.Context, req *productpb.GetWishlistRequest) (*productpb.WishlistResponse, error) {
+	wishlists, err := h.svc.GetWishlist(ctx, req.UserId)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	now := time.Now()
+	itemResponses := make([]*productpb.WishlistItemResponse, 0, len(wishlists))
+	for _, item := range wishlists {
+		itemResponses = append(itemResponses, toWishlistItemResponse(item, now))
+	}
+
+	return &productpb.WishlistResponse{
+		Items: itemResponses,
+	}, nil
+}
+
+func (h *GRPCHandler) DeleteCategory(ctx context.Context, req *productpb.DeleteOneRequest) (*productpb.DeletedResponse, error) {
+	if err := h.svc.DeleteCategory(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.DeletedResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) DeleteCategories(ctx context.Context, req *productpb.DeleteManyRequest) (*productpb.DeletedResponse, error) {
+	if err := h.svc.DeleteCategories(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.DeletedResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) GetDeletedCategories(ctx context.Context, req *productpb.GetAllRequest) (*productpb.CategoriesAdminResponse, error) {
+	convertedCategories, err := h.svc.GetDeletedCategories(ctx)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return convertedCategories, nil
+}
+
+func (h *GRPCHandler) RestoreCategory(ctx context.Context, req *productpb.RestoreOneRequest) (*productpb.RestoredResponse, error) {
+	if err := h.svc.RestoreCategory(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.RestoredResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) RestoreCategories(ctx context.Context, req *productpb.RestoreManyRequest) (*productpb.RestoredResponse, error) {
+	if err := h.svc.RestoreCategories(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.RestoredResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) MoveCategory(ctx context.Context, req *productpb.MoveCategoryRequest) (*productpb.UpdatedResponse, error) {
+	if err := h.svc.MoveCategory(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.UpdatedResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) ReorderCategoryChildren(ctx context.Context, req *productpb.ReorderCategoryChildrenRequest) (*productpb.UpdatedResponse, error) {
+	if err := h.svc.ReorderCategoryChildren(ctx, req); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.UpdatedResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *GRPCHandler) GetCategoryBySlug(ctx context.Context, req *productpb.GetCategoryBySlugRequest) (*productpb.CategoryPublicDetailsResponse, error) {
+	convertedCategory, err := h.svc.GetCategoryBySlug(ctx, req.Slug)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return convertedCategory, nil
+}
+
+func (h *GRPCHandler) DuplicateProduct(ctx context.Context, req *productpb.DuplicateProductRequest) (*productpb.CreatedResponse, error) {
+	productID, err := h.svc.DuplicateProduct(ctx, req)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return &productpb.CreatedResponse{
+		Id: productID,
+	}, nil
+}
+
+func (h *GRPCHandler) GetProductRevisions(ctx context.Context, req *productpb.GetProductRevisionsRequest) (*productpb.ProductRevisionsResponse, error) {
+	convertedRevisions, err := h.svc.GetProductRevisions(ctx, req)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return convertedRevisions, nil
+}
+
+func (h *GRPCHandler) DiffProductRevisions(ctx context.Context, req *productpb.DiffProductRevisionsRequest) (*productpb.ProductRevisionDiffResponse, error) {
+	convertedDiff, err := h.svc.DiffProductRevisions(ctx, req)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return convertedDiff, nil
+}
+
+func (h *GRPCHandler) RollbackProductRevision(ctx context.Context, req *productpb.RollbackProductRevisionRequest) (*productpb.ProductAdminDetailsResponse, error) {
+	convertedProduct, err := h.svc.RollbackProductRevision(ctx, req)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return convertedProduct, nil
+}
+
+func (h *GRPCHandler) GetAuditLogs(ctx context.Context, req *productpb.GetAuditLogsRequest) (*productpb.AuditLogsResponse, error) {
+	convertedLogs, err := h.svc.GetAuditLogs(ctx, req)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	return convertedLogs, nil
+}
+
+func toWishlistItemResponse(item *model.Wishlist, now time.Time) *productpb.WishlistItemResponse {
+	var variant *productpb.BaseVariantResponse
+	stock := item.Product.GetStock()
+	isStock := stock > 0
+	if item.Variant != nil && item.Variant.Inventory != nil && item.Variant.Color != nil && item.Variant.Size != nil {
+		variant = toBaseVariantResponse(item.Variant)
+		stock = item.Variant.Inventory.Stock
+		isStock = item.Variant.Inventory.IsStock
+	}
+
+	price := item.Product.GetEffectivePrice(now)
+	isSale := price < item.Product.Price
+
+	return &productpb.WishlistItemResponse{
+		Id:            item.ID,
+		Product:       toBaseProductResponse(item.Product),
+		Variant:       variant,
+		Price:         price,
+		OriginalPrice: item.Product.Price,
+		IsSale:        &isSale,
+		Stock:         int64(stock),
+		IsStock:       &isStock,
+		IsActive:      &item.Product.IsActive,
+		CreatedAt:     item.CreatedAt.Format(time.RFC3339),
+	}
+}
+
 func toProductsAdminResponse(products []*model.Product, meta *common.PaginationMeta) *productpb.ProductsAdminResponse {
 	var productResponses []*productpb.ProductAdminResponse
 	for _, pro := range products {
@@ -885,6 +1077,7 @@ func toProductAdminResponse(product *model.Product) *productpb.ProductAdminRespo
 		Price:      product.Price,
 		Categories: categories,
 		Thumbnail:  thumbnail,
+		Status:     product.Status,
 	}
 }
 
@@ -925,19 +1118,44 @@ func toProductPublicResponse(product *model.Product) *productpb.ProductPublicRes
 		images[i] = toBaseImageResponse(image)
 	}
 
+	var bundleStock *int64
+	if product.IsBundle() {
+		bundleStock = proto.Int64(int64(product.GetBundleStock()))
+	}
+
+	var primaryCategory *productpb.BaseCategoryResponse
+	if product.PrimaryCategory != nil {
+		primaryCategory = toBaseCategoryResponse(product.PrimaryCategory)
+	}
+
+	breadcrumbs := make([]*productpb.CategoryPathResponse, 0, len(product.Breadcrumbs))
+	for i, path := range product.Breadcrumbs {
+		breadcrumbs = append(breadcrumbs, &productpb.CategoryPathResponse{
+			Categories: toBaseCategoriesResponse(path),
+			IsPrimary:  i == 0 && product.PrimaryCategory != nil,
+		})
+	}
+
 	return &productpb.ProductPublicResponse{
-		Id:          product.ID,
-		Title:       product.Title,
-		Slug:        product.Slug,
-		Description: product.Description,
-		Price:       product.Price,
-		IsSale:      &product.IsSale,
-		SalePrice:   product.SalePrice,
-		StartSale:   startSalePtr,
-		EndSale:     endSalePtr,
-		Categories:  categories,
-		Variants:    variants,
-		Images:      images,
+		Id:              product.ID,
+		Title:           product.Title,
+		Slug:            product.Slug,
+		Description:     product.Description,
+		Price:           product.Price,
+		IsSale:          &product.IsSale,
+		SalePrice:       product.SalePrice,
+		StartSale:       startSalePtr,
+		EndSale:         endSalePtr,
+		Categories:      categories,
+		Variants:        variants,
+		Images:          images,
+		IsBundle:        proto.Bool(product.IsBundle()),
+		BundleItems:     service.ToBaseBundleItemsResponse(product.BundleItems),
+		BundleStock:     bundleStock,
+		AverageRating:   product.AverageRating,
+		ReviewCount:     uint32(product.ReviewCount),
+		PrimaryCategory: primaryCategory,
+		Breadcrumbs:     breadcrumbs,
 	}
 }
 
@@ -1036,6 +1254,27 @@ func toBaseVariantResponse(variant *model.Variant) *productpb.BaseVariantRespons
 	}
 }
 
+func toBaseProductResponse(product *model.Product) *productpb.BaseProductResponse {
+	var thumb *productpb.BaseImageResponse
+	for _, img := range product.Images {
+		if img.IsThumbnail {
+			thumb = &productpb.BaseImageResponse{
+				Id:          img.ID,
+				Url:         img.Url,
+				IsThumbnail: &img.IsThumbnail,
+			}
+			break
+		}
+	}
+
+	return &productpb.BaseProductResponse{
+		Id:    product.ID,
+		Title: product.Title,
+		Slug:  product.Slug,
+		Image: thumb,
+	}
+}
+
 func toBaseImageResponse(image *model.Image) *productpb.BaseImageResponse {
 	return &productpb.BaseImageResponse{
 		Id: image.ID,
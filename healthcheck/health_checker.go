@@ -0,0 +1,139 @@
+package healthcheck
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type CheckFunc func(ctx context.Context) error
+
+type dependencyCheck struct {
+	name     string
+	check    CheckFunc
+	critical bool
+}
+
+type HealthChecker struct {
+	server   *health.Server
+	services []string
+	checks   []*dependencyCheck
+	interval time.Duration
+	timeout  time.Duration
+	mu       sync.Mutex
+	ready    bool
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+func NewHealthChecker(server *health.Server, services []string, interval, timeout time.Duration) *HealthChecker {
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	if timeout <= 0 || timeout > interval {
+		timeout = interval
+	}
+
+	return &HealthChecker{
+		server:   server,
+		services: append([]string{""}, services...),
+		interval: interval,
+		timeout:  timeout,
+		ready:    true,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+func (h *HealthChecker) AddCheck(name string, check CheckFunc, critical bool) {
+	h.checks = append(h.checks, &dependencyCheck{name, check, critical})
+	h.server.SetServingStatus(name, healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+func (h *HealthChecker) Start() {
+	for _, service := range h.services {
+		h.server.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+
+	go func() {
+		defer close(h.done)
+
+		ticker := time.NewTicker(h.interval)
+		defer ticker.Stop()
+
+		h.run()
+		for {
+			select {
+			case <-h.stop:
+				return
+			case <-ticker.C:
+				h.run()
+			}
+		}
+	}()
+}
+
+func (h *HealthChecker) Shutdown() {
+	h.mu.Lock()
+	h.ready = false
+	h.mu.Unlock()
+
+	h.server.Shutdown()
+
+	h.stopOnce.Do(func() {
+		close(h.stop)
+		<-h.done
+	})
+}
+
+func (h *HealthChecker) run() {
+	results := make([]bool, len(h.checks))
+
+	var wg sync.WaitGroup
+	for i, c := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), h.timeout)
+			defer cancel()
+
+			if err := c.check(ctx); err != nil {
+				log.Printf("kiểm tra kết nối %s thất bại: %v", c.name, err)
+				return
+			}
+			results[i] = true
+		}()
+	}
+	wg.Wait()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.ready {
+		return
+	}
+
+	serving := true
+	for i, c := range h.checks {
+		h.server.SetServingStatus(c.name, toServingStatus(results[i]))
+		if c.critical && !results[i] {
+			serving = false
+		}
+	}
+	for _, service := range h.services {
+		h.server.SetServingStatus(service, toServingStatus(serving))
+	}
+}
+
+func toServingStatus(ok bool) healthpb.HealthCheckResponse_ServingStatus {
+	if ok {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
@@ -9,5 +9,9 @@ import (
 type ImageKitService interface {
 	UploadFromBase64(ctx context.Context, req *common.Base64UploadRequest) (*common.UploadFileResponse, error)
 
+	UploadFromURL(ctx context.Context, req *common.Base64UploadRequest) (*common.UploadFileResponse, error)
+
 	DeleteFile(ctx context.Context, fileID string) error
+
+	Ping(ctx context.Context) error
 }
\ No newline at end of file
@@ -3,10 +3,13 @@ package imagekit
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/SomeHowMicroservice/product/common"
 	"github.com/SomeHowMicroservice/product/config"
+	"github.com/SomeHowMicroservice/product/metrics"
 	"github.com/imagekit-developer/imagekit-go"
+	"github.com/imagekit-developer/imagekit-go/api/media"
 	"github.com/imagekit-developer/imagekit-go/api/uploader"
 )
 
@@ -25,6 +28,14 @@ func NewImageKitService(cfg *config.Config) ImageKitService {
 }
 
 func (s *imageKitServiceImpl) UploadFromBase64(ctx context.Context, req *common.Base64UploadRequest) (*common.UploadFileResponse, error) {
+	return s.upload(ctx, "base64", req.Base64Data, req)
+}
+
+func (s *imageKitServiceImpl) UploadFromURL(ctx context.Context, req *common.Base64UploadRequest) (*common.UploadFileResponse, error) {
+	return s.upload(ctx, "url", req.SourceURL, req)
+}
+
+func (s *imageKitServiceImpl) upload(ctx context.Context, source, file string, req *common.Base64UploadRequest) (*common.UploadFileResponse, error) {
 	params := uploader.UploadParam{
 		FileName: req.FileName,
 	}
@@ -33,7 +44,9 @@ func (s *imageKitServiceImpl) UploadFromBase64(ctx context.Context, req *common.
 		params.Folder = req.Folder
 	}
 
-	result, err := s.client.Uploader.Upload(ctx, req.Base64Data, params)
+	start := time.Now()
+	result, err := s.client.Uploader.Upload(ctx, file, params)
+	metrics.ObserveImageUpload(source, time.Since(start), err)
 	if err != nil {
 		return nil, fmt.Errorf("upload file thất bại: %w", err)
 	}
@@ -52,3 +65,11 @@ func (s *imageKitServiceImpl) DeleteFile(ctx context.Context, fileID string) err
 
 	return nil
 }
+
+func (s *imageKitServiceImpl) Ping(ctx context.Context) error {
+	if _, err := s.client.Media.Files(ctx, media.FilesParam{Limit: 1}); err != nil {
+		return fmt.Errorf("kết nối ImageKit thất bại: %w", err)
+	}
+
+	return nil
+}
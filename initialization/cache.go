@@ -0,0 +1,50 @@
+package initialization
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SomeHowMicroservice/product/cache"
+	"github.com/SomeHowMicroservice/product/config"
+	"github.com/redis/go-redis/v9"
+)
+
+type Cache struct {
+	Service cache.CacheService
+	client  *redis.Client
+}
+
+func InitCache(cfg *config.Config) (*Cache, error) {
+	if cfg.Cache.RedisAddr == "" {
+		return &Cache{
+			cache.NewMemoryCache(),
+			nil,
+		}, nil
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     cfg.Cache.RedisAddr,
+		Password: cfg.Cache.RedisPassword,
+		DB:       cfg.Cache.RedisDB,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("kết nối Redis thất bại: %w", err)
+	}
+
+	return &Cache{
+		cache.NewRedisCache(client),
+		client,
+	}, nil
+}
+
+func (c *Cache) Close() {
+	if c.client != nil {
+		_ = c.client.Close()
+	}
+}
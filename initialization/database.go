@@ -1,13 +1,17 @@
 package initialization
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
+	"github.com/SomeHowMicroservice/product/common"
 	"github.com/SomeHowMicroservice/product/config"
+	"github.com/SomeHowMicroservice/product/metrics"
 	"github.com/SomeHowMicroservice/product/model"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/plugin/opentelemetry/tracing"
 )
 
 var allModels = []interface{}{
@@ -19,6 +23,20 @@ var allModels = []interface{}{
 	&model.Inventory{},
 	&model.Image{},
 	&model.Tag{},
+	&model.BundleItem{},
+	&model.ProductRelation{},
+	&model.Review{},
+	&model.Question{},
+	&model.QuestionVote{},
+	&model.Answer{},
+	&model.AnswerVote{},
+	&model.Wishlist{},
+	&model.CategoryFeaturedProduct{},
+	&model.ProductSlugHistory{},
+	&model.CategorySlugHistory{},
+	&model.ProductRevision{},
+	&model.AuditLog{},
+	&model.IdempotencyKey{},
 }
 
 type DB struct {
@@ -41,6 +59,14 @@ func InitDB(cfg *config.Config) (*DB, error) {
 		return nil, fmt.Errorf("kết nối PostgreSQL thất bại: %w", err)
 	}
 
+	if err := gDB.Use(metrics.NewGormPlugin()); err != nil {
+		return nil, fmt.Errorf("đăng ký metrics cho GORM thất bại: %w", err)
+	}
+
+	if err := gDB.Use(tracing.NewPlugin(tracing.WithoutMetrics())); err != nil {
+		return nil, fmt.Errorf("đăng ký tracing cho GORM thất bại: %w", err)
+	}
+
 	if err := runAutoMigrations(gDB); err != nil {
 		return nil, fmt.Errorf("chuyển dịch DB thất bại: %w", err)
 	}
@@ -56,10 +82,33 @@ func InitDB(cfg *config.Config) (*DB, error) {
 	}, nil
 }
 
+func (d *DB) Ping(ctx context.Context) error {
+	return d.sql.PingContext(ctx)
+}
+
 func (d *DB) Close() {
 	_ = d.sql.Close()
 }
 
 func runAutoMigrations(db *gorm.DB) error {
-	return db.AutoMigrate(allModels...)
+	if err := db.SetupJoinTable(&model.Category{}, "Parents", &model.CategoryParent{}); err != nil {
+		return err
+	}
+	if err := db.SetupJoinTable(&model.Category{}, "Children", &model.CategoryParent{}); err != nil {
+		return err
+	}
+
+	hasProductStatus := db.Migrator().HasColumn(&model.Product{}, "status")
+
+	if err := db.AutoMigrate(allModels...); err != nil {
+		return err
+	}
+
+	if !hasProductStatus {
+		if err := db.Model(&model.Product{}).Where("is_active = false").Update("status", common.ProductStatusDraft).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -1,13 +1,18 @@
 package initialization
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	userpb "github.com/SomeHowMicroservice/product/protobuf/user"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
 )
 
 type GRPCClients struct {
@@ -29,6 +34,21 @@ func InitClients(userAddr string) (*GRPCClients, error) {
 	}, nil
 }
 
+func (g *GRPCClients) PingUserService(ctx context.Context) error {
+	res, err := healthpb.NewHealthClient(g.userConn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return nil
+		}
+		return err
+	}
+	if res.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("user service đang ở trạng thái %s", res.Status)
+	}
+
+	return nil
+}
+
 func (g *GRPCClients) Close() {
 	_ = g.userConn.Close()
 }
@@ -36,6 +56,7 @@ func (g *GRPCClients) Close() {
 func dialOptions() []grpc.DialOption {
 	return []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 		grpc.WithDefaultServiceConfig(`{
 			"methodConfig": [{
 				"name": [{}],
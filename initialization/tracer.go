@@ -0,0 +1,81 @@
+package initialization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type Tracer struct {
+	provider *sdktrace.TracerProvider
+}
+
+func InitTracer(cfg *config.Config) (*Tracer, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.Tracing.Exporter {
+	case "", common.TracingExporterNone:
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		return &Tracer{}, nil
+	case common.TracingExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case common.TracingExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Tracing.Endpoint)}
+		if cfg.Tracing.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(context.Background(), opts...)
+	default:
+		return nil, fmt.Errorf("exporter tracing không hợp lệ: %s", cfg.Tracing.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("khởi tạo exporter tracing thất bại: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(common.ServiceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("khởi tạo resource tracing thất bại: %w", err)
+	}
+
+	sampleRatio := cfg.Tracing.SampleRatio
+	if sampleRatio <= 0 || sampleRatio > 1 {
+		sampleRatio = 1
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return &Tracer{
+		provider,
+	}, nil
+}
+
+func (t *Tracer) Shutdown(ctx context.Context) {
+	if t.provider == nil {
+		return
+	}
+
+	_ = t.provider.Shutdown(ctx)
+}
@@ -1,6 +1,8 @@
 package initialization
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/SomeHowMicroservice/product/common"
@@ -80,3 +82,13 @@ func (w *WatermillConnection) Close() {
 	_ = w.Publisher.Close()
 	_ = w.Subscriber.Close()
 }
+
+func (w *WatermillConnection) Ping(ctx context.Context) error {
+	for _, conn := range []any{w.Publisher, w.Subscriber} {
+		if c, ok := conn.(interface{ IsConnected() bool }); ok && !c.IsConnected() {
+			return errors.New("mất kết nối tới RabbitMQ")
+		}
+	}
+
+	return nil
+}
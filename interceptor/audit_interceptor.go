@@ -0,0 +1,256 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/model"
+	productpb "github.com/SomeHowMicroservice/product/protobuf/product"
+	auditRepo "github.com/SomeHowMicroservice/product/repository/audit"
+	"github.com/bytedance/sonic"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"gorm.io/gorm"
+)
+
+type auditSpec struct {
+	action     string
+	entityType string
+	table      string
+	keyColumn  string
+	rowColumn  string
+	entityIDs  func(req, resp any) []string
+}
+
+var auditSpecs = map[string]*auditSpec{
+	productpb.ProductService_CreateProduct_FullMethodName:               {common.AuditActionCreate, common.AuditEntityProduct, "products", "id", "", createdIDs},
+	productpb.ProductService_DuplicateProduct_FullMethodName:            {common.AuditActionCreate, common.AuditEntityProduct, "products", "id", "", createdIDs},
+	productpb.ProductService_UpdateProduct_FullMethodName:               {common.AuditActionUpdate, common.AuditEntityProduct, "", "", "", requestIDs},
+	productpb.ProductService_RollbackProductRevision_FullMethodName:     {common.AuditActionUpdate, common.AuditEntityProduct, "", "", "", productIDs},
+	productpb.ProductService_UpdateBundleItems_FullMethodName:           {common.AuditActionUpdate, common.AuditEntityProduct, "bundle_items", "bundle_id", "id", bundleIDs},
+	productpb.ProductService_AddProductRelations_FullMethodName:         {common.AuditActionUpdate, common.AuditEntityProduct, "product_relations", "product_id", "id", productIDs},
+	productpb.ProductService_RemoveProductRelations_FullMethodName:      {common.AuditActionUpdate, common.AuditEntityProduct, "product_relations", "product_id", "id", productIDs},
+	productpb.ProductService_DeleteProduct_FullMethodName:               {common.AuditActionDelete, common.AuditEntityProduct, "products", "id", "", requestIDs},
+	productpb.ProductService_DeleteProducts_FullMethodName:              {common.AuditActionDelete, common.AuditEntityProduct, "products", "id", "", requestIDs},
+	productpb.ProductService_RestoreProduct_FullMethodName:              {common.AuditActionRestore, common.AuditEntityProduct, "products", "id", "", requestIDs},
+	productpb.ProductService_RestoreProducts_FullMethodName:             {common.AuditActionRestore, common.AuditEntityProduct, "products", "id", "", requestIDs},
+	productpb.ProductService_PermanentlyDeleteProduct_FullMethodName:    {common.AuditActionPermanentlyDelete, common.AuditEntityProduct, "products", "id", "", requestIDs},
+	productpb.ProductService_PermanentlyDeleteProducts_FullMethodName:   {common.AuditActionPermanentlyDelete, common.AuditEntityProduct, "products", "id", "", requestIDs},
+	productpb.ProductService_CreateCategory_FullMethodName:              {common.AuditActionCreate, common.AuditEntityCategory, "categories", "id", "", createdIDs},
+	productpb.ProductService_UpdateCategory_FullMethodName:              {common.AuditActionUpdate, common.AuditEntityCategory, "categories", "id", "", requestIDs},
+	productpb.ProductService_MoveCategory_FullMethodName:                {common.AuditActionUpdate, common.AuditEntityCategory, "category_parents", "child_id", "parent_id", requestIDs},
+	productpb.ProductService_ReorderCategoryChildren_FullMethodName:     {common.AuditActionUpdate, common.AuditEntityCategory, "category_parents", "parent_id", "child_id", parentIDs},
+	productpb.ProductService_DeleteCategory_FullMethodName:              {common.AuditActionDelete, common.AuditEntityCategory, "categories", "id", "", requestIDs},
+	productpb.ProductService_DeleteCategories_FullMethodName:            {common.AuditActionDelete, common.AuditEntityCategory, "categories", "id", "", requestIDs},
+	productpb.ProductService_RestoreCategory_FullMethodName:             {common.AuditActionRestore, common.AuditEntityCategory, "categories", "id", "", requestIDs},
+	productpb.ProductService_RestoreCategories_FullMethodName:           {common.AuditActionRestore, common.AuditEntityCategory, "categories", "id", "", requestIDs},
+	productpb.ProductService_PermanentlyDeleteCategory_FullMethodName:   {common.AuditActionPermanentlyDelete, common.AuditEntityCategory, "categories", "id", "", requestIDs},
+	productpb.ProductService_PermanentlyDeleteCategories_FullMethodName: {common.AuditActionPermanentlyDelete, common.AuditEntityCategory, "categories", "id", "", requestIDs},
+	productpb.ProductService_CreateColor_FullMethodName:                 {common.AuditActionCreate, common.AuditEntityColor, "colors", "id", "", createdIDs},
+	productpb.ProductService_UpdateColor_FullMethodName:                 {common.AuditActionUpdate, common.AuditEntityColor, "colors", "id", "", requestIDs},
+	productpb.ProductService_DeleteColor_FullMethodName:                 {common.AuditActionDelete, common.AuditEntityColor, "colors", "id", "", requestIDs},
+	productpb.ProductService_DeleteColors_FullMethodName:                {common.AuditActionDelete, common.AuditEntityColor, "colors", "id", "", requestIDs},
+	productpb.ProductService_RestoreColor_FullMethodName:                {common.AuditActionRestore, common.AuditEntityColor, "colors", "id", "", requestIDs},
+	productpb.ProductService_RestoreColors_FullMethodName:               {common.AuditActionRestore, common.AuditEntityColor, "colors", "id", "", requestIDs},
+	productpb.ProductService_PermanentlyDeleteColor_FullMethodName:      {common.AuditActionPermanentlyDelete, common.AuditEntityColor, "colors", "id", "", requestIDs},
+	productpb.ProductService_PermanentlyDeleteColors_FullMethodName:     {common.AuditActionPermanentlyDelete, common.AuditEntityColor, "colors", "id", "", requestIDs},
+	productpb.ProductService_CreateSize_FullMethodName:                  {common.AuditActionCreate, common.AuditEntitySize, "sizes", "id", "", createdIDs},
+	productpb.ProductService_UpdateSize_FullMethodName:                  {common.AuditActionUpdate, common.AuditEntitySize, "sizes", "id", "", requestIDs},
+	productpb.ProductService_DeleteSize_FullMethodName:                  {common.AuditActionDelete, common.AuditEntitySize, "sizes", "id", "", requestIDs},
+	productpb.ProductService_DeleteSizes_FullMethodName:                 {common.AuditActionDelete, common.AuditEntitySize, "sizes", "id", "", requestIDs},
+	productpb.ProductService_RestoreSize_FullMethodName:                 {common.AuditActionRestore, common.AuditEntitySize, "sizes", "id", "", requestIDs},
+	productpb.ProductService_RestoreSizes_FullMethodName:                {common.AuditActionRestore, common.AuditEntitySize, "sizes", "id", "", requestIDs},
+	productpb.ProductService_PermanentlyDeleteSize_FullMethodName:       {common.AuditActionPermanentlyDelete, common.AuditEntitySize, "sizes", "id", "", requestIDs},
+	productpb.ProductService_PermanentlyDeleteSizes_FullMethodName:      {common.AuditActionPermanentlyDelete, common.AuditEntitySize, "sizes", "id", "", requestIDs},
+	productpb.ProductService_CreateTag_FullMethodName:                   {common.AuditActionCreate, common.AuditEntityTag, "tags", "id", "", createdIDs},
+	productpb.ProductService_UpdateTag_FullMethodName:                   {common.AuditActionUpdate, common.AuditEntityTag, "tags", "id", "", requestIDs},
+	productpb.ProductService_DeleteTag_FullMethodName:                   {common.AuditActionDelete, common.AuditEntityTag, "tags", "id", "", requestIDs},
+	productpb.ProductService_DeleteTags_FullMethodName:                  {common.AuditActionDelete, common.AuditEntityTag, "tags", "id", "", requestIDs},
+	productpb.ProductService_RestoreTag_FullMethodName:                  {common.AuditActionRestore, common.AuditEntityTag, "tags", "id", "", requestIDs},
+	productpb.ProductService_RestoreTags_FullMethodName:                 {common.AuditActionRestore, common.AuditEntityTag, "tags", "id", "", requestIDs},
+	productpb.ProductService_PermanentlyDeleteTag_FullMethodName:        {common.AuditActionPermanentlyDelete, common.AuditEntityTag, "tags", "id", "", requestIDs},
+	productpb.ProductService_PermanentlyDeleteTags_FullMethodName:       {common.AuditActionPermanentlyDelete, common.AuditEntityTag, "tags", "id", "", requestIDs},
+	productpb.ProductService_ModerateReview_FullMethodName:              {common.AuditActionModerate, common.AuditEntityReview, "reviews", "id", "", requestIDs},
+	productpb.ProductService_ModerateQuestion_FullMethodName:            {common.AuditActionModerate, common.AuditEntityQuestion, "questions", "id", "", requestIDs},
+	productpb.ProductService_ModerateAnswer_FullMethodName:              {common.AuditActionModerate, common.AuditEntityAnswer, "answers", "id", "", requestIDs},
+}
+
+func AuditUnaryInterceptor(auditRepo auditRepo.AuditRepository) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		spec, ok := auditSpecs[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		requestID := getRequestID(ctx)
+		if err := grpc.SetHeader(ctx, metadata.Pairs(common.RequestIDHeader, requestID)); err != nil {
+			log.Printf("gửi request id thất bại: %v", err)
+		}
+
+		var capture common.AuditCapture
+		if spec.table != "" && spec.action != common.AuditActionCreate {
+			capture = func(ctx context.Context, tx *gorm.DB) (map[string]map[string]any, error) {
+				rows, err := auditRepo.FindRowsByColumnTx(ctx, tx, spec.table, spec.keyColumn, spec.entityIDs(req, nil))
+				if err != nil {
+					return nil, fmt.Errorf("lấy dữ liệu nhật ký thao tác bảng %s thất bại: %w", spec.table, err)
+				}
+				return toAuditSnapshots(spec, rows), nil
+			}
+		}
+		ctx, recorded := common.WithAuditSnapshots(ctx, capture)
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+
+		ctx = context.WithoutCancel(ctx)
+		entityIDs := spec.entityIDs(req, resp)
+		before, after := recorded.Before(), recorded.After()
+		if spec.table != "" && spec.action != common.AuditActionPermanentlyDelete {
+			after = loadAuditSnapshots(ctx, auditRepo, spec, entityIDs)
+		}
+
+		var actorID string
+		if identity, ok := IdentityFromContext(ctx); ok {
+			actorID = identity.UserID
+		} else if r, ok := req.(interface{ GetUserId() string }); ok {
+			actorID = r.GetUserId()
+		}
+
+		logs := make([]*model.AuditLog, 0, len(entityIDs))
+		for _, entityID := range entityIDs {
+			logs = append(logs, &model.AuditLog{
+				ID:         uuid.NewString(),
+				RequestID:  requestID,
+				ActorID:    actorID,
+				Action:     spec.action,
+				EntityType: spec.entityType,
+				EntityID:   entityID,
+				Method:     info.FullMethod,
+				Changes:    diffAuditSnapshots(before[entityID], after[entityID]),
+			})
+		}
+
+		if len(logs) > 0 {
+			if err := auditRepo.CreateAll(ctx, logs); err != nil {
+				log.Printf("ghi nhật ký thao tác %s thất bại: %v", info.FullMethod, err)
+			}
+		}
+
+		return resp, nil
+	}
+}
+
+func getRequestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(common.RequestIDHeader); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+
+	return uuid.NewString()
+}
+
+func loadAuditSnapshots(ctx context.Context, auditRepo auditRepo.AuditRepository, spec *auditSpec, entityIDs []string) map[string]map[string]any {
+	snapshots := make(map[string]map[string]any)
+	if len(entityIDs) == 0 {
+		return snapshots
+	}
+
+	rows, err := auditRepo.FindRowsByColumn(ctx, spec.table, spec.keyColumn, entityIDs)
+	if err != nil {
+		log.Printf("lấy dữ liệu nhật ký thao tác bảng %s thất bại: %v", spec.table, err)
+		return snapshots
+	}
+
+	return toAuditSnapshots(spec, rows)
+}
+
+func toAuditSnapshots(spec *auditSpec, rows []map[string]any) map[string]map[string]any {
+	snapshots := make(map[string]map[string]any)
+	for _, row := range rows {
+		entityID := fmt.Sprint(row[spec.keyColumn])
+		if spec.rowColumn == "" {
+			snapshots[entityID] = row
+			continue
+		}
+
+		if snapshots[entityID] == nil {
+			snapshots[entityID] = make(map[string]any)
+		}
+		snapshots[entityID][fmt.Sprint(row[spec.rowColumn])] = row
+	}
+
+	return snapshots
+}
+
+func diffAuditSnapshots(before, after map[string]any) map[string]*model.AuditChange {
+	changes := make(map[string]*model.AuditChange)
+	for field, from := range before {
+		to, ok := after[field]
+		if ok && equalAuditValue(from, to) {
+			continue
+		}
+		changes[field] = &model.AuditChange{From: from, To: to}
+	}
+	for field, to := range after {
+		if _, ok := before[field]; !ok {
+			changes[field] = &model.AuditChange{To: to}
+		}
+	}
+
+	return changes
+}
+
+func equalAuditValue(a, b any) bool {
+	aData, _ := sonic.Marshal(a)
+	bData, _ := sonic.Marshal(b)
+	return string(aData) == string(bData)
+}
+
+func requestIDs(req, _ any) []string {
+	if r, ok := req.(interface{ GetIds() []string }); ok {
+		return r.GetIds()
+	}
+	if r, ok := req.(interface{ GetId() string }); ok {
+		return []string{r.GetId()}
+	}
+
+	return nil
+}
+
+func createdIDs(_, resp any) []string {
+	if r, ok := resp.(interface{ GetId() string }); ok && r.GetId() != "" {
+		return []string{r.GetId()}
+	}
+
+	return nil
+}
+
+func productIDs(req, _ any) []string {
+	if r, ok := req.(interface{ GetProductId() string }); ok {
+		return []string{r.GetProductId()}
+	}
+
+	return nil
+}
+
+func bundleIDs(req, _ any) []string {
+	if r, ok := req.(interface{ GetBundleId() string }); ok {
+		return []string{r.GetBundleId()}
+	}
+
+	return nil
+}
+
+func parentIDs(req, _ any) []string {
+	if r, ok := req.(interface{ GetParentId() string }); ok {
+		return []string{r.GetParentId()}
+	}
+
+	return nil
+}
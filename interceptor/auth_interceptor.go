@@ -0,0 +1,277 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/SomeHowMicroservice/product/cache"
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/config"
+	productpb "github.com/SomeHowMicroservice/product/protobuf/product"
+	userpb "github.com/SomeHowMicroservice/product/protobuf/user"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionv1alphapb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type Identity struct {
+	UserID string
+	Roles  []string
+}
+
+type identityKey struct{}
+
+func IdentityFromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+	return identity, ok
+}
+
+var publicMethods = map[string]struct{}{
+	productpb.ProductService_GetCategoryTree_FullMethodName:                  {},
+	productpb.ProductService_GetCategoryBySlug_FullMethodName:                {},
+	productpb.ProductService_GetProductBySlug_FullMethodName:                 {},
+	productpb.ProductService_GetProductsByCategory_FullMethodName:            {},
+	productpb.ProductService_GetAllColors_FullMethodName:                     {},
+	productpb.ProductService_GetAllSizes_FullMethodName:                      {},
+	productpb.ProductService_GetAllTags_FullMethodName:                       {},
+	productpb.ProductService_GetBundleItems_FullMethodName:                   {},
+	productpb.ProductService_GetRelatedProducts_FullMethodName:               {},
+	productpb.ProductService_GetProductReviews_FullMethodName:                {},
+	productpb.ProductService_GetProductQuestions_FullMethodName:              {},
+	productpb.ProductService_GetQuestionAnswers_FullMethodName:               {},
+	healthpb.Health_Check_FullMethodName:                                     {},
+	healthpb.Health_List_FullMethodName:                                      {},
+	healthpb.Health_Watch_FullMethodName:                                     {},
+	reflectionpb.ServerReflection_ServerReflectionInfo_FullMethodName:        {},
+	reflectionv1alphapb.ServerReflection_ServerReflectionInfo_FullMethodName: {},
+}
+
+var customerMethods = map[string]struct{}{
+	productpb.ProductService_CreateReview_FullMethodName:       {},
+	productpb.ProductService_CreateQuestion_FullMethodName:     {},
+	productpb.ProductService_CreateAnswer_FullMethodName:       {},
+	productpb.ProductService_UpvoteQuestion_FullMethodName:     {},
+	productpb.ProductService_UpvoteAnswer_FullMethodName:       {},
+	productpb.ProductService_AddToWishlist_FullMethodName:      {},
+	productpb.ProductService_RemoveFromWishlist_FullMethodName: {},
+	productpb.ProductService_GetWishlist_FullMethodName:        {},
+}
+
+var adminOnlyMethods = map[string]struct{}{
+	productpb.ProductService_PermanentlyDeleteProduct_FullMethodName:    {},
+	productpb.ProductService_PermanentlyDeleteProducts_FullMethodName:   {},
+	productpb.ProductService_PermanentlyDeleteCategory_FullMethodName:   {},
+	productpb.ProductService_PermanentlyDeleteCategories_FullMethodName: {},
+	productpb.ProductService_PermanentlyDeleteColor_FullMethodName:      {},
+	productpb.ProductService_PermanentlyDeleteColors_FullMethodName:     {},
+	productpb.ProductService_PermanentlyDeleteSize_FullMethodName:       {},
+	productpb.ProductService_PermanentlyDeleteSizes_FullMethodName:      {},
+	productpb.ProductService_PermanentlyDeleteTag_FullMethodName:        {},
+	productpb.ProductService_PermanentlyDeleteTags_FullMethodName:       {},
+	productpb.ProductService_GetAuditLogs_FullMethodName:                {},
+}
+
+type AuthInterceptor struct {
+	cfg        *config.Config
+	userClient userpb.UserServiceClient
+	cache      cache.CacheService
+}
+
+func NewAuthInterceptor(cfg *config.Config, userClient userpb.UserServiceClient, cache cache.CacheService) *AuthInterceptor {
+	return &AuthInterceptor{
+		cfg,
+		userClient,
+		cache,
+	}
+}
+
+func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := publicMethods[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
+
+		identity, err := i.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		setRequestUserID(req, identity.UserID)
+		return handler(context.WithValue(ctx, identityKey{}, identity), req)
+	}
+}
+
+func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if _, ok := publicMethods[info.FullMethod]; ok {
+			return handler(srv, ss)
+		}
+
+		identity, err := i.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &identityServerStream{ss, context.WithValue(ss.Context(), identityKey{}, identity), identity})
+	}
+}
+
+func (i *AuthInterceptor) authorize(ctx context.Context, fullMethod string) (*Identity, error) {
+	userID, err := i.authenticate(ctx)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	roles, err := i.getUserRoles(ctx, userID)
+	if err != nil {
+		if errors.Is(err, common.ErrUserNotFound) {
+			return nil, common.ToStatusError(common.ErrUnauthenticated)
+		}
+		return nil, common.ToStatusError(err)
+	}
+
+	if !hasRequiredRole(fullMethod, roles) {
+		return nil, common.ToStatusError(common.ErrPermissionDenied)
+	}
+
+	return &Identity{userID, roles}, nil
+}
+
+func (i *AuthInterceptor) authenticate(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", common.ErrUnauthenticated
+	}
+
+	if values := md.Get(common.AuthorizationHeader); len(values) > 0 {
+		tokenStr, ok := strings.CutPrefix(values[0], "Bearer ")
+		if !ok || i.cfg.Auth.JWTSecret == "" {
+			return "", common.ErrInvalidToken
+		}
+		return i.parseToken(tokenStr)
+	}
+
+	if i.cfg.Auth.TrustForwardedIdentity {
+		if values := md.Get(common.ForwardedUserIDHeader); len(values) > 0 && values[0] != "" {
+			return values[0], nil
+		}
+	}
+
+	return "", common.ErrUnauthenticated
+}
+
+func (i *AuthInterceptor) parseToken(tokenStr string) (string, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	}
+	if i.cfg.Auth.JWTIssuer != "" {
+		opts = append(opts, jwt.WithIssuer(i.cfg.Auth.JWTIssuer))
+	}
+
+	claims := &jwt.RegisteredClaims{}
+	if _, err := jwt.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (any, error) {
+		return []byte(i.cfg.Auth.JWTSecret), nil
+	}, opts...); err != nil {
+		return "", common.ErrInvalidToken
+	}
+	if claims.Subject == "" {
+		return "", common.ErrInvalidToken
+	}
+
+	return claims.Subject, nil
+}
+
+func (i *AuthInterceptor) getUserRoles(ctx context.Context, userID string) ([]string, error) {
+	key := fmt.Sprintf(common.UserRolesCacheKey, userID)
+
+	var roles []string
+	found, err := i.cache.Get(ctx, key, &roles)
+	if err != nil {
+		log.Printf("đọc cache quyền người dùng thất bại: %v", err)
+	}
+	if found {
+		return roles, nil
+	}
+
+	user, err := i.userClient.GetUserPublicById(ctx, &userpb.GetOneRequest{
+		Id: userID,
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return nil, common.ErrUserNotFound
+			default:
+				return nil, fmt.Errorf("%w: %s", common.ErrUserServiceUnavailable, st.Message())
+			}
+		}
+		return nil, fmt.Errorf("%w: %w", common.ErrUserServiceUnavailable, err)
+	}
+
+	ttl := i.cfg.Auth.RoleCacheTTL
+	if ttl <= 0 {
+		ttl = time.Minute
+	}
+	if err = i.cache.Set(ctx, key, user.Roles, ttl); err != nil {
+		log.Printf("ghi cache quyền người dùng thất bại: %v", err)
+	}
+
+	return user.Roles, nil
+}
+
+func hasRequiredRole(fullMethod string, roles []string) bool {
+	if _, ok := customerMethods[fullMethod]; ok {
+		return true
+	}
+	if _, ok := adminOnlyMethods[fullMethod]; ok {
+		return slices.Contains(roles, common.RoleAdmin)
+	}
+
+	return slices.Contains(roles, common.RoleAdmin) || slices.Contains(roles, common.RoleStaff)
+}
+
+func setRequestUserID(req any, userID string) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return
+	}
+
+	reflectMsg := msg.ProtoReflect()
+	field := reflectMsg.Descriptor().Fields().ByName("user_id")
+	if field != nil && field.Kind() == protoreflect.StringKind && !field.IsList() {
+		reflectMsg.Set(field, protoreflect.ValueOfString(userID))
+	}
+}
+
+type identityServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	identity *Identity
+}
+
+func (s *identityServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *identityServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	setRequestUserID(m, s.identity.UserID)
+	return nil
+}
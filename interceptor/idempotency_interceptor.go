@@ -0,0 +1,239 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/model"
+	productpb "github.com/SomeHowMicroservice/product/protobuf/product"
+	idempotencyRepo "github.com/SomeHowMicroservice/product/repository/idempotency"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	defaultIdempotencyTTL   = 24 * time.Hour
+	defaultIdempotencyLease = 30 * time.Second
+)
+
+var idempotentMethods = map[string]struct{}{
+	productpb.ProductService_CreateProduct_FullMethodName:               {},
+	productpb.ProductService_CreateCategory_FullMethodName:              {},
+	productpb.ProductService_CreateColor_FullMethodName:                 {},
+	productpb.ProductService_CreateSize_FullMethodName:                  {},
+	productpb.ProductService_CreateTag_FullMethodName:                   {},
+	productpb.ProductService_CreateReview_FullMethodName:                {},
+	productpb.ProductService_CreateQuestion_FullMethodName:              {},
+	productpb.ProductService_CreateAnswer_FullMethodName:                {},
+	productpb.ProductService_DuplicateProduct_FullMethodName:            {},
+	productpb.ProductService_SellBundle_FullMethodName:                  {},
+	productpb.ProductService_ReserveBundle_FullMethodName:               {},
+	productpb.ProductService_ReleaseBundle_FullMethodName:               {},
+	productpb.ProductService_UpdateProduct_FullMethodName:               {},
+	productpb.ProductService_UpdateCategory_FullMethodName:              {},
+	productpb.ProductService_UpdateColor_FullMethodName:                 {},
+	productpb.ProductService_UpdateSize_FullMethodName:                  {},
+	productpb.ProductService_UpdateTag_FullMethodName:                   {},
+	productpb.ProductService_UpdateBundleItems_FullMethodName:           {},
+	productpb.ProductService_DeleteProducts_FullMethodName:              {},
+	productpb.ProductService_DeleteCategories_FullMethodName:            {},
+	productpb.ProductService_DeleteColors_FullMethodName:                {},
+	productpb.ProductService_DeleteSizes_FullMethodName:                 {},
+	productpb.ProductService_DeleteTags_FullMethodName:                  {},
+	productpb.ProductService_PermanentlyDeleteProducts_FullMethodName:   {},
+	productpb.ProductService_PermanentlyDeleteCategories_FullMethodName: {},
+	productpb.ProductService_PermanentlyDeleteColors_FullMethodName:     {},
+	productpb.ProductService_PermanentlyDeleteSizes_FullMethodName:      {},
+	productpb.ProductService_PermanentlyDeleteTags_FullMethodName:       {},
+	productpb.ProductService_RestoreProduct_FullMethodName:              {},
+	productpb.ProductService_RestoreProducts_FullMethodName:             {},
+	productpb.ProductService_RestoreCategory_FullMethodName:             {},
+	productpb.ProductService_RestoreCategories_FullMethodName:           {},
+	productpb.ProductService_RestoreColor_FullMethodName:                {},
+	productpb.ProductService_RestoreColors_FullMethodName:               {},
+	productpb.ProductService_RestoreSize_FullMethodName:                 {},
+	productpb.ProductService_RestoreSizes_FullMethodName:                {},
+	productpb.ProductService_RestoreTag_FullMethodName:                  {},
+	productpb.ProductService_RestoreTags_FullMethodName:                 {},
+}
+
+func IdempotencyUnaryInterceptor(idempotencyRepo idempotencyRepo.IdempotencyRepository, ttl, lease time.Duration) grpc.UnaryServerInterceptor {
+	if ttl <= 0 {
+		ttl = defaultIdempotencyTTL
+	}
+	if lease <= 0 {
+		lease = defaultIdempotencyLease
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := idempotentMethods[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		key := getIdempotencyKey(ctx)
+		msg, ok := req.(proto.Message)
+		if key == "" || !ok {
+			return handler(ctx, req)
+		}
+		if len(key) > 255 {
+			return nil, common.ToStatusError(common.NewDetailedError(common.ErrInvalidRequest, nil, &common.FieldViolation{
+				Field:       common.IdempotencyKeyHeader,
+				Description: "idempotency key không được vượt quá 255 ký tự",
+				Reason:      "IDEMPOTENCY_KEY_TOO_LONG",
+			}))
+		}
+
+		requestHash, err := hashRequest(msg)
+		if err != nil {
+			return nil, common.ToStatusError(err)
+		}
+
+		var userID string
+		if identity, ok := IdentityFromContext(ctx); ok {
+			userID = identity.UserID
+		} else if r, ok := req.(interface{ GetUserId() string }); ok {
+			userID = r.GetUserId()
+		}
+
+		now := time.Now()
+		record := &model.IdempotencyKey{
+			UserID:      userID,
+			Key:         key,
+			Method:      info.FullMethod,
+			RequestHash: requestHash,
+			Status:      common.IdempotencyStatusPending,
+			LockedUntil: now.Add(lease),
+			ExpiresAt:   now.Add(ttl),
+		}
+		created, err := idempotencyRepo.CreateIfNotExists(ctx, record)
+		if err != nil {
+			return nil, common.ToStatusError(err)
+		}
+		if !created {
+			if err = idempotencyRepo.DeleteReclaimableByUserIDAndKeyAndMethod(ctx, userID, key, info.FullMethod, now); err != nil {
+				return nil, common.ToStatusError(err)
+			}
+			if created, err = idempotencyRepo.CreateIfNotExists(ctx, record); err != nil {
+				return nil, common.ToStatusError(err)
+			}
+		}
+		if !created {
+			return replayIdempotentResponse(ctx, idempotencyRepo, userID, key, info.FullMethod, requestHash)
+		}
+
+		stopHeartbeat := renewIdempotencyLease(context.WithoutCancel(ctx), idempotencyRepo, userID, key, info.FullMethod, lease)
+		resp, err := handler(ctx, req)
+		stopHeartbeat()
+		ctx = context.WithoutCancel(ctx)
+		if err != nil {
+			if delErr := idempotencyRepo.Delete(ctx, userID, key, info.FullMethod); delErr != nil {
+				log.Printf("xóa idempotency key %s thất bại: %v", key, delErr)
+			}
+			return nil, err
+		}
+
+		respMsg, ok := resp.(proto.Message)
+		if !ok {
+			return resp, nil
+		}
+		data, err := proto.Marshal(respMsg)
+		if err != nil {
+			log.Printf("mã hóa phản hồi cho idempotency key %s thất bại: %v", key, err)
+			return resp, nil
+		}
+		if err = idempotencyRepo.Complete(ctx, userID, key, info.FullMethod, string(respMsg.ProtoReflect().Descriptor().FullName()), data); err != nil {
+			log.Printf("lưu phản hồi cho idempotency key %s thất bại: %v", key, err)
+		}
+
+		return resp, nil
+	}
+}
+
+func renewIdempotencyLease(ctx context.Context, idempotencyRepo idempotencyRepo.IdempotencyRepository, userID, key, method string, lease time.Duration) func() {
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(lease / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if err := idempotencyRepo.ExtendLease(ctx, userID, key, method, time.Now().Add(lease)); err != nil {
+					log.Printf("gia hạn idempotency key %s thất bại: %v", key, err)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(stop)
+		<-done
+	}
+}
+
+func replayIdempotentResponse(ctx context.Context, idempotencyRepo idempotencyRepo.IdempotencyRepository, userID, key, method, requestHash string) (any, error) {
+	record, err := idempotencyRepo.FindByUserIDAndKeyAndMethod(ctx, userID, key, method)
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+	if record == nil {
+		return nil, common.ToStatusError(common.ErrIdempotencyRequestInProgress)
+	}
+	if record.RequestHash != requestHash {
+		return nil, common.ToStatusError(common.NewDetailedError(common.ErrIdempotencyKeyReused, map[string]string{"idempotency_key": key}))
+	}
+	if record.Status != common.IdempotencyStatusCompleted {
+		return nil, common.ToStatusError(common.NewDetailedError(common.ErrIdempotencyRequestInProgress, map[string]string{"idempotency_key": key}))
+	}
+
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(record.ResponseType))
+	if err != nil {
+		return nil, common.ToStatusError(err)
+	}
+	resp := msgType.New().Interface()
+	if err = proto.Unmarshal(record.Response, resp); err != nil {
+		return nil, common.ToStatusError(err)
+	}
+
+	if err = grpc.SetHeader(ctx, metadata.Pairs(common.IdempotencyReplayedHeader, "true")); err != nil {
+		log.Printf("gửi header idempotency thất bại: %v", err)
+	}
+
+	return resp, nil
+}
+
+func getIdempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if values := md.Get(common.IdempotencyKeyHeader); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+func hashRequest(msg proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,55 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func LocaleUnaryInterceptor(fallbackLocale string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		locales := localesFromMetadata(ctx, fallbackLocale)
+
+		resp, err := handler(common.WithLocales(ctx, locales), req)
+		if err != nil {
+			return nil, common.LocalizeStatusError(err, locales)
+		}
+
+		return resp, nil
+	}
+}
+
+func LocaleStreamInterceptor(fallbackLocale string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		locales := localesFromMetadata(ss.Context(), fallbackLocale)
+
+		if err := handler(srv, &localeServerStream{ss, common.WithLocales(ss.Context(), locales)}); err != nil {
+			return common.LocalizeStatusError(err, locales)
+		}
+
+		return nil
+	}
+}
+
+func localesFromMetadata(ctx context.Context, fallbackLocale string) []string {
+	var values []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		values = md.Get(common.LocaleHeader)
+		if len(values) == 0 {
+			values = md.Get(common.AcceptLanguageHeader)
+		}
+	}
+
+	return common.ParseLocales(values, fallbackLocale)
+}
+
+type localeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *localeServerStream) Context() context.Context {
+	return s.ctx
+}
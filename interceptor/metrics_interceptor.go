@@ -0,0 +1,30 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"github.com/SomeHowMicroservice/product/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func MetricsUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		metrics.ObserveRPC(info.FullMethod, status.Code(err), time.Since(start))
+
+		return resp, err
+	}
+}
+
+func MetricsStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		metrics.ObserveRPC(info.FullMethod, status.Code(err), time.Since(start))
+
+		return err
+	}
+}
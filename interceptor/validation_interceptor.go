@@ -0,0 +1,40 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"buf.build/go/protovalidate"
+	"github.com/SomeHowMicroservice/product/common"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+func ValidationUnaryInterceptor(validator protovalidate.Validator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		if err := validator.Validate(msg); err != nil {
+			var validationErr *protovalidate.ValidationError
+			if !errors.As(err, &validationErr) {
+				return nil, common.ToStatusError(err)
+			}
+
+			violations := make([]*common.FieldViolation, 0, len(validationErr.Violations))
+			for _, v := range validationErr.Violations {
+				violations = append(violations, &common.FieldViolation{
+					Field:       protovalidate.FieldPathString(v.Proto.GetField()),
+					Description: v.Proto.GetMessage(),
+					Reason:      strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(v.Proto.GetRuleId())),
+				})
+			}
+			return nil, common.ToStatusError(common.NewDetailedError(common.ErrInvalidRequest, nil, violations...))
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const gormStartTimeKey = "metrics:start_time"
+
+type gormPlugin struct{}
+
+func NewGormPlugin() gorm.Plugin {
+	return &gormPlugin{}
+}
+
+func (p *gormPlugin) Name() string {
+	return "metrics"
+}
+
+func (p *gormPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+
+	return errors.Join(
+		cb.Create().Before("gorm:create").Register("metrics:before_create", startGormTimer),
+		cb.Create().After("gorm:create").Register("metrics:after_create", observeGormQuery("create")),
+		cb.Query().Before("gorm:query").Register("metrics:before_query", startGormTimer),
+		cb.Query().After("gorm:query").Register("metrics:after_query", observeGormQuery("query")),
+		cb.Update().Before("gorm:update").Register("metrics:before_update", startGormTimer),
+		cb.Update().After("gorm:update").Register("metrics:after_update", observeGormQuery("update")),
+		cb.Delete().Before("gorm:delete").Register("metrics:before_delete", startGormTimer),
+		cb.Delete().After("gorm:delete").Register("metrics:after_delete", observeGormQuery("delete")),
+		cb.Row().Before("gorm:row").Register("metrics:before_row", startGormTimer),
+		cb.Row().After("gorm:row").Register("metrics:after_row", observeGormQuery("row")),
+		cb.Raw().Before("gorm:raw").Register("metrics:before_raw", startGormTimer),
+		cb.Raw().After("gorm:raw").Register("metrics:after_raw", observeGormQuery("raw")),
+	)
+}
+
+func startGormTimer(db *gorm.DB) {
+	db.InstanceSet(gormStartTimeKey, time.Now())
+}
+
+func observeGormQuery(operation string) func(db *gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(gormStartTimeKey)
+		if !ok {
+			return
+		}
+		start, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+
+		table := db.Statement.Table
+		if table == "" {
+			table = "unknown"
+		}
+
+		err := db.Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = nil
+		}
+		ObserveDBQuery(operation, table, time.Since(start), err)
+	}
+}
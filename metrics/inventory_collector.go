@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"context"
+	"log"
+	"time"
+
+	inventoryRepo "github.com/SomeHowMicroservice/product/repository/inventory"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const inventoryCollectTimeout = 5 * time.Second
+
+type inventoryCollector struct {
+	inventoryRepo inventoryRepo.InventoryRepository
+	outOfStock    *prometheus.Desc
+	lowStock      *prometheus.Desc
+}
+
+func RegisterInventoryCollector(inventoryRepo inventoryRepo.InventoryRepository) error {
+	return Registry.Register(&inventoryCollector{
+		inventoryRepo,
+		prometheus.NewDesc(prometheus.BuildFQName(namespace, "inventory", "out_of_stock_variants"), "Số biến thể đã hết hàng.", nil, nil),
+		prometheus.NewDesc(prometheus.BuildFQName(namespace, "inventory", "low_stock_variants"), "Số biến thể sắp hết hàng.", nil, nil),
+	})
+}
+
+func (c *inventoryCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.outOfStock
+	ch <- c.lowStock
+}
+
+func (c *inventoryCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), inventoryCollectTimeout)
+	defer cancel()
+
+	levels, err := c.inventoryRepo.CountStockLevels(ctx)
+	if err != nil {
+		log.Printf("thống kê tồn kho thất bại: %v", err)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.outOfStock, prometheus.GaugeValue, float64(levels.OutOfStock))
+	ch <- prometheus.MustNewConstMetric(c.lowStock, prometheus.GaugeValue, float64(levels.LowStock))
+}
@@ -0,0 +1,128 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"google.golang.org/grpc/codes"
+)
+
+const namespace = "product"
+
+var (
+	grpcHandledTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_server",
+		Name:      "handled_total",
+		Help:      "Tổng số RPC đã xử lý theo method và status code.",
+	}, []string{"method", "code"})
+
+	grpcHandlingSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_server",
+		Name:      "handling_seconds",
+		Help:      "Thời gian xử lý RPC theo method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	dbQuerySeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_seconds",
+		Help:      "Thời gian thực thi truy vấn GORM theo thao tác và bảng.",
+		Buckets:   []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+	}, []string{"operation", "table"})
+
+	dbQueryErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_errors_total",
+		Help:      "Tổng số truy vấn GORM lỗi theo thao tác và bảng.",
+	}, []string{"operation", "table"})
+
+	mqProcessedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "mq_handler",
+		Name:      "processed_total",
+		Help:      "Tổng số message xử lý thành công theo handler.",
+	}, []string{"handler"})
+
+	mqFailedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "mq_handler",
+		Name:      "failed_total",
+		Help:      "Tổng số message xử lý thất bại sau khi hết lượt thử lại theo handler.",
+	}, []string{"handler"})
+
+	mqRetriedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "mq_handler",
+		Name:      "retried_total",
+		Help:      "Tổng số lần thử lại message theo handler.",
+	}, []string{"handler"})
+
+	mqHandlingSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "mq_handler",
+		Name:      "handling_seconds",
+		Help:      "Thời gian xử lý message theo handler, bao gồm cả các lần thử lại.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"handler"})
+
+	imageUploadSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "image_upload",
+		Name:      "duration_seconds",
+		Help:      "Thời gian upload ảnh lên ImageKit theo nguồn ảnh.",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"source"})
+
+	imageUploadFailuresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "image_upload",
+		Name:      "failures_total",
+		Help:      "Tổng số lần upload ảnh thất bại theo nguồn ảnh.",
+	}, []string{"source"})
+)
+
+var Registry = newRegistry()
+
+func newRegistry() *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		grpcHandledTotal,
+		grpcHandlingSeconds,
+		dbQuerySeconds,
+		dbQueryErrorsTotal,
+		mqProcessedTotal,
+		mqFailedTotal,
+		mqRetriedTotal,
+		mqHandlingSeconds,
+		imageUploadSeconds,
+		imageUploadFailuresTotal,
+	)
+
+	return registry
+}
+
+func ObserveRPC(method string, code codes.Code, duration time.Duration) {
+	grpcHandledTotal.WithLabelValues(method, code.String()).Inc()
+	grpcHandlingSeconds.WithLabelValues(method).Observe(duration.Seconds())
+}
+
+func ObserveDBQuery(operation, table string, duration time.Duration, err error) {
+	dbQuerySeconds.WithLabelValues(operation, table).Observe(duration.Seconds())
+	if err != nil {
+		dbQueryErrorsTotal.WithLabelValues(operation, table).Inc()
+	}
+}
+
+func ObserveImageUpload(source string, duration time.Duration, err error) {
+	imageUploadSeconds.WithLabelValues(source).Observe(duration.Seconds())
+	if err != nil {
+		imageUploadFailuresTotal.WithLabelValues(source).Inc()
+	}
+}
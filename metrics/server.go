@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+type Server struct {
+	server *http.Server
+}
+
+func NewServer(port int) *Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry}))
+
+	return &Server{
+		&http.Server{
+			Addr:              fmt.Sprintf(":%d", port),
+			Handler:           mux,
+			ReadHeaderTimeout: 5 * time.Second,
+		},
+	}
+}
+
+func (s *Server) Start() {
+	go func() {
+		if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("Lỗi chạy metrics server: %v", err)
+		}
+	}()
+}
+
+func (s *Server) Shutdown(ctx context.Context) {
+	if err := s.server.Shutdown(ctx); err != nil {
+		log.Printf("Shutdown metrics server thất bại: %v", err)
+	}
+}
@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+const attemptMetadataKey = "metrics_attempt"
+
+func HandlerMiddleware(h message.HandlerFunc) message.HandlerFunc {
+	return func(msg *message.Message) ([]*message.Message, error) {
+		handler := message.HandlerNameFromCtx(msg.Context())
+		start := time.Now()
+
+		msgs, err := h(msg)
+
+		mqHandlingSeconds.WithLabelValues(handler).Observe(time.Since(start).Seconds())
+		if err != nil {
+			mqFailedTotal.WithLabelValues(handler).Inc()
+		} else {
+			mqProcessedTotal.WithLabelValues(handler).Inc()
+		}
+
+		return msgs, err
+	}
+}
+
+func RetryMiddleware(h message.HandlerFunc) message.HandlerFunc {
+	return func(msg *message.Message) ([]*message.Message, error) {
+		if msg.Metadata.Get(attemptMetadataKey) != "" {
+			mqRetriedTotal.WithLabelValues(message.HandlerNameFromCtx(msg.Context())).Inc()
+		}
+		msg.Metadata.Set(attemptMetadataKey, "1")
+
+		return h(msg)
+	}
+}
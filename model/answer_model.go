@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+type Answer struct {
+	ID            string     `gorm:"type:char(36);primaryKey" json:"id"`
+	QuestionID    string     `gorm:"type:char(36);index;not null" json:"question_id"`
+	UserID        string     `gorm:"type:char(36);not null" json:"user_id"`
+	Content       string     `gorm:"type:text;not null" json:"content"`
+	IsStaff       bool       `gorm:"type:boolean;not null;default:false" json:"is_staff"`
+	Status        string     `gorm:"type:varchar(20);index;not null;default:'pending'" json:"status"`
+	UpvoteCount   int        `gorm:"type:int;not null;default:0" json:"upvote_count"`
+	ModeratedByID *string    `gorm:"type:char(36)" json:"moderated_by_id"`
+	ModeratedAt   *time.Time `json:"moderated_at"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt     time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Question *Question `gorm:"foreignKey:QuestionID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
+
+type AnswerVote struct {
+	AnswerID  string    `gorm:"type:char(36);primaryKey" json:"answer_id"`
+	UserID    string    `gorm:"type:char(36);primaryKey" json:"user_id"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+
+	Answer *Answer `gorm:"foreignKey:AnswerID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+type AuditLog struct {
+	ID         string                  `gorm:"type:char(36);primaryKey" json:"id"`
+	RequestID  string                  `gorm:"type:varchar(64);index;not null" json:"request_id"`
+	ActorID    string                  `gorm:"type:char(36);index" json:"actor_id"`
+	Action     string                  `gorm:"type:varchar(30);index;not null" json:"action"`
+	EntityType string                  `gorm:"type:varchar(30);index:audit_logs_entity_type_entity_id_idx,priority:1;not null" json:"entity_type"`
+	EntityID   string                  `gorm:"type:char(36);index:audit_logs_entity_type_entity_id_idx,priority:2;not null" json:"entity_id"`
+	Method     string                  `gorm:"type:varchar(100);not null" json:"method"`
+	Changes    map[string]*AuditChange `gorm:"type:jsonb;serializer:json" json:"changes"`
+	CreatedAt  time.Time               `gorm:"autoCreateTime;index" json:"created_at"`
+}
+
+type AuditChange struct {
+	From any `json:"from"`
+	To   any `json:"to"`
+}
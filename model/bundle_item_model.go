@@ -0,0 +1,11 @@
+package model
+
+type BundleItem struct {
+	ID        string `gorm:"type:char(36);primaryKey" json:"id"`
+	BundleID  string `gorm:"type:char(36);uniqueIndex:bundle_items_bundle_id_variant_id_key;not null" json:"-"`
+	VariantID string `gorm:"type:char(36);uniqueIndex:bundle_items_bundle_id_variant_id_key;not null" json:"-"`
+	Quantity  int    `gorm:"type:int;not null" json:"quantity"`
+
+	Bundle  *Product `gorm:"foreignKey:BundleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Variant *Variant `gorm:"foreignKey:VariantID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"variant"`
+}
@@ -0,0 +1,10 @@
+package model
+
+type CategoryFeaturedProduct struct {
+	CategoryID string `gorm:"type:char(36);primaryKey" json:"-"`
+	ProductID  string `gorm:"type:char(36);primaryKey" json:"-"`
+	SortOrder  int    `gorm:"type:int;not null;default:0" json:"sort_order"`
+
+	Category *Category `gorm:"foreignKey:CategoryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Product  *Product  `gorm:"foreignKey:ProductID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"product"`
+}
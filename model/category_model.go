@@ -3,15 +3,36 @@ package model
 import "time"
 
 type Category struct {
-	ID          string      `gorm:"type:char(36);primaryKey" json:"id"`
-	Name        string      `gorm:"type:varchar(100);not null" json:"name"`
-	Slug        string      `gorm:"type:varchar(100);uniqueIndex:categories_slug_key;not null" json:"slug"`
-	Parents     []*Category `gorm:"many2many:category_parents;joinForeignKey:ChildID;joinReferences:ParentID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"parents"`
-	Children    []*Category `gorm:"many2many:category_parents;joinForeignKey:ParentID;joinReferences:ChildID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"children"`
-	CreatedAt   time.Time   `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt   time.Time   `gorm:"autoUpdateTime" json:"updated_at"`
-	CreatedByID string      `gorm:"type:char(36);not null" json:"created_by_id"`
-	UpdatedByID string      `gorm:"type:char(36);not null" json:"updated_by_id"`
+	ID              string       `gorm:"type:char(36);primaryKey" json:"id"`
+	Name            string       `gorm:"type:varchar(100);not null" json:"name"`
+	Slug            string       `gorm:"type:varchar(100);uniqueIndex:categories_slug_key;not null" json:"slug"`
+	Description     string       `gorm:"type:text" json:"description"`
+	BannerUrl       string       `gorm:"type:varchar(255)" json:"banner_url"`
+	BannerFileID    string       `gorm:"type:char(24)" json:"banner_file_id"`
+	MetaTitle       string       `gorm:"type:varchar(255)" json:"meta_title"`
+	MetaDescription string       `gorm:"type:varchar(500)" json:"meta_description"`
+	Translations    Translations `gorm:"type:jsonb;not null;default:'{}'" json:"translations"`
+	IsVisible       bool         `gorm:"type:boolean;not null;default:true" json:"is_visible"`
+	Parents         []*Category  `gorm:"many2many:category_parents;joinForeignKey:ChildID;joinReferences:ParentID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"parents"`
+	Children        []*Category  `gorm:"many2many:category_parents;joinForeignKey:ParentID;joinReferences:ChildID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"children"`
+	IsDeleted       bool         `gorm:"type:boolean;not null;default:false" json:"is_deleted"`
+	Version         int          `gorm:"type:int;not null;default:1" json:"version"`
+	CreatedAt       time.Time    `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time    `gorm:"autoUpdateTime" json:"updated_at"`
+	CreatedByID     string       `gorm:"type:char(36);not null" json:"created_by_id"`
+	UpdatedByID     string       `gorm:"type:char(36);not null" json:"updated_by_id"`
 
-	Products []*Product `gorm:"many2many:product_categories;constraint:OnUpdate:CASCADE;OnDelete:CASCADE" json:"products"`
+	Products         []*Product                 `gorm:"many2many:product_categories;constraint:OnUpdate:CASCADE;OnDelete:CASCADE" json:"products"`
+	FeaturedProducts []*CategoryFeaturedProduct `gorm:"foreignKey:CategoryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"featured_products"`
+}
+
+func (m *Category) Localize(locales []string) {
+	m.Name = m.Translations.Get(locales, "name", m.Name)
+	m.Description = m.Translations.Get(locales, "description", m.Description)
+	m.MetaTitle = m.Translations.Get(locales, "meta_title", m.MetaTitle)
+	m.MetaDescription = m.Translations.Get(locales, "meta_description", m.MetaDescription)
+
+	for _, child := range m.Children {
+		child.Localize(locales)
+	}
 }
@@ -0,0 +1,7 @@
+package model
+
+type CategoryParent struct {
+	ChildID   string `gorm:"type:char(36);primaryKey" json:"child_id"`
+	ParentID  string `gorm:"type:char(36);primaryKey;index:category_parents_parent_id_sort_order_idx,priority:1" json:"parent_id"`
+	SortOrder int    `gorm:"type:int;not null;default:0;index:category_parents_parent_id_sort_order_idx,priority:2" json:"sort_order"`
+}
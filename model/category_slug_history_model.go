@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+type CategorySlugHistory struct {
+	ID         string    `gorm:"type:char(36);primaryKey" json:"id"`
+	CategoryID string    `gorm:"type:char(36);index;not null" json:"-"`
+	Slug       string    `gorm:"type:varchar(100);uniqueIndex:category_slug_history_slug_key;not null" json:"slug"`
+	CreatedAt  time.Time `gorm:"autoCreateTime" json:"created_at"`
+
+	Category *Category `gorm:"foreignKey:CategoryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"category"`
+}
+
+func (CategorySlugHistory) TableName() string {
+	return "category_slug_history"
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+type IdempotencyKey struct {
+	UserID       string    `gorm:"type:varchar(36);primaryKey" json:"user_id"`
+	Key          string    `gorm:"type:varchar(255);primaryKey" json:"key"`
+	Method       string    `gorm:"type:varchar(255);primaryKey" json:"method"`
+	RequestHash  string    `gorm:"type:char(64);not null" json:"request_hash"`
+	Status       string    `gorm:"type:varchar(20);not null" json:"status"`
+	ResponseType string    `gorm:"type:varchar(255)" json:"response_type"`
+	Response     []byte    `gorm:"type:bytea" json:"-"`
+	LockedUntil  time.Time `gorm:"not null" json:"locked_until"`
+	CreatedAt    time.Time `gorm:"autoCreateTime" json:"created_at"`
+	ExpiresAt    time.Time `gorm:"index;not null" json:"expires_at"`
+}
@@ -5,6 +5,7 @@ type Inventory struct {
 	VariantID    string    `gorm:"type:char(36);uniqueIndex:inventories_variant_id_key;not null" json:"-"`
 	Quantity     int       `gorm:"type:int" json:"quantity"`
 	SoldQuantity int       `gorm:"type:int" json:"sold_quantity"`
+	ReservedQuantity int   `gorm:"type:int;not null;default:0" json:"reserved_quantity"`
 	Stock        int       `gorm:"type:int" json:"stock"`
 	IsStock      bool      `gorm:"type:boolean;default:true" json:"is_stock"`
 
@@ -12,7 +13,7 @@ type Inventory struct {
 }
 
 func (m *Inventory) SetStock() {
-	m.Stock = m.Quantity - m.SoldQuantity
+	m.Stock = m.Quantity - m.SoldQuantity - m.ReservedQuantity
 	if m.Stock <= 5 {
 		m.IsStock = false
 	}
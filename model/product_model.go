@@ -1,26 +1,141 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/SomeHowMicroservice/product/common"
+)
 
 type Product struct {
-	ID          string     `gorm:"type:char(36);primaryKey" json:"id"`
-	Title       string     `gorm:"type:varchar(255);not null" json:"title"`
-	Slug        string     `gorm:"type:varchar(255);uniqueIndex:products_slug_key;not null" json:"slug"`
-	Description string     `gorm:"type:text;not null" json:"description"`
-	Price       float32    `gorm:"type:decimal(10,2);not null" json:"price"`
-	IsActive    bool       `gorm:"type:boolean;not null;default:true" json:"is_active"`
-	IsSale      bool       `gorm:"type:boolean;not null" json:"is_sale"`
-	SalePrice   *float32   `gorm:"type:decimal(10,2)" json:"sale_price"`
-	StartSale   *time.Time `gorm:"type:date" json:"start_sale"`
-	EndSale     *time.Time `gorm:"type:date" json:"end_sale"`
-	IsDeleted   bool       `gorm:"type:boolean;not null;default:false" json:"is_deleted"`
-	CreatedAt   time.Time  `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt   time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
-	CreatedByID string     `gorm:"type:char(36);not null" json:"created_by_id"`
-	UpdatedByID string     `gorm:"type:char(36);not null" json:"updated_by_id"`
-
-	Categories []*Category `gorm:"many2many:product_categories;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"categories"`
-	Tags       []*Tag      `gorm:"many2many:product_tags;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"tags"`
-	Variants   []*Variant  `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE;OnDelete:CASCADE" json:"variants"`
-	Images     []*Image    `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE;OnDelete:CASCADE" json:"images"`
+	ID                  string       `gorm:"type:char(36);primaryKey" json:"id"`
+	Title               string       `gorm:"type:varchar(255);not null" json:"title"`
+	Slug                string       `gorm:"type:varchar(255);uniqueIndex:products_slug_key;not null" json:"slug"`
+	Type                string       `gorm:"type:varchar(20);not null;default:'simple'" json:"type"`
+	Description         string       `gorm:"type:text;not null" json:"description"`
+	Translations        Translations `gorm:"type:jsonb;not null;default:'{}'" json:"translations"`
+	Price               float32      `gorm:"type:decimal(10,2);not null" json:"price"`
+	IsActive            bool         `gorm:"type:boolean;not null" json:"is_active"`
+	Status              string       `gorm:"type:varchar(20);not null;default:'published';index" json:"status"`
+	PublishAt           *time.Time   `gorm:"index" json:"publish_at"`
+	UnpublishAt         *time.Time   `gorm:"index" json:"unpublish_at"`
+	IsSale              bool         `gorm:"type:boolean;not null" json:"is_sale"`
+	SalePrice           *float32     `gorm:"type:decimal(10,2)" json:"sale_price"`
+	StartSale           *time.Time   `gorm:"type:date" json:"start_sale"`
+	EndSale             *time.Time   `gorm:"type:date" json:"end_sale"`
+	PriceDropNotifiedAt *time.Time   `json:"price_drop_notified_at"`
+	IsDeleted           bool         `gorm:"type:boolean;not null;default:false" json:"is_deleted"`
+	Version             int          `gorm:"type:int;not null;default:1" json:"version"`
+	AverageRating       float32      `gorm:"type:decimal(3,2);not null;default:0" json:"average_rating"`
+	ReviewCount         int          `gorm:"type:int;not null;default:0" json:"review_count"`
+	PrimaryCategoryID   *string      `gorm:"type:char(36);index" json:"primary_category_id"`
+	CreatedAt           time.Time    `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt           time.Time    `gorm:"autoUpdateTime" json:"updated_at"`
+	CreatedByID         string       `gorm:"type:char(36);not null" json:"created_by_id"`
+	UpdatedByID         string       `gorm:"type:char(36);not null" json:"updated_by_id"`
+
+	Categories      []*Category   `gorm:"many2many:product_categories;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"categories"`
+	Tags            []*Tag        `gorm:"many2many:product_tags;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"tags"`
+	Variants        []*Variant    `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE;OnDelete:CASCADE" json:"variants"`
+	Images          []*Image      `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE;OnDelete:CASCADE" json:"images"`
+	BundleItems     []*BundleItem `gorm:"foreignKey:BundleID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"bundle_items"`
+	PrimaryCategory *Category     `gorm:"foreignKey:PrimaryCategoryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL" json:"primary_category"`
+
+	Breadcrumbs [][]*Category `gorm:"-" json:"breadcrumbs"`
+}
+
+func (m *Product) IsPublished() bool {
+	return m.Status == common.ProductStatusPublished && !m.IsDeleted
+}
+
+func (m *Product) IsBundle() bool {
+	return m.Type == common.ProductTypeBundle
+}
+
+func (m *Product) GetBundleStock() int {
+	if len(m.BundleItems) == 0 {
+		return 0
+	}
+
+	stock := -1
+	for _, item := range m.BundleItems {
+		if item.Variant == nil || item.Variant.Inventory == nil || item.Quantity <= 0 {
+			return 0
+		}
+
+		available := item.Variant.Inventory.Stock / item.Quantity
+		if stock == -1 || available < stock {
+			stock = available
+		}
+	}
+	if stock < 0 {
+		return 0
+	}
+
+	return stock
+}
+
+func (m *Product) GetEffectivePrice(now time.Time) float32 {
+	if !m.IsSale || m.SalePrice == nil {
+		return m.Price
+	}
+
+	today := now.Truncate(24 * time.Hour)
+	if m.StartSale != nil && today.Before(*m.StartSale) {
+		return m.Price
+	}
+	if m.EndSale != nil && today.After(*m.EndSale) {
+		return m.Price
+	}
+
+	return *m.SalePrice
+}
+
+func (m *Product) GetStock() int {
+	if m.IsBundle() {
+		return m.GetBundleStock()
+	}
+
+	stock := 0
+	for _, variant := range m.Variants {
+		if variant.Inventory != nil && variant.Inventory.Stock > 0 {
+			stock += variant.Inventory.Stock
+		}
+	}
+
+	return stock
+}
+
+func (m *Product) Localize(locales []string) {
+	m.localizeFields(locales)
+
+	for _, category := range m.Categories {
+		category.Localize(locales)
+	}
+	if m.PrimaryCategory != nil {
+		m.PrimaryCategory.Localize(locales)
+	}
+	for _, path := range m.Breadcrumbs {
+		for _, category := range path {
+			category.Localize(locales)
+		}
+	}
+	for _, tag := range m.Tags {
+		tag.Localize(locales)
+	}
+	for _, variant := range m.Variants {
+		variant.Localize(locales)
+	}
+	for _, item := range m.BundleItems {
+		if item.Variant != nil {
+			item.Variant.Localize(locales)
+			if item.Variant.Product != nil {
+				item.Variant.Product.localizeFields(locales)
+			}
+		}
+	}
+}
+
+func (m *Product) localizeFields(locales []string) {
+	m.Title = m.Translations.Get(locales, "title", m.Title)
+	m.Description = m.Translations.Get(locales, "description", m.Description)
 }
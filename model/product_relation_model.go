@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+type ProductRelation struct {
+	ID               string    `gorm:"type:char(36);primaryKey" json:"id"`
+	ProductID        string    `gorm:"type:char(36);uniqueIndex:product_relations_product_id_related_product_id_type_key;not null" json:"-"`
+	RelatedProductID string    `gorm:"type:char(36);uniqueIndex:product_relations_product_id_related_product_id_type_key;not null" json:"-"`
+	Type             string    `gorm:"type:varchar(20);uniqueIndex:product_relations_product_id_related_product_id_type_key;not null" json:"type"`
+	SortOrder        int       `gorm:"type:int;not null;default:0" json:"sort_order"`
+	CreatedAt        time.Time `gorm:"autoCreateTime" json:"created_at"`
+	CreatedByID      string    `gorm:"type:char(36);not null" json:"created_by_id"`
+
+	Product        *Product `gorm:"foreignKey:ProductID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	RelatedProduct *Product `gorm:"foreignKey:RelatedProductID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"related_product"`
+}
@@ -0,0 +1,180 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+)
+
+type ProductRevision struct {
+	ID          string           `gorm:"type:char(36);primaryKey" json:"id"`
+	ProductID   string           `gorm:"type:char(36);uniqueIndex:product_revisions_product_id_revision_key;not null" json:"product_id"`
+	Revision    int              `gorm:"type:int;uniqueIndex:product_revisions_product_id_revision_key;not null" json:"revision"`
+	Snapshot    *ProductSnapshot `gorm:"type:jsonb;serializer:json;not null" json:"snapshot"`
+	CreatedAt   time.Time        `gorm:"autoCreateTime" json:"created_at"`
+	CreatedByID string           `gorm:"type:char(36);not null" json:"created_by_id"`
+
+	Product *Product `gorm:"foreignKey:ProductID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
+
+type ProductSnapshot struct {
+	Title             string                `json:"title"`
+	Slug              string                `json:"slug"`
+	Description       string                `json:"description"`
+	Price             float32               `json:"price"`
+	Status            string                `json:"status"`
+	IsSale            bool                  `json:"is_sale"`
+	SalePrice         *float32              `json:"sale_price"`
+	StartSale         *time.Time            `json:"start_sale"`
+	EndSale           *time.Time            `json:"end_sale"`
+	PublishAt         *time.Time            `json:"publish_at"`
+	UnpublishAt       *time.Time            `json:"unpublish_at"`
+	PrimaryCategoryID *string               `json:"primary_category_id"`
+	CategoryIDs       []string              `json:"category_ids"`
+	TagIDs            []string              `json:"tag_ids"`
+	Variants          []*VariantSnapshot    `json:"variants"`
+	Images            []*ImageSnapshot      `json:"images"`
+	BundleItems       []*BundleItemSnapshot `json:"bundle_items"`
+	Translations      Translations          `json:"translations"`
+}
+
+type VariantSnapshot struct {
+	ID       string `json:"id"`
+	SKU      string `json:"sku"`
+	ColorID  string `json:"color_id"`
+	SizeID   string `json:"size_id"`
+	Quantity int    `json:"quantity"`
+}
+
+type ImageSnapshot struct {
+	ID          string `json:"id"`
+	ColorID     string `json:"color_id"`
+	Url         string `json:"url"`
+	SortOrder   int    `json:"sort_order"`
+	IsThumbnail bool   `json:"is_thumbnail"`
+}
+
+type BundleItemSnapshot struct {
+	VariantID string `json:"variant_id"`
+	Quantity  int    `json:"quantity"`
+}
+
+func NewProductSnapshot(product *Product) *ProductSnapshot {
+	snapshot := &ProductSnapshot{
+		Title:             product.Title,
+		Slug:              product.Slug,
+		Description:       product.Description,
+		Price:             product.Price,
+		Status:            product.Status,
+		IsSale:            product.IsSale,
+		SalePrice:         product.SalePrice,
+		StartSale:         product.StartSale,
+		EndSale:           product.EndSale,
+		PublishAt:         product.PublishAt,
+		UnpublishAt:       product.UnpublishAt,
+		PrimaryCategoryID: product.PrimaryCategoryID,
+		CategoryIDs:       make([]string, 0, len(product.Categories)),
+		TagIDs:            make([]string, 0, len(product.Tags)),
+		Variants:          make([]*VariantSnapshot, 0, len(product.Variants)),
+		Images:            make([]*ImageSnapshot, 0, len(product.Images)),
+		BundleItems:       make([]*BundleItemSnapshot, 0, len(product.BundleItems)),
+		Translations:      product.Translations,
+	}
+
+	for _, category := range product.Categories {
+		snapshot.CategoryIDs = append(snapshot.CategoryIDs, category.ID)
+	}
+	for _, tag := range product.Tags {
+		snapshot.TagIDs = append(snapshot.TagIDs, tag.ID)
+	}
+	for _, variant := range product.Variants {
+		variantSnapshot := &VariantSnapshot{
+			ID:      variant.ID,
+			SKU:     variant.SKU,
+			ColorID: variant.ColorID,
+			SizeID:  variant.SizeID,
+		}
+		if variant.Inventory != nil {
+			variantSnapshot.Quantity = variant.Inventory.Quantity
+		}
+		snapshot.Variants = append(snapshot.Variants, variantSnapshot)
+	}
+	for _, image := range product.Images {
+		snapshot.Images = append(snapshot.Images, &ImageSnapshot{
+			ID:          image.ID,
+			ColorID:     image.ColorID,
+			Url:         image.Url,
+			SortOrder:   image.SortOrder,
+			IsThumbnail: image.IsThumbnail,
+		})
+	}
+	for _, item := range product.BundleItems {
+		snapshot.BundleItems = append(snapshot.BundleItems, &BundleItemSnapshot{
+			VariantID: item.VariantID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	return snapshot
+}
+
+func (m *ProductSnapshot) Flatten() map[string]string {
+	fields := map[string]string{
+		"title":               m.Title,
+		"slug":                m.Slug,
+		"description":         m.Description,
+		"price":               formatSnapshotValue(m.Price),
+		"status":              m.Status,
+		"is_sale":             formatSnapshotValue(m.IsSale),
+		"sale_price":          formatSnapshotValue(m.SalePrice),
+		"start_sale":          formatSnapshotValue(m.StartSale),
+		"end_sale":            formatSnapshotValue(m.EndSale),
+		"publish_at":          formatSnapshotValue(m.PublishAt),
+		"unpublish_at":        formatSnapshotValue(m.UnpublishAt),
+		"primary_category_id": formatSnapshotValue(m.PrimaryCategoryID),
+		"category_ids":        formatSnapshotIDs(m.CategoryIDs),
+		"tag_ids":             formatSnapshotIDs(m.TagIDs),
+	}
+
+	for _, variant := range m.Variants {
+		prefix := fmt.Sprintf("variants[%s].", variant.ID)
+		fields[prefix+"sku"] = variant.SKU
+		fields[prefix+"color_id"] = variant.ColorID
+		fields[prefix+"size_id"] = variant.SizeID
+		fields[prefix+"quantity"] = formatSnapshotValue(variant.Quantity)
+	}
+
+	for _, image := range m.Images {
+		prefix := fmt.Sprintf("images[%s].", image.ID)
+		fields[prefix+"color_id"] = image.ColorID
+		fields[prefix+"url"] = image.Url
+		fields[prefix+"sort_order"] = formatSnapshotValue(image.SortOrder)
+		fields[prefix+"is_thumbnail"] = formatSnapshotValue(image.IsThumbnail)
+	}
+
+	for _, item := range m.BundleItems {
+		fields[fmt.Sprintf("bundle_items[%s].quantity", item.VariantID)] = formatSnapshotValue(item.Quantity)
+	}
+
+	for locale, translation := range m.Translations {
+		for field, value := range translation {
+			fields[fmt.Sprintf("translations[%s].%s", locale, field)] = value
+		}
+	}
+
+	return fields
+}
+
+func formatSnapshotValue(value any) string {
+	data, _ := sonic.Marshal(value)
+	return string(data)
+}
+
+func formatSnapshotIDs(ids []string) string {
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+	return strings.Join(sorted, ",")
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+type ProductSlugHistory struct {
+	ID        string    `gorm:"type:char(36);primaryKey" json:"id"`
+	ProductID string    `gorm:"type:char(36);index;not null" json:"-"`
+	Slug      string    `gorm:"type:varchar(255);uniqueIndex:product_slug_history_slug_key;not null" json:"slug"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+
+	Product *Product `gorm:"foreignKey:ProductID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"product"`
+}
+
+func (ProductSlugHistory) TableName() string {
+	return "product_slug_history"
+}
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+type Question struct {
+	ID            string     `gorm:"type:char(36);primaryKey" json:"id"`
+	ProductID     string     `gorm:"type:char(36);index;not null" json:"product_id"`
+	UserID        string     `gorm:"type:char(36);not null" json:"user_id"`
+	Content       string     `gorm:"type:text;not null" json:"content"`
+	Status        string     `gorm:"type:varchar(20);index;not null;default:'pending'" json:"status"`
+	UpvoteCount   int        `gorm:"type:int;not null;default:0" json:"upvote_count"`
+	AnswerCount   int        `gorm:"type:int;not null;default:0" json:"answer_count"`
+	ModeratedByID *string    `gorm:"type:char(36)" json:"moderated_by_id"`
+	ModeratedAt   *time.Time `json:"moderated_at"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt     time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Product *Product  `gorm:"foreignKey:ProductID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Answers []*Answer `gorm:"foreignKey:QuestionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"answers"`
+}
+
+type QuestionVote struct {
+	QuestionID string    `gorm:"type:char(36);primaryKey" json:"question_id"`
+	UserID     string    `gorm:"type:char(36);primaryKey" json:"user_id"`
+	CreatedAt  time.Time `gorm:"autoCreateTime" json:"created_at"`
+
+	Question *Question `gorm:"foreignKey:QuestionID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
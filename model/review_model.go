@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+type Review struct {
+	ID                 string     `gorm:"type:char(36);primaryKey" json:"id"`
+	ProductID          string     `gorm:"type:char(36);uniqueIndex:reviews_product_id_user_id_key;not null" json:"product_id"`
+	UserID             string     `gorm:"type:char(36);uniqueIndex:reviews_product_id_user_id_key;not null" json:"user_id"`
+	Rating             int        `gorm:"type:smallint;not null" json:"rating"`
+	Title              string     `gorm:"type:varchar(255);not null" json:"title"`
+	Content            string     `gorm:"type:text;not null" json:"content"`
+	IsVerifiedPurchase bool       `gorm:"type:boolean;not null;default:false" json:"is_verified_purchase"`
+	Status             string     `gorm:"type:varchar(20);index;not null;default:'pending'" json:"status"`
+	ModeratedByID      *string    `gorm:"type:char(36)" json:"moderated_by_id"`
+	ModeratedAt        *time.Time `json:"moderated_at"`
+	CreatedAt          time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt          time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Product *Product `gorm:"foreignKey:ProductID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
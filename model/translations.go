@@ -0,0 +1,76 @@
+package model
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/bytedance/sonic"
+)
+
+type Translations map[string]map[string]string
+
+func (t Translations) Value() (driver.Value, error) {
+	if t == nil {
+		return "{}", nil
+	}
+
+	data, err := sonic.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+func (t *Translations) Scan(value any) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*t = Translations{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("không thể đọc bản dịch từ kiểu %T", value)
+	}
+
+	translations := Translations{}
+	if err := sonic.Unmarshal(data, &translations); err != nil {
+		return err
+	}
+	*t = translations
+
+	return nil
+}
+
+func (t Translations) Get(locales []string, field, fallback string) string {
+	for _, locale := range locales {
+		if locale == common.DefaultLocale {
+			return fallback
+		}
+		if value := t[locale][field]; value != "" {
+			return value
+		}
+	}
+
+	return fallback
+}
+
+func (t Translations) Merge(updates Translations) Translations {
+	merged := Translations{}
+	for locale, fields := range t {
+		merged[locale] = fields
+	}
+	for locale, fields := range updates {
+		if len(fields) == 0 {
+			delete(merged, locale)
+			continue
+		}
+		merged[locale] = fields
+	}
+
+	return merged
+}
@@ -12,3 +12,12 @@ type Variant struct {
 	Size      *Size      `gorm:"foreignKey:SizeID;references:ID;constraint:OnUpdate:CASCADE;OnDelete:CASCADE" json:"size"`
 	Inventory *Inventory `gorm:"foreignKey:VariantID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"inventory"`
 }
+
+func (m *Variant) Localize(locales []string) {
+	if m.Color != nil {
+		m.Color.Localize(locales)
+	}
+	if m.Size != nil {
+		m.Size.Localize(locales)
+	}
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+type Wishlist struct {
+	ID        string    `gorm:"type:char(36);primaryKey" json:"id"`
+	UserID    string    `gorm:"type:char(36);uniqueIndex:wishlists_user_id_product_id_variant_id_key,option:NULLS NOT DISTINCT;not null" json:"user_id"`
+	ProductID string    `gorm:"type:char(36);index;uniqueIndex:wishlists_user_id_product_id_variant_id_key;not null" json:"-"`
+	VariantID *string   `gorm:"type:char(36);uniqueIndex:wishlists_user_id_product_id_variant_id_key" json:"-"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+
+	Product *Product `gorm:"foreignKey:ProductID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"product"`
+	Variant *Variant `gorm:"foreignKey:VariantID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"variant"`
+}
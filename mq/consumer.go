@@ -1,17 +1,19 @@
 package mq
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"sync"
 
+	"github.com/SomeHowMicroservice/product/cache"
 	"github.com/SomeHowMicroservice/product/common"
 	"github.com/SomeHowMicroservice/product/imagekit"
+	categoryRepo "github.com/SomeHowMicroservice/product/repository/category"
 	imageRepo "github.com/SomeHowMicroservice/product/repository/image"
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/bytedance/sonic"
+	"gorm.io/gorm"
 )
 
 var (
@@ -31,7 +33,7 @@ func RegisterDeleteImageConsumer(router *message.Router, subscriber message.Subs
 	)
 }
 
-func RegisterUploadImageConsumer(router *message.Router, publisher message.Publisher, subscriber message.Subscriber, imagekit imagekit.ImageKitService, imageRepo imageRepo.ImageRepository) {
+func RegisterUploadImageConsumer(router *message.Router, publisher message.Publisher, subscriber message.Subscriber, imagekit imagekit.ImageKitService, imageRepo imageRepo.ImageRepository, categoryRepo categoryRepo.CategoryRepository, cacheService cache.CacheService) {
 	router.AddHandler(
 		"upload_image_handler",
 		common.UploadTopic,
@@ -44,8 +46,14 @@ func RegisterUploadImageConsumer(router *message.Router, publisher message.Publi
 				return nil, fmt.Errorf("unmarshal json thất bại: %w", err)
 			}
 
-			ctx := context.Background()
-			res, err := imagekit.UploadFromBase64(ctx, imageMsg)
+			ctx := msg.Context()
+			var res *common.UploadFileResponse
+			var err error
+			if imageMsg.SourceURL != "" {
+				res, err = imagekit.UploadFromURL(ctx, imageMsg)
+			} else {
+				res, err = imagekit.UploadFromBase64(ctx, imageMsg)
+			}
 			if err != nil {
 				return nil, fmt.Errorf("upload image thất bại: %w", err)
 			}
@@ -53,6 +61,22 @@ func RegisterUploadImageConsumer(router *message.Router, publisher message.Publi
 
 			fileID := res.FileID
 			url := res.URL
+
+			if imageMsg.CategoryID != "" {
+				updateData := map[string]any{
+					"banner_file_id": fileID,
+					"banner_url":     url,
+					"version":        gorm.Expr("version + 1"),
+				}
+				if err = categoryRepo.Update(ctx, imageMsg.CategoryID, updateData); err != nil {
+					return nil, fmt.Errorf("cập nhật database thất bại: %w", err)
+				}
+				cache.InvalidateCategoryCache(ctx, cacheService)
+				log.Printf("Cập nhật banner danh mục %s có FileID: %s và url: %s thành công", imageMsg.CategoryID, fileID, url)
+
+				return nil, nil
+			}
+
 			updateData := map[string]any{
 				"file_id": fileID,
 				"url":     url,
@@ -97,7 +121,7 @@ func RegisterUploadImageConsumer(router *message.Router, publisher message.Publi
 func handleDeleteImage(msg *message.Message, imagekit imagekit.ImageKitService) error {
 	fileID := string(msg.Payload)
 
-	ctx := context.Background()
+	ctx := msg.Context()
 
 	if err := imagekit.DeleteFile(ctx, fileID); err != nil {
 		return fmt.Errorf("xóa file thất bại: %w", err)
@@ -1,11 +1,16 @@
 package mq
 
 import (
+	"context"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/tracing"
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bytedance/sonic"
 )
 
-func PublishMessage(publisher message.Publisher, topic string, payload []byte) error {
+func PublishMessage(ctx context.Context, publisher message.Publisher, topic string, payload []byte) error {
 	msg := message.NewMessage(
 		watermill.NewUUID(),
 		payload,
@@ -13,5 +18,34 @@ func PublishMessage(publisher message.Publisher, topic string, payload []byte) e
 
 	msg.Metadata.Set("content-type", "application/json")
 
-	return publisher.Publish(topic, msg)
-}
\ No newline at end of file
+	span := tracing.StartPublishSpan(ctx, topic, msg)
+	defer span.End()
+
+	err := publisher.Publish(topic, msg)
+	tracing.RecordError(span, err)
+
+	return err
+}
+
+func PublishProductStatusChanged(ctx context.Context, publisher message.Publisher, event *common.ProductStatusChangedEvent) error {
+	body, err := sonic.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	topic := common.ProductUnpublishedTopic
+	if event.Status == common.ProductStatusPublished {
+		topic = common.ProductPublishedTopic
+	}
+
+	return PublishMessage(ctx, publisher, topic, body)
+}
+
+func PublishPriceDrop(ctx context.Context, publisher message.Publisher, event *common.PriceDropEvent) error {
+	body, err := sonic.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return PublishMessage(ctx, publisher, common.PriceDropTopic, body)
+}
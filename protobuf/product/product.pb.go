@@ -7,6 +7,7 @@
 package __
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -21,6 +22,4314 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type GetAuditLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Order         string                 `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Action        string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	EntityType    string                 `protobuf:"bytes,6,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId      string                 `protobuf:"bytes,7,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	From          *string                `protobuf:"bytes,9,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To            *string                `protobuf:"bytes,10,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditLogsRequest) Reset() {
+	*x = GetAuditLogsRequest{}
+	mi := &file_proto_product_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogsRequest) ProtoMessage() {}
+
+func (x *GetAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetAuditLogsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetAuditLogsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAuditLogsRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *GetAuditLogsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *GetAuditLogsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *GetAuditLogsRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *GetAuditLogsRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *GetAuditLogsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *GetAuditLogsRequest) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *GetAuditLogsRequest) GetTo() string {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return ""
+}
+
+type AuditLogChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	FromValue     string                 `protobuf:"bytes,2,opt,name=from_value,json=fromValue,proto3" json:"from_value,omitempty"`
+	ToValue       string                 `protobuf:"bytes,3,opt,name=to_value,json=toValue,proto3" json:"to_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogChangeResponse) Reset() {
+	*x = AuditLogChangeResponse{}
+	mi := &file_proto_product_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogChangeResponse) ProtoMessage() {}
+
+func (x *AuditLogChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogChangeResponse.ProtoReflect.Descriptor instead.
+func (*AuditLogChangeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuditLogChangeResponse) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AuditLogChangeResponse) GetFromValue() string {
+	if x != nil {
+		return x.FromValue
+	}
+	return ""
+}
+
+func (x *AuditLogChangeResponse) GetToValue() string {
+	if x != nil {
+		return x.ToValue
+	}
+	return ""
+}
+
+type AuditLogResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Id            string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RequestId     string                    `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Action        string                    `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	EntityType    string                    `protobuf:"bytes,4,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId      string                    `protobuf:"bytes,5,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Method        string                    `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"`
+	Changes       []*AuditLogChangeResponse `protobuf:"bytes,7,rep,name=changes,proto3" json:"changes,omitempty"`
+	CreatedAt     string                    `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Actor         *BaseUserResponse         `protobuf:"bytes,9,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogResponse) Reset() {
+	*x = AuditLogResponse{}
+	mi := &file_proto_product_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogResponse) ProtoMessage() {}
+
+func (x *AuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogResponse.ProtoReflect.Descriptor instead.
+func (*AuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuditLogResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditLogResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditLogResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditLogResponse) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *AuditLogResponse) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *AuditLogResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditLogResponse) GetChanges() []*AuditLogChangeResponse {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *AuditLogResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AuditLogResponse) GetActor() *BaseUserResponse {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+type AuditLogsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Logs          []*AuditLogResponse     `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Meta          *PaginationMetaResponse `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogsResponse) Reset() {
+	*x = AuditLogsResponse{}
+	mi := &file_proto_product_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogsResponse) ProtoMessage() {}
+
+func (x *AuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*AuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AuditLogsResponse) GetLogs() []*AuditLogResponse {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *AuditLogsResponse) GetMeta() *PaginationMetaResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type GetProductRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Page          uint32                 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRevisionsRequest) Reset() {
+	*x = GetProductRevisionsRequest{}
+	mi := &file_proto_product_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRevisionsRequest) ProtoMessage() {}
+
+func (x *GetProductRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetProductRevisionsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetProductRevisionsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetProductRevisionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ProductRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revision      uint32                 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Slug          string                 `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy     *BaseUserResponse      `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductRevisionResponse) Reset() {
+	*x = ProductRevisionResponse{}
+	mi := &file_proto_product_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductRevisionResponse) ProtoMessage() {}
+
+func (x *ProductRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductRevisionResponse.ProtoReflect.Descriptor instead.
+func (*ProductRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProductRevisionResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductRevisionResponse) GetRevision() uint32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ProductRevisionResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ProductRevisionResponse) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ProductRevisionResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ProductRevisionResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ProductRevisionResponse) GetCreatedBy() *BaseUserResponse {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+type ProductRevisionsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Revisions     []*ProductRevisionResponse `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	Meta          *PaginationMetaResponse    `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductRevisionsResponse) Reset() {
+	*x = ProductRevisionsResponse{}
+	mi := &file_proto_product_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductRevisionsResponse) ProtoMessage() {}
+
+func (x *ProductRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ProductRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProductRevisionsResponse) GetRevisions() []*ProductRevisionResponse {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ProductRevisionsResponse) GetMeta() *PaginationMetaResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type DiffProductRevisionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	FromRevisionId string                 `protobuf:"bytes,2,opt,name=from_revision_id,json=fromRevisionId,proto3" json:"from_revision_id,omitempty"`
+	ToRevisionId   string                 `protobuf:"bytes,3,opt,name=to_revision_id,json=toRevisionId,proto3" json:"to_revision_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DiffProductRevisionsRequest) Reset() {
+	*x = DiffProductRevisionsRequest{}
+	mi := &file_proto_product_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffProductRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffProductRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffProductRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffProductRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffProductRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DiffProductRevisionsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DiffProductRevisionsRequest) GetFromRevisionId() string {
+	if x != nil {
+		return x.FromRevisionId
+	}
+	return ""
+}
+
+func (x *DiffProductRevisionsRequest) GetToRevisionId() string {
+	if x != nil {
+		return x.ToRevisionId
+	}
+	return ""
+}
+
+type ProductRevisionFieldDiffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	FromValue     *string                `protobuf:"bytes,2,opt,name=from_value,json=fromValue,proto3,oneof" json:"from_value,omitempty"`
+	ToValue       *string                `protobuf:"bytes,3,opt,name=to_value,json=toValue,proto3,oneof" json:"to_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductRevisionFieldDiffResponse) Reset() {
+	*x = ProductRevisionFieldDiffResponse{}
+	mi := &file_proto_product_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductRevisionFieldDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductRevisionFieldDiffResponse) ProtoMessage() {}
+
+func (x *ProductRevisionFieldDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductRevisionFieldDiffResponse.ProtoReflect.Descriptor instead.
+func (*ProductRevisionFieldDiffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProductRevisionFieldDiffResponse) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ProductRevisionFieldDiffResponse) GetFromValue() string {
+	if x != nil && x.FromValue != nil {
+		return *x.FromValue
+	}
+	return ""
+}
+
+func (x *ProductRevisionFieldDiffResponse) GetToValue() string {
+	if x != nil && x.ToValue != nil {
+		return *x.ToValue
+	}
+	return ""
+}
+
+type ProductRevisionDiffResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	ProductId     string                              `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	FromRevision  uint32                              `protobuf:"varint,2,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	ToRevision    uint32                              `protobuf:"varint,3,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	Changes       []*ProductRevisionFieldDiffResponse `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductRevisionDiffResponse) Reset() {
+	*x = ProductRevisionDiffResponse{}
+	mi := &file_proto_product_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductRevisionDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductRevisionDiffResponse) ProtoMessage() {}
+
+func (x *ProductRevisionDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductRevisionDiffResponse.ProtoReflect.Descriptor instead.
+func (*ProductRevisionDiffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProductRevisionDiffResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductRevisionDiffResponse) GetFromRevision() uint32 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *ProductRevisionDiffResponse) GetToRevision() uint32 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+func (x *ProductRevisionDiffResponse) GetChanges() []*ProductRevisionFieldDiffResponse {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type RollbackProductRevisionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	RevisionId       string                 `protobuf:"bytes,2,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	UserId           string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RestoreLifecycle bool                   `protobuf:"varint,4,opt,name=restore_lifecycle,json=restoreLifecycle,proto3" json:"restore_lifecycle,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RollbackProductRevisionRequest) Reset() {
+	*x = RollbackProductRevisionRequest{}
+	mi := &file_proto_product_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackProductRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackProductRevisionRequest) ProtoMessage() {}
+
+func (x *RollbackProductRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackProductRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RollbackProductRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RollbackProductRevisionRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RollbackProductRevisionRequest) GetRevisionId() string {
+	if x != nil {
+		return x.RevisionId
+	}
+	return ""
+}
+
+func (x *RollbackProductRevisionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RollbackProductRevisionRequest) GetRestoreLifecycle() bool {
+	if x != nil {
+		return x.RestoreLifecycle
+	}
+	return false
+}
+
+type DuplicateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Slug          *string                `protobuf:"bytes,3,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	SlugMode      string                 `protobuf:"bytes,4,opt,name=slug_mode,json=slugMode,proto3" json:"slug_mode,omitempty"`
+	SkuSuffix     string                 `protobuf:"bytes,5,opt,name=sku_suffix,json=skuSuffix,proto3" json:"sku_suffix,omitempty"`
+	SkuTemplate   string                 `protobuf:"bytes,6,opt,name=sku_template,json=skuTemplate,proto3" json:"sku_template,omitempty"`
+	UserId        string                 `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateProductRequest) Reset() {
+	*x = DuplicateProductRequest{}
+	mi := &file_proto_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateProductRequest) ProtoMessage() {}
+
+func (x *DuplicateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateProductRequest.ProtoReflect.Descriptor instead.
+func (*DuplicateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DuplicateProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DuplicateProductRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *DuplicateProductRequest) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *DuplicateProductRequest) GetSlugMode() string {
+	if x != nil {
+		return x.SlugMode
+	}
+	return ""
+}
+
+func (x *DuplicateProductRequest) GetSkuSuffix() string {
+	if x != nil {
+		return x.SkuSuffix
+	}
+	return ""
+}
+
+func (x *DuplicateProductRequest) GetSkuTemplate() string {
+	if x != nil {
+		return x.SkuTemplate
+	}
+	return ""
+}
+
+func (x *DuplicateProductRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetCategoryBySlugRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryBySlugRequest) Reset() {
+	*x = GetCategoryBySlugRequest{}
+	mi := &file_proto_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryBySlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryBySlugRequest) ProtoMessage() {}
+
+func (x *GetCategoryBySlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryBySlugRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryBySlugRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetCategoryBySlugRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type CategoryPublicDetailsResponse struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	Id               string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug             string                  `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description      string                  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	BannerUrl        string                  `protobuf:"bytes,5,opt,name=banner_url,json=bannerUrl,proto3" json:"banner_url,omitempty"`
+	MetaTitle        string                  `protobuf:"bytes,6,opt,name=meta_title,json=metaTitle,proto3" json:"meta_title,omitempty"`
+	MetaDescription  string                  `protobuf:"bytes,7,opt,name=meta_description,json=metaDescription,proto3" json:"meta_description,omitempty"`
+	Breadcrumbs      []*BaseCategoryResponse `protobuf:"bytes,8,rep,name=breadcrumbs,proto3" json:"breadcrumbs,omitempty"`
+	Children         []*BaseCategoryResponse `protobuf:"bytes,9,rep,name=children,proto3" json:"children,omitempty"`
+	FeaturedProducts []*BaseProductResponse  `protobuf:"bytes,10,rep,name=featured_products,json=featuredProducts,proto3" json:"featured_products,omitempty"`
+	RedirectTo       *string                 `protobuf:"bytes,11,opt,name=redirect_to,json=redirectTo,proto3,oneof" json:"redirect_to,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CategoryPublicDetailsResponse) Reset() {
+	*x = CategoryPublicDetailsResponse{}
+	mi := &file_proto_product_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryPublicDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryPublicDetailsResponse) ProtoMessage() {}
+
+func (x *CategoryPublicDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryPublicDetailsResponse.ProtoReflect.Descriptor instead.
+func (*CategoryPublicDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CategoryPublicDetailsResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CategoryPublicDetailsResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryPublicDetailsResponse) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CategoryPublicDetailsResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CategoryPublicDetailsResponse) GetBannerUrl() string {
+	if x != nil {
+		return x.BannerUrl
+	}
+	return ""
+}
+
+func (x *CategoryPublicDetailsResponse) GetMetaTitle() string {
+	if x != nil {
+		return x.MetaTitle
+	}
+	return ""
+}
+
+func (x *CategoryPublicDetailsResponse) GetMetaDescription() string {
+	if x != nil {
+		return x.MetaDescription
+	}
+	return ""
+}
+
+func (x *CategoryPublicDetailsResponse) GetBreadcrumbs() []*BaseCategoryResponse {
+	if x != nil {
+		return x.Breadcrumbs
+	}
+	return nil
+}
+
+func (x *CategoryPublicDetailsResponse) GetChildren() []*BaseCategoryResponse {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *CategoryPublicDetailsResponse) GetFeaturedProducts() []*BaseProductResponse {
+	if x != nil {
+		return x.FeaturedProducts
+	}
+	return nil
+}
+
+func (x *CategoryPublicDetailsResponse) GetRedirectTo() string {
+	if x != nil && x.RedirectTo != nil {
+		return *x.RedirectTo
+	}
+	return ""
+}
+
+type MoveCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromParentId  *string                `protobuf:"bytes,2,opt,name=from_parent_id,json=fromParentId,proto3,oneof" json:"from_parent_id,omitempty"`
+	ToParentId    *string                `protobuf:"bytes,3,opt,name=to_parent_id,json=toParentId,proto3,oneof" json:"to_parent_id,omitempty"`
+	Position      uint32                 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCategoryRequest) Reset() {
+	*x = MoveCategoryRequest{}
+	mi := &file_proto_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCategoryRequest) ProtoMessage() {}
+
+func (x *MoveCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCategoryRequest.ProtoReflect.Descriptor instead.
+func (*MoveCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MoveCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveCategoryRequest) GetFromParentId() string {
+	if x != nil && x.FromParentId != nil {
+		return *x.FromParentId
+	}
+	return ""
+}
+
+func (x *MoveCategoryRequest) GetToParentId() string {
+	if x != nil && x.ToParentId != nil {
+		return *x.ToParentId
+	}
+	return ""
+}
+
+func (x *MoveCategoryRequest) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *MoveCategoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReorderCategoryChildrenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParentId      string                 `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	ChildIds      []string               `protobuf:"bytes,2,rep,name=child_ids,json=childIds,proto3" json:"child_ids,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCategoryChildrenRequest) Reset() {
+	*x = ReorderCategoryChildrenRequest{}
+	mi := &file_proto_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCategoryChildrenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCategoryChildrenRequest) ProtoMessage() {}
+
+func (x *ReorderCategoryChildrenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCategoryChildrenRequest.ProtoReflect.Descriptor instead.
+func (*ReorderCategoryChildrenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReorderCategoryChildrenRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *ReorderCategoryChildrenRequest) GetChildIds() []string {
+	if x != nil {
+		return x.ChildIds
+	}
+	return nil
+}
+
+func (x *ReorderCategoryChildrenRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CategoriesAdminResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Categories    []*CategoryAdminResponse `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoriesAdminResponse) Reset() {
+	*x = CategoriesAdminResponse{}
+	mi := &file_proto_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoriesAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoriesAdminResponse) ProtoMessage() {}
+
+func (x *CategoriesAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoriesAdminResponse.ProtoReflect.Descriptor instead.
+func (*CategoriesAdminResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CategoriesAdminResponse) GetCategories() []*CategoryAdminResponse {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type AddToWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     *string                `protobuf:"bytes,3,opt,name=variant_id,json=variantId,proto3,oneof" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToWishlistRequest) Reset() {
+	*x = AddToWishlistRequest{}
+	mi := &file_proto_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToWishlistRequest) ProtoMessage() {}
+
+func (x *AddToWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToWishlistRequest.ProtoReflect.Descriptor instead.
+func (*AddToWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AddToWishlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddToWishlistRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AddToWishlistRequest) GetVariantId() string {
+	if x != nil && x.VariantId != nil {
+		return *x.VariantId
+	}
+	return ""
+}
+
+type RemoveFromWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromWishlistRequest) Reset() {
+	*x = RemoveFromWishlistRequest{}
+	mi := &file_proto_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromWishlistRequest) ProtoMessage() {}
+
+func (x *RemoveFromWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromWishlistRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RemoveFromWishlistRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveFromWishlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWishlistRequest) Reset() {
+	*x = GetWishlistRequest{}
+	mi := &file_proto_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWishlistRequest) ProtoMessage() {}
+
+func (x *GetWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWishlistRequest.ProtoReflect.Descriptor instead.
+func (*GetWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetWishlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type WishlistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Product       *BaseProductResponse   `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	Variant       *BaseVariantResponse   `protobuf:"bytes,3,opt,name=variant,proto3" json:"variant,omitempty"`
+	Price         float32                `protobuf:"fixed32,4,opt,name=price,proto3" json:"price,omitempty"`
+	OriginalPrice float32                `protobuf:"fixed32,5,opt,name=original_price,json=originalPrice,proto3" json:"original_price,omitempty"`
+	IsSale        *bool                  `protobuf:"varint,6,opt,name=is_sale,json=isSale,proto3,oneof" json:"is_sale,omitempty"`
+	Stock         int64                  `protobuf:"varint,7,opt,name=stock,proto3" json:"stock,omitempty"`
+	IsStock       *bool                  `protobuf:"varint,8,opt,name=is_stock,json=isStock,proto3,oneof" json:"is_stock,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WishlistItemResponse) Reset() {
+	*x = WishlistItemResponse{}
+	mi := &file_proto_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WishlistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistItemResponse) ProtoMessage() {}
+
+func (x *WishlistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistItemResponse.ProtoReflect.Descriptor instead.
+func (*WishlistItemResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WishlistItemResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WishlistItemResponse) GetProduct() *BaseProductResponse {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *WishlistItemResponse) GetVariant() *BaseVariantResponse {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+func (x *WishlistItemResponse) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *WishlistItemResponse) GetOriginalPrice() float32 {
+	if x != nil {
+		return x.OriginalPrice
+	}
+	return 0
+}
+
+func (x *WishlistItemResponse) GetIsSale() bool {
+	if x != nil && x.IsSale != nil {
+		return *x.IsSale
+	}
+	return false
+}
+
+func (x *WishlistItemResponse) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *WishlistItemResponse) GetIsStock() bool {
+	if x != nil && x.IsStock != nil {
+		return *x.IsStock
+	}
+	return false
+}
+
+func (x *WishlistItemResponse) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *WishlistItemResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type WishlistResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Items         []*WishlistItemResponse `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WishlistResponse) Reset() {
+	*x = WishlistResponse{}
+	mi := &file_proto_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistResponse) ProtoMessage() {}
+
+func (x *WishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistResponse.ProtoReflect.Descriptor instead.
+func (*WishlistResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WishlistResponse) GetItems() []*WishlistItemResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreateQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQuestionRequest) Reset() {
+	*x = CreateQuestionRequest{}
+	mi := &file_proto_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuestionRequest) ProtoMessage() {}
+
+func (x *CreateQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuestionRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateQuestionRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CreateAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    string                 `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAnswerRequest) Reset() {
+	*x = CreateAnswerRequest{}
+	mi := &file_proto_product_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAnswerRequest) ProtoMessage() {}
+
+func (x *CreateAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAnswerRequest.ProtoReflect.Descriptor instead.
+func (*CreateAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateAnswerRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *CreateAnswerRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateAnswerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UpvoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpvoteRequest) Reset() {
+	*x = UpvoteRequest{}
+	mi := &file_proto_product_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpvoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpvoteRequest) ProtoMessage() {}
+
+func (x *UpvoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpvoteRequest.ProtoReflect.Descriptor instead.
+func (*UpvoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpvoteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpvoteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetProductQuestionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Page          uint32                 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Sort          string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	Order         string                 `protobuf:"bytes,5,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductQuestionsRequest) Reset() {
+	*x = GetProductQuestionsRequest{}
+	mi := &file_proto_product_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductQuestionsRequest) ProtoMessage() {}
+
+func (x *GetProductQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*GetProductQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetProductQuestionsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetProductQuestionsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetProductQuestionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetProductQuestionsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetProductQuestionsRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+type GetQuestionAnswersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    string                 `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Page          uint32                 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Sort          string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	Order         string                 `protobuf:"bytes,5,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuestionAnswersRequest) Reset() {
+	*x = GetQuestionAnswersRequest{}
+	mi := &file_proto_product_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuestionAnswersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionAnswersRequest) ProtoMessage() {}
+
+func (x *GetQuestionAnswersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionAnswersRequest.ProtoReflect.Descriptor instead.
+func (*GetQuestionAnswersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetQuestionAnswersRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *GetQuestionAnswersRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetQuestionAnswersRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetQuestionAnswersRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetQuestionAnswersRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+type GetAllQuestionsAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Sort          string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	Order         string                 `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`
+	Search        string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+	ProductId     string                 `protobuf:"bytes,6,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllQuestionsAdminRequest) Reset() {
+	*x = GetAllQuestionsAdminRequest{}
+	mi := &file_proto_product_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllQuestionsAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllQuestionsAdminRequest) ProtoMessage() {}
+
+func (x *GetAllQuestionsAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllQuestionsAdminRequest.ProtoReflect.Descriptor instead.
+func (*GetAllQuestionsAdminRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetAllQuestionsAdminRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetAllQuestionsAdminRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAllQuestionsAdminRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetAllQuestionsAdminRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *GetAllQuestionsAdminRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetAllQuestionsAdminRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetAllQuestionsAdminRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetAllAnswersAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Sort          string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	Order         string                 `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`
+	Search        string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,6,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllAnswersAdminRequest) Reset() {
+	*x = GetAllAnswersAdminRequest{}
+	mi := &file_proto_product_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllAnswersAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllAnswersAdminRequest) ProtoMessage() {}
+
+func (x *GetAllAnswersAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllAnswersAdminRequest.ProtoReflect.Descriptor instead.
+func (*GetAllAnswersAdminRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetAllAnswersAdminRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetAllAnswersAdminRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAllAnswersAdminRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetAllAnswersAdminRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *GetAllAnswersAdminRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetAllAnswersAdminRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *GetAllAnswersAdminRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ModerateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateRequest) Reset() {
+	*x = ModerateRequest{}
+	mi := &file_proto_product_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateRequest) ProtoMessage() {}
+
+func (x *ModerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateRequest.ProtoReflect.Descriptor instead.
+func (*ModerateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ModerateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerateRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ModerateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type QuestionPublicResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	UpvoteCount   uint32                 `protobuf:"varint,3,opt,name=upvote_count,json=upvoteCount,proto3" json:"upvote_count,omitempty"`
+	AnswerCount   uint32                 `protobuf:"varint,4,opt,name=answer_count,json=answerCount,proto3" json:"answer_count,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	User          *BaseUserResponse      `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionPublicResponse) Reset() {
+	*x = QuestionPublicResponse{}
+	mi := &file_proto_product_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionPublicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionPublicResponse) ProtoMessage() {}
+
+func (x *QuestionPublicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionPublicResponse.ProtoReflect.Descriptor instead.
+func (*QuestionPublicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *QuestionPublicResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuestionPublicResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *QuestionPublicResponse) GetUpvoteCount() uint32 {
+	if x != nil {
+		return x.UpvoteCount
+	}
+	return 0
+}
+
+func (x *QuestionPublicResponse) GetAnswerCount() uint32 {
+	if x != nil {
+		return x.AnswerCount
+	}
+	return 0
+}
+
+func (x *QuestionPublicResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *QuestionPublicResponse) GetUser() *BaseUserResponse {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type QuestionsPublicResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Questions     []*QuestionPublicResponse `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	Meta          *PaginationMetaResponse   `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionsPublicResponse) Reset() {
+	*x = QuestionsPublicResponse{}
+	mi := &file_proto_product_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionsPublicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionsPublicResponse) ProtoMessage() {}
+
+func (x *QuestionsPublicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionsPublicResponse.ProtoReflect.Descriptor instead.
+func (*QuestionsPublicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *QuestionsPublicResponse) GetQuestions() []*QuestionPublicResponse {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *QuestionsPublicResponse) GetMeta() *PaginationMetaResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type AnswerPublicResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	IsStaff       *bool                  `protobuf:"varint,3,opt,name=is_staff,json=isStaff,proto3,oneof" json:"is_staff,omitempty"`
+	UpvoteCount   uint32                 `protobuf:"varint,4,opt,name=upvote_count,json=upvoteCount,proto3" json:"upvote_count,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	User          *BaseUserResponse      `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerPublicResponse) Reset() {
+	*x = AnswerPublicResponse{}
+	mi := &file_proto_product_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerPublicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerPublicResponse) ProtoMessage() {}
+
+func (x *AnswerPublicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerPublicResponse.ProtoReflect.Descriptor instead.
+func (*AnswerPublicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AnswerPublicResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AnswerPublicResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *AnswerPublicResponse) GetIsStaff() bool {
+	if x != nil && x.IsStaff != nil {
+		return *x.IsStaff
+	}
+	return false
+}
+
+func (x *AnswerPublicResponse) GetUpvoteCount() uint32 {
+	if x != nil {
+		return x.UpvoteCount
+	}
+	return 0
+}
+
+func (x *AnswerPublicResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AnswerPublicResponse) GetUser() *BaseUserResponse {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type AnswersPublicResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Answers       []*AnswerPublicResponse `protobuf:"bytes,1,rep,name=answers,proto3" json:"answers,omitempty"`
+	Meta          *PaginationMetaResponse `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswersPublicResponse) Reset() {
+	*x = AnswersPublicResponse{}
+	mi := &file_proto_product_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswersPublicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswersPublicResponse) ProtoMessage() {}
+
+func (x *AnswersPublicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswersPublicResponse.ProtoReflect.Descriptor instead.
+func (*AnswersPublicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AnswersPublicResponse) GetAnswers() []*AnswerPublicResponse {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *AnswersPublicResponse) GetMeta() *PaginationMetaResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type QuestionAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	UpvoteCount   uint32                 `protobuf:"varint,5,opt,name=upvote_count,json=upvoteCount,proto3" json:"upvote_count,omitempty"`
+	AnswerCount   uint32                 `protobuf:"varint,6,opt,name=answer_count,json=answerCount,proto3" json:"answer_count,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	User          *BaseUserResponse      `protobuf:"bytes,9,opt,name=user,proto3" json:"user,omitempty"`
+	ModeratedBy   *BaseUserResponse      `protobuf:"bytes,10,opt,name=moderated_by,json=moderatedBy,proto3" json:"moderated_by,omitempty"`
+	ModeratedAt   *string                `protobuf:"bytes,11,opt,name=moderated_at,json=moderatedAt,proto3,oneof" json:"moderated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionAdminResponse) Reset() {
+	*x = QuestionAdminResponse{}
+	mi := &file_proto_product_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionAdminResponse) ProtoMessage() {}
+
+func (x *QuestionAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionAdminResponse.ProtoReflect.Descriptor instead.
+func (*QuestionAdminResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *QuestionAdminResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuestionAdminResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *QuestionAdminResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *QuestionAdminResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *QuestionAdminResponse) GetUpvoteCount() uint32 {
+	if x != nil {
+		return x.UpvoteCount
+	}
+	return 0
+}
+
+func (x *QuestionAdminResponse) GetAnswerCount() uint32 {
+	if x != nil {
+		return x.AnswerCount
+	}
+	return 0
+}
+
+func (x *QuestionAdminResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *QuestionAdminResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *QuestionAdminResponse) GetUser() *BaseUserResponse {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *QuestionAdminResponse) GetModeratedBy() *BaseUserResponse {
+	if x != nil {
+		return x.ModeratedBy
+	}
+	return nil
+}
+
+func (x *QuestionAdminResponse) GetModeratedAt() string {
+	if x != nil && x.ModeratedAt != nil {
+		return *x.ModeratedAt
+	}
+	return ""
+}
+
+type QuestionsAdminResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Questions     []*QuestionAdminResponse `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	Meta          *PaginationMetaResponse  `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionsAdminResponse) Reset() {
+	*x = QuestionsAdminResponse{}
+	mi := &file_proto_product_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionsAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionsAdminResponse) ProtoMessage() {}
+
+func (x *QuestionsAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionsAdminResponse.ProtoReflect.Descriptor instead.
+func (*QuestionsAdminResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *QuestionsAdminResponse) GetQuestions() []*QuestionAdminResponse {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *QuestionsAdminResponse) GetMeta() *PaginationMetaResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type AnswerAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	IsStaff       *bool                  `protobuf:"varint,4,opt,name=is_staff,json=isStaff,proto3,oneof" json:"is_staff,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	UpvoteCount   uint32                 `protobuf:"varint,6,opt,name=upvote_count,json=upvoteCount,proto3" json:"upvote_count,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	User          *BaseUserResponse      `protobuf:"bytes,9,opt,name=user,proto3" json:"user,omitempty"`
+	ModeratedBy   *BaseUserResponse      `protobuf:"bytes,10,opt,name=moderated_by,json=moderatedBy,proto3" json:"moderated_by,omitempty"`
+	ModeratedAt   *string                `protobuf:"bytes,11,opt,name=moderated_at,json=moderatedAt,proto3,oneof" json:"moderated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerAdminResponse) Reset() {
+	*x = AnswerAdminResponse{}
+	mi := &file_proto_product_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerAdminResponse) ProtoMessage() {}
+
+func (x *AnswerAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerAdminResponse.ProtoReflect.Descriptor instead.
+func (*AnswerAdminResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AnswerAdminResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AnswerAdminResponse) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *AnswerAdminResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *AnswerAdminResponse) GetIsStaff() bool {
+	if x != nil && x.IsStaff != nil {
+		return *x.IsStaff
+	}
+	return false
+}
+
+func (x *AnswerAdminResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AnswerAdminResponse) GetUpvoteCount() uint32 {
+	if x != nil {
+		return x.UpvoteCount
+	}
+	return 0
+}
+
+func (x *AnswerAdminResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AnswerAdminResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *AnswerAdminResponse) GetUser() *BaseUserResponse {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *AnswerAdminResponse) GetModeratedBy() *BaseUserResponse {
+	if x != nil {
+		return x.ModeratedBy
+	}
+	return nil
+}
+
+func (x *AnswerAdminResponse) GetModeratedAt() string {
+	if x != nil && x.ModeratedAt != nil {
+		return *x.ModeratedAt
+	}
+	return ""
+}
+
+type AnswersAdminResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Answers       []*AnswerAdminResponse  `protobuf:"bytes,1,rep,name=answers,proto3" json:"answers,omitempty"`
+	Meta          *PaginationMetaResponse `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswersAdminResponse) Reset() {
+	*x = AnswersAdminResponse{}
+	mi := &file_proto_product_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswersAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswersAdminResponse) ProtoMessage() {}
+
+func (x *AnswersAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswersAdminResponse.ProtoReflect.Descriptor instead.
+func (*AnswersAdminResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AnswersAdminResponse) GetAnswers() []*AnswerAdminResponse {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *AnswersAdminResponse) GetMeta() *PaginationMetaResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type CreateReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Rating        uint32                 `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewRequest) Reset() {
+	*x = CreateReviewRequest{}
+	mi := &file_proto_product_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewRequest) ProtoMessage() {}
+
+func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateReviewRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetRating() uint32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetProductReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Page          uint32                 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Sort          string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	Order         string                 `protobuf:"bytes,5,opt,name=order,proto3" json:"order,omitempty"`
+	Rating        *uint32                `protobuf:"varint,6,opt,name=rating,proto3,oneof" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductReviewsRequest) Reset() {
+	*x = GetProductReviewsRequest{}
+	mi := &file_proto_product_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductReviewsRequest) ProtoMessage() {}
+
+func (x *GetProductReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductReviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetProductReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetProductReviewsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetProductReviewsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetProductReviewsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetProductReviewsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetProductReviewsRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *GetProductReviewsRequest) GetRating() uint32 {
+	if x != nil && x.Rating != nil {
+		return *x.Rating
+	}
+	return 0
+}
+
+type GetAllReviewsAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Sort          string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	Order         string                 `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`
+	Search        string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+	ProductId     string                 `protobuf:"bytes,6,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Rating        *uint32                `protobuf:"varint,8,opt,name=rating,proto3,oneof" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllReviewsAdminRequest) Reset() {
+	*x = GetAllReviewsAdminRequest{}
+	mi := &file_proto_product_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllReviewsAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllReviewsAdminRequest) ProtoMessage() {}
+
+func (x *GetAllReviewsAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllReviewsAdminRequest.ProtoReflect.Descriptor instead.
+func (*GetAllReviewsAdminRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetAllReviewsAdminRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetAllReviewsAdminRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAllReviewsAdminRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetAllReviewsAdminRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *GetAllReviewsAdminRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetAllReviewsAdminRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetAllReviewsAdminRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetAllReviewsAdminRequest) GetRating() uint32 {
+	if x != nil && x.Rating != nil {
+		return *x.Rating
+	}
+	return 0
+}
+
+type ModerateReviewRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status             string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	UserId             string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsVerifiedPurchase *bool                  `protobuf:"varint,4,opt,name=is_verified_purchase,json=isVerifiedPurchase,proto3,oneof" json:"is_verified_purchase,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ModerateReviewRequest) Reset() {
+	*x = ModerateReviewRequest{}
+	mi := &file_proto_product_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateReviewRequest) ProtoMessage() {}
+
+func (x *ModerateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateReviewRequest.ProtoReflect.Descriptor instead.
+func (*ModerateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ModerateReviewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerateReviewRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ModerateReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ModerateReviewRequest) GetIsVerifiedPurchase() bool {
+	if x != nil && x.IsVerifiedPurchase != nil {
+		return *x.IsVerifiedPurchase
+	}
+	return false
+}
+
+type ReviewPublicResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rating             uint32                 `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Title              string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Content            string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	IsVerifiedPurchase *bool                  `protobuf:"varint,5,opt,name=is_verified_purchase,json=isVerifiedPurchase,proto3,oneof" json:"is_verified_purchase,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	User               *BaseUserResponse      `protobuf:"bytes,7,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ReviewPublicResponse) Reset() {
+	*x = ReviewPublicResponse{}
+	mi := &file_proto_product_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewPublicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewPublicResponse) ProtoMessage() {}
+
+func (x *ReviewPublicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewPublicResponse.ProtoReflect.Descriptor instead.
+func (*ReviewPublicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReviewPublicResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewPublicResponse) GetRating() uint32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ReviewPublicResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ReviewPublicResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ReviewPublicResponse) GetIsVerifiedPurchase() bool {
+	if x != nil && x.IsVerifiedPurchase != nil {
+		return *x.IsVerifiedPurchase
+	}
+	return false
+}
+
+func (x *ReviewPublicResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ReviewPublicResponse) GetUser() *BaseUserResponse {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ReviewsPublicResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Reviews       []*ReviewPublicResponse `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	Meta          *PaginationMetaResponse `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	AverageRating float32                 `protobuf:"fixed32,3,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	ReviewCount   uint32                  `protobuf:"varint,4,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewsPublicResponse) Reset() {
+	*x = ReviewsPublicResponse{}
+	mi := &file_proto_product_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewsPublicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewsPublicResponse) ProtoMessage() {}
+
+func (x *ReviewsPublicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewsPublicResponse.ProtoReflect.Descriptor instead.
+func (*ReviewsPublicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReviewsPublicResponse) GetReviews() []*ReviewPublicResponse {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ReviewsPublicResponse) GetMeta() *PaginationMetaResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ReviewsPublicResponse) GetAverageRating() float32 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *ReviewsPublicResponse) GetReviewCount() uint32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+type ReviewAdminResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId          string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Rating             uint32                 `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	Title              string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Content            string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	IsVerifiedPurchase *bool                  `protobuf:"varint,6,opt,name=is_verified_purchase,json=isVerifiedPurchase,proto3,oneof" json:"is_verified_purchase,omitempty"`
+	Status             string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	User               *BaseUserResponse      `protobuf:"bytes,10,opt,name=user,proto3" json:"user,omitempty"`
+	ModeratedBy        *BaseUserResponse      `protobuf:"bytes,11,opt,name=moderated_by,json=moderatedBy,proto3" json:"moderated_by,omitempty"`
+	ModeratedAt        *string                `protobuf:"bytes,12,opt,name=moderated_at,json=moderatedAt,proto3,oneof" json:"moderated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ReviewAdminResponse) Reset() {
+	*x = ReviewAdminResponse{}
+	mi := &file_proto_product_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAdminResponse) ProtoMessage() {}
+
+func (x *ReviewAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAdminResponse.ProtoReflect.Descriptor instead.
+func (*ReviewAdminResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReviewAdminResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewAdminResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReviewAdminResponse) GetRating() uint32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ReviewAdminResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ReviewAdminResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ReviewAdminResponse) GetIsVerifiedPurchase() bool {
+	if x != nil && x.IsVerifiedPurchase != nil {
+		return *x.IsVerifiedPurchase
+	}
+	return false
+}
+
+func (x *ReviewAdminResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReviewAdminResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ReviewAdminResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ReviewAdminResponse) GetUser() *BaseUserResponse {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ReviewAdminResponse) GetModeratedBy() *BaseUserResponse {
+	if x != nil {
+		return x.ModeratedBy
+	}
+	return nil
+}
+
+func (x *ReviewAdminResponse) GetModeratedAt() string {
+	if x != nil && x.ModeratedAt != nil {
+		return *x.ModeratedAt
+	}
+	return ""
+}
+
+type ReviewsAdminResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Reviews       []*ReviewAdminResponse  `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	Meta          *PaginationMetaResponse `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewsAdminResponse) Reset() {
+	*x = ReviewsAdminResponse{}
+	mi := &file_proto_product_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewsAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewsAdminResponse) ProtoMessage() {}
+
+func (x *ReviewsAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewsAdminResponse.ProtoReflect.Descriptor instead.
+func (*ReviewsAdminResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReviewsAdminResponse) GetReviews() []*ReviewAdminResponse {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ReviewsAdminResponse) GetMeta() *PaginationMetaResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type ProductRelationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RelatedProductId string                 `protobuf:"bytes,1,opt,name=related_product_id,json=relatedProductId,proto3" json:"related_product_id,omitempty"`
+	Type             string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	SortOrder        int32                  `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProductRelationRequest) Reset() {
+	*x = ProductRelationRequest{}
+	mi := &file_proto_product_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductRelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductRelationRequest) ProtoMessage() {}
+
+func (x *ProductRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductRelationRequest.ProtoReflect.Descriptor instead.
+func (*ProductRelationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ProductRelationRequest) GetRelatedProductId() string {
+	if x != nil {
+		return x.RelatedProductId
+	}
+	return ""
+}
+
+func (x *ProductRelationRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProductRelationRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+type AddProductRelationsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	ProductId     string                    `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Relations     []*ProductRelationRequest `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"`
+	UserId        string                    `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductRelationsRequest) Reset() {
+	*x = AddProductRelationsRequest{}
+	mi := &file_proto_product_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductRelationsRequest) ProtoMessage() {}
+
+func (x *AddProductRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductRelationsRequest.ProtoReflect.Descriptor instead.
+func (*AddProductRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AddProductRelationsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AddProductRelationsRequest) GetRelations() []*ProductRelationRequest {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+func (x *AddProductRelationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveProductRelationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Ids           []string               `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveProductRelationsRequest) Reset() {
+	*x = RemoveProductRelationsRequest{}
+	mi := &file_proto_product_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveProductRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProductRelationsRequest) ProtoMessage() {}
+
+func (x *RemoveProductRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProductRelationsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveProductRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RemoveProductRelationsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RemoveProductRelationsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *RemoveProductRelationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ProductRelationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	Product       *BaseProductResponse   `protobuf:"bytes,4,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductRelationResponse) Reset() {
+	*x = ProductRelationResponse{}
+	mi := &file_proto_product_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductRelationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductRelationResponse) ProtoMessage() {}
+
+func (x *ProductRelationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductRelationResponse.ProtoReflect.Descriptor instead.
+func (*ProductRelationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ProductRelationResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductRelationResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProductRelationResponse) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *ProductRelationResponse) GetProduct() *BaseProductResponse {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ProductRelationsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Relations     []*ProductRelationResponse `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductRelationsResponse) Reset() {
+	*x = ProductRelationsResponse{}
+	mi := &file_proto_product_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductRelationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductRelationsResponse) ProtoMessage() {}
+
+func (x *ProductRelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductRelationsResponse.ProtoReflect.Descriptor instead.
+func (*ProductRelationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ProductRelationsResponse) GetRelations() []*ProductRelationResponse {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+type GetRelatedProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Type          *string                `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedProductsRequest) Reset() {
+	*x = GetRelatedProductsRequest{}
+	mi := &file_proto_product_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedProductsRequest) ProtoMessage() {}
+
+func (x *GetRelatedProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedProductsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelatedProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetRelatedProductsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetRelatedProductsRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *GetRelatedProductsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RelatedProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Product       *BaseProductResponse   `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	Price         float32                `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
+	IsSale        *bool                  `protobuf:"varint,4,opt,name=is_sale,json=isSale,proto3,oneof" json:"is_sale,omitempty"`
+	SalePrice     *float32               `protobuf:"fixed32,5,opt,name=sale_price,json=salePrice,proto3,oneof" json:"sale_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelatedProductResponse) Reset() {
+	*x = RelatedProductResponse{}
+	mi := &file_proto_product_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedProductResponse) ProtoMessage() {}
+
+func (x *RelatedProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedProductResponse.ProtoReflect.Descriptor instead.
+func (*RelatedProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RelatedProductResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RelatedProductResponse) GetProduct() *BaseProductResponse {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *RelatedProductResponse) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *RelatedProductResponse) GetIsSale() bool {
+	if x != nil && x.IsSale != nil {
+		return *x.IsSale
+	}
+	return false
+}
+
+func (x *RelatedProductResponse) GetSalePrice() float32 {
+	if x != nil && x.SalePrice != nil {
+		return *x.SalePrice
+	}
+	return 0
+}
+
+type RelatedProductsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Products      []*RelatedProductResponse `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelatedProductsResponse) Reset() {
+	*x = RelatedProductsResponse{}
+	mi := &file_proto_product_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedProductsResponse) ProtoMessage() {}
+
+func (x *RelatedProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedProductsResponse.ProtoReflect.Descriptor instead.
+func (*RelatedProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RelatedProductsResponse) GetProducts() []*RelatedProductResponse {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type BundleItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VariantId     string                 `protobuf:"bytes,1,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleItemRequest) Reset() {
+	*x = BundleItemRequest{}
+	mi := &file_proto_product_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleItemRequest) ProtoMessage() {}
+
+func (x *BundleItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleItemRequest.ProtoReflect.Descriptor instead.
+func (*BundleItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BundleItemRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *BundleItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type UpdateBundleItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleId      string                 `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	Items         []*BundleItemRequest   `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBundleItemsRequest) Reset() {
+	*x = UpdateBundleItemsRequest{}
+	mi := &file_proto_product_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBundleItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBundleItemsRequest) ProtoMessage() {}
+
+func (x *UpdateBundleItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBundleItemsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBundleItemsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdateBundleItemsRequest) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *UpdateBundleItemsRequest) GetItems() []*BundleItemRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *UpdateBundleItemsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SellBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleId      string                 `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SellBundleRequest) Reset() {
+	*x = SellBundleRequest{}
+	mi := &file_proto_product_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SellBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellBundleRequest) ProtoMessage() {}
+
+func (x *SellBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SellBundleRequest.ProtoReflect.Descriptor instead.
+func (*SellBundleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SellBundleRequest) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *SellBundleRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *SellBundleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReserveBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleId      string                 `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveBundleRequest) Reset() {
+	*x = ReserveBundleRequest{}
+	mi := &file_proto_product_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveBundleRequest) ProtoMessage() {}
+
+func (x *ReserveBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveBundleRequest.ProtoReflect.Descriptor instead.
+func (*ReserveBundleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReserveBundleRequest) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *ReserveBundleRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReserveBundleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReleaseBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleId      string                 `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseBundleRequest) Reset() {
+	*x = ReleaseBundleRequest{}
+	mi := &file_proto_product_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseBundleRequest) ProtoMessage() {}
+
+func (x *ReleaseBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseBundleRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseBundleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReleaseBundleRequest) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *ReleaseBundleRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReleaseBundleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type BaseBundleItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Variant       *BaseVariantResponse   `protobuf:"bytes,3,opt,name=variant,proto3" json:"variant,omitempty"`
+	Product       *BaseProductResponse   `protobuf:"bytes,4,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BaseBundleItemResponse) Reset() {
+	*x = BaseBundleItemResponse{}
+	mi := &file_proto_product_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BaseBundleItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseBundleItemResponse) ProtoMessage() {}
+
+func (x *BaseBundleItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaseBundleItemResponse.ProtoReflect.Descriptor instead.
+func (*BaseBundleItemResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *BaseBundleItemResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BaseBundleItemResponse) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BaseBundleItemResponse) GetVariant() *BaseVariantResponse {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+func (x *BaseBundleItemResponse) GetProduct() *BaseProductResponse {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type BundleItemsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Items         []*BaseBundleItemResponse `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Stock         int64                     `protobuf:"varint,2,opt,name=stock,proto3" json:"stock,omitempty"`
+	IsStock       *bool                     `protobuf:"varint,3,opt,name=is_stock,json=isStock,proto3,oneof" json:"is_stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleItemsResponse) Reset() {
+	*x = BundleItemsResponse{}
+	mi := &file_proto_product_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleItemsResponse) ProtoMessage() {}
+
+func (x *BundleItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleItemsResponse.ProtoReflect.Descriptor instead.
+func (*BundleItemsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *BundleItemsResponse) GetItems() []*BaseBundleItemResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BundleItemsResponse) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *BundleItemsResponse) GetIsStock() bool {
+	if x != nil && x.IsStock != nil {
+		return *x.IsStock
+	}
+	return false
+}
+
 type GetByProductId struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
@@ -30,7 +4339,7 @@ type GetByProductId struct {
 
 func (x *GetByProductId) Reset() {
 	*x = GetByProductId{}
-	mi := &file_proto_product_proto_msgTypes[0]
+	mi := &file_proto_product_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -42,7 +4351,7 @@ func (x *GetByProductId) String() string {
 func (*GetByProductId) ProtoMessage() {}
 
 func (x *GetByProductId) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[0]
+	mi := &file_proto_product_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -55,7 +4364,7 @@ func (x *GetByProductId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByProductId.ProtoReflect.Descriptor instead.
 func (*GetByProductId) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{0}
+	return file_proto_product_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GetByProductId) GetProductId() string {
@@ -74,7 +4383,7 @@ type ImagesResponse struct {
 
 func (x *ImagesResponse) Reset() {
 	*x = ImagesResponse{}
-	mi := &file_proto_product_proto_msgTypes[1]
+	mi := &file_proto_product_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -86,7 +4395,7 @@ func (x *ImagesResponse) String() string {
 func (*ImagesResponse) ProtoMessage() {}
 
 func (x *ImagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[1]
+	mi := &file_proto_product_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -99,7 +4408,7 @@ func (x *ImagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImagesResponse.ProtoReflect.Descriptor instead.
 func (*ImagesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{1}
+	return file_proto_product_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *ImagesResponse) GetImages() []*BaseImageResponse {
@@ -123,7 +4432,7 @@ type PaginationMetaResponse struct {
 
 func (x *PaginationMetaResponse) Reset() {
 	*x = PaginationMetaResponse{}
-	mi := &file_proto_product_proto_msgTypes[2]
+	mi := &file_proto_product_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -135,7 +4444,7 @@ func (x *PaginationMetaResponse) String() string {
 func (*PaginationMetaResponse) ProtoMessage() {}
 
 func (x *PaginationMetaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[2]
+	mi := &file_proto_product_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -148,7 +4457,7 @@ func (x *PaginationMetaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaginationMetaResponse.ProtoReflect.Descriptor instead.
 func (*PaginationMetaResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{2}
+	return file_proto_product_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *PaginationMetaResponse) GetPage() uint32 {
@@ -202,13 +4511,14 @@ type GetAllProductsAdminRequest struct {
 	Search        string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
 	CategoryId    string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	IsActive      *bool                  `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	Status        *string                `protobuf:"bytes,8,opt,name=status,proto3,oneof" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAllProductsAdminRequest) Reset() {
 	*x = GetAllProductsAdminRequest{}
-	mi := &file_proto_product_proto_msgTypes[3]
+	mi := &file_proto_product_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -220,7 +4530,7 @@ func (x *GetAllProductsAdminRequest) String() string {
 func (*GetAllProductsAdminRequest) ProtoMessage() {}
 
 func (x *GetAllProductsAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[3]
+	mi := &file_proto_product_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -233,7 +4543,7 @@ func (x *GetAllProductsAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllProductsAdminRequest.ProtoReflect.Descriptor instead.
 func (*GetAllProductsAdminRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{3}
+	return file_proto_product_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *GetAllProductsAdminRequest) GetPage() uint32 {
@@ -285,16 +4595,24 @@ func (x *GetAllProductsAdminRequest) GetIsActive() bool {
 	return false
 }
 
+func (x *GetAllProductsAdminRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
 type PermanentlyDeleteManyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PermanentlyDeleteManyRequest) Reset() {
 	*x = PermanentlyDeleteManyRequest{}
-	mi := &file_proto_product_proto_msgTypes[4]
+	mi := &file_proto_product_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -306,7 +4624,7 @@ func (x *PermanentlyDeleteManyRequest) String() string {
 func (*PermanentlyDeleteManyRequest) ProtoMessage() {}
 
 func (x *PermanentlyDeleteManyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[4]
+	mi := &file_proto_product_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -319,7 +4637,7 @@ func (x *PermanentlyDeleteManyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PermanentlyDeleteManyRequest.ProtoReflect.Descriptor instead.
 func (*PermanentlyDeleteManyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{4}
+	return file_proto_product_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *PermanentlyDeleteManyRequest) GetIds() []string {
@@ -329,16 +4647,24 @@ func (x *PermanentlyDeleteManyRequest) GetIds() []string {
 	return nil
 }
 
+func (x *PermanentlyDeleteManyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 type PermanentlyDeleteOneRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PermanentlyDeleteOneRequest) Reset() {
 	*x = PermanentlyDeleteOneRequest{}
-	mi := &file_proto_product_proto_msgTypes[5]
+	mi := &file_proto_product_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -350,7 +4676,7 @@ func (x *PermanentlyDeleteOneRequest) String() string {
 func (*PermanentlyDeleteOneRequest) ProtoMessage() {}
 
 func (x *PermanentlyDeleteOneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[5]
+	mi := &file_proto_product_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -363,7 +4689,7 @@ func (x *PermanentlyDeleteOneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PermanentlyDeleteOneRequest.ProtoReflect.Descriptor instead.
 func (*PermanentlyDeleteOneRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{5}
+	return file_proto_product_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *PermanentlyDeleteOneRequest) GetId() string {
@@ -373,6 +4699,13 @@ func (x *PermanentlyDeleteOneRequest) GetId() string {
 	return ""
 }
 
+func (x *PermanentlyDeleteOneRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 type RestoreManyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
@@ -383,7 +4716,7 @@ type RestoreManyRequest struct {
 
 func (x *RestoreManyRequest) Reset() {
 	*x = RestoreManyRequest{}
-	mi := &file_proto_product_proto_msgTypes[6]
+	mi := &file_proto_product_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -395,7 +4728,7 @@ func (x *RestoreManyRequest) String() string {
 func (*RestoreManyRequest) ProtoMessage() {}
 
 func (x *RestoreManyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[6]
+	mi := &file_proto_product_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -408,7 +4741,7 @@ func (x *RestoreManyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreManyRequest.ProtoReflect.Descriptor instead.
 func (*RestoreManyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{6}
+	return file_proto_product_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *RestoreManyRequest) GetIds() []string {
@@ -435,7 +4768,7 @@ type RestoreOneRequest struct {
 
 func (x *RestoreOneRequest) Reset() {
 	*x = RestoreOneRequest{}
-	mi := &file_proto_product_proto_msgTypes[7]
+	mi := &file_proto_product_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -447,7 +4780,7 @@ func (x *RestoreOneRequest) String() string {
 func (*RestoreOneRequest) ProtoMessage() {}
 
 func (x *RestoreOneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[7]
+	mi := &file_proto_product_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,7 +4793,7 @@ func (x *RestoreOneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreOneRequest.ProtoReflect.Descriptor instead.
 func (*RestoreOneRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{7}
+	return file_proto_product_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *RestoreOneRequest) GetId() string {
@@ -486,7 +4819,7 @@ type RestoredResponse struct {
 
 func (x *RestoredResponse) Reset() {
 	*x = RestoredResponse{}
-	mi := &file_proto_product_proto_msgTypes[8]
+	mi := &file_proto_product_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -498,7 +4831,7 @@ func (x *RestoredResponse) String() string {
 func (*RestoredResponse) ProtoMessage() {}
 
 func (x *RestoredResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[8]
+	mi := &file_proto_product_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -511,7 +4844,7 @@ func (x *RestoredResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoredResponse.ProtoReflect.Descriptor instead.
 func (*RestoredResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{8}
+	return file_proto_product_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *RestoredResponse) GetSuccess() bool {
@@ -526,13 +4859,15 @@ type UpdateSizeRequest struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SlugMode      string                 `protobuf:"bytes,4,opt,name=slug_mode,json=slugMode,proto3" json:"slug_mode,omitempty"`
+	Translations  []*TranslationRequest  `protobuf:"bytes,5,rep,name=translations,proto3" json:"translations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateSizeRequest) Reset() {
 	*x = UpdateSizeRequest{}
-	mi := &file_proto_product_proto_msgTypes[9]
+	mi := &file_proto_product_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -544,7 +4879,7 @@ func (x *UpdateSizeRequest) String() string {
 func (*UpdateSizeRequest) ProtoMessage() {}
 
 func (x *UpdateSizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[9]
+	mi := &file_proto_product_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -557,7 +4892,7 @@ func (x *UpdateSizeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSizeRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSizeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{9}
+	return file_proto_product_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *UpdateSizeRequest) GetId() string {
@@ -581,18 +4916,34 @@ func (x *UpdateSizeRequest) GetUserId() string {
 	return ""
 }
 
+func (x *UpdateSizeRequest) GetSlugMode() string {
+	if x != nil {
+		return x.SlugMode
+	}
+	return ""
+}
+
+func (x *UpdateSizeRequest) GetTranslations() []*TranslationRequest {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
 type UpdateColorRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SlugMode      string                 `protobuf:"bytes,4,opt,name=slug_mode,json=slugMode,proto3" json:"slug_mode,omitempty"`
+	Translations  []*TranslationRequest  `protobuf:"bytes,5,rep,name=translations,proto3" json:"translations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateColorRequest) Reset() {
 	*x = UpdateColorRequest{}
-	mi := &file_proto_product_proto_msgTypes[10]
+	mi := &file_proto_product_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -604,7 +4955,7 @@ func (x *UpdateColorRequest) String() string {
 func (*UpdateColorRequest) ProtoMessage() {}
 
 func (x *UpdateColorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[10]
+	mi := &file_proto_product_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +4968,7 @@ func (x *UpdateColorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateColorRequest.ProtoReflect.Descriptor instead.
 func (*UpdateColorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{10}
+	return file_proto_product_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *UpdateColorRequest) GetId() string {
@@ -641,6 +4992,20 @@ func (x *UpdateColorRequest) GetUserId() string {
 	return ""
 }
 
+func (x *UpdateColorRequest) GetSlugMode() string {
+	if x != nil {
+		return x.SlugMode
+	}
+	return ""
+}
+
+func (x *UpdateColorRequest) GetTranslations() []*TranslationRequest {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
 type GetAllRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -649,7 +5014,7 @@ type GetAllRequest struct {
 
 func (x *GetAllRequest) Reset() {
 	*x = GetAllRequest{}
-	mi := &file_proto_product_proto_msgTypes[11]
+	mi := &file_proto_product_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -661,7 +5026,7 @@ func (x *GetAllRequest) String() string {
 func (*GetAllRequest) ProtoMessage() {}
 
 func (x *GetAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[11]
+	mi := &file_proto_product_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,7 +5039,7 @@ func (x *GetAllRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllRequest.ProtoReflect.Descriptor instead.
 func (*GetAllRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{11}
+	return file_proto_product_proto_rawDescGZIP(), []int{72}
 }
 
 type DeleteOneRequest struct {
@@ -687,7 +5052,7 @@ type DeleteOneRequest struct {
 
 func (x *DeleteOneRequest) Reset() {
 	*x = DeleteOneRequest{}
-	mi := &file_proto_product_proto_msgTypes[12]
+	mi := &file_proto_product_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -699,7 +5064,7 @@ func (x *DeleteOneRequest) String() string {
 func (*DeleteOneRequest) ProtoMessage() {}
 
 func (x *DeleteOneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[12]
+	mi := &file_proto_product_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,7 +5077,7 @@ func (x *DeleteOneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteOneRequest.ProtoReflect.Descriptor instead.
 func (*DeleteOneRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{12}
+	return file_proto_product_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *DeleteOneRequest) GetId() string {
@@ -739,7 +5104,7 @@ type DeleteManyRequest struct {
 
 func (x *DeleteManyRequest) Reset() {
 	*x = DeleteManyRequest{}
-	mi := &file_proto_product_proto_msgTypes[13]
+	mi := &file_proto_product_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -751,7 +5116,7 @@ func (x *DeleteManyRequest) String() string {
 func (*DeleteManyRequest) ProtoMessage() {}
 
 func (x *DeleteManyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[13]
+	mi := &file_proto_product_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,7 +5129,7 @@ func (x *DeleteManyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteManyRequest.ProtoReflect.Descriptor instead.
 func (*DeleteManyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{13}
+	return file_proto_product_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *DeleteManyRequest) GetIds() []string {
@@ -790,7 +5155,7 @@ type DeletedResponse struct {
 
 func (x *DeletedResponse) Reset() {
 	*x = DeletedResponse{}
-	mi := &file_proto_product_proto_msgTypes[14]
+	mi := &file_proto_product_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -802,7 +5167,7 @@ func (x *DeletedResponse) String() string {
 func (*DeletedResponse) ProtoMessage() {}
 
 func (x *DeletedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[14]
+	mi := &file_proto_product_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -815,7 +5180,7 @@ func (x *DeletedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletedResponse.ProtoReflect.Descriptor instead.
 func (*DeletedResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{14}
+	return file_proto_product_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *DeletedResponse) GetSuccess() bool {
@@ -826,32 +5191,40 @@ func (x *DeletedResponse) GetSuccess() bool {
 }
 
 type UpdateProductRequest struct {
-	state            protoimpl.MessageState  `protogen:"open.v1"`
-	Id               string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title            *string                 `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description      *string                 `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Price            *float32                `protobuf:"fixed32,4,opt,name=price,proto3,oneof" json:"price,omitempty"`
-	IsActive         *bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
-	IsSale           *bool                   `protobuf:"varint,6,opt,name=is_sale,json=isSale,proto3,oneof" json:"is_sale,omitempty"`
-	SalePrice        *float32                `protobuf:"fixed32,7,opt,name=sale_price,json=salePrice,proto3,oneof" json:"sale_price,omitempty"`
-	StartSale        *string                 `protobuf:"bytes,8,opt,name=start_sale,json=startSale,proto3,oneof" json:"start_sale,omitempty"`
-	EndSale          *string                 `protobuf:"bytes,9,opt,name=end_sale,json=endSale,proto3,oneof" json:"end_sale,omitempty"`
-	CategoryIds      []string                `protobuf:"bytes,10,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
-	TagIds           []string                `protobuf:"bytes,11,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
-	UpdateImages     []*UpdateImageRequest   `protobuf:"bytes,12,rep,name=update_images,json=updateImages,proto3" json:"update_images,omitempty"`
-	NewImages        []*CreateImageRequest   `protobuf:"bytes,13,rep,name=new_images,json=newImages,proto3" json:"new_images,omitempty"`
-	DeleteImageIds   []string                `protobuf:"bytes,14,rep,name=delete_image_ids,json=deleteImageIds,proto3" json:"delete_image_ids,omitempty"`
-	UpdateVariants   []*UpdateVariantRequest `protobuf:"bytes,15,rep,name=update_variants,json=updateVariants,proto3" json:"update_variants,omitempty"`
-	NewVariants      []*CreateVariantRequest `protobuf:"bytes,16,rep,name=new_variants,json=newVariants,proto3" json:"new_variants,omitempty"`
-	DeleteVariantIds []string                `protobuf:"bytes,17,rep,name=delete_variant_ids,json=deleteVariantIds,proto3" json:"delete_variant_ids,omitempty"`
-	UserId           string                  `protobuf:"bytes,18,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	Id                string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title             *string                 `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description       *string                 `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Price             *float32                `protobuf:"fixed32,4,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	IsActive          *bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	IsSale            *bool                   `protobuf:"varint,6,opt,name=is_sale,json=isSale,proto3,oneof" json:"is_sale,omitempty"`
+	SalePrice         *float32                `protobuf:"fixed32,7,opt,name=sale_price,json=salePrice,proto3,oneof" json:"sale_price,omitempty"`
+	StartSale         *string                 `protobuf:"bytes,8,opt,name=start_sale,json=startSale,proto3,oneof" json:"start_sale,omitempty"`
+	EndSale           *string                 `protobuf:"bytes,9,opt,name=end_sale,json=endSale,proto3,oneof" json:"end_sale,omitempty"`
+	CategoryIds       []string                `protobuf:"bytes,10,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	TagIds            []string                `protobuf:"bytes,11,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	UpdateImages      []*UpdateImageRequest   `protobuf:"bytes,12,rep,name=update_images,json=updateImages,proto3" json:"update_images,omitempty"`
+	NewImages         []*CreateImageRequest   `protobuf:"bytes,13,rep,name=new_images,json=newImages,proto3" json:"new_images,omitempty"`
+	DeleteImageIds    []string                `protobuf:"bytes,14,rep,name=delete_image_ids,json=deleteImageIds,proto3" json:"delete_image_ids,omitempty"`
+	UpdateVariants    []*UpdateVariantRequest `protobuf:"bytes,15,rep,name=update_variants,json=updateVariants,proto3" json:"update_variants,omitempty"`
+	NewVariants       []*CreateVariantRequest `protobuf:"bytes,16,rep,name=new_variants,json=newVariants,proto3" json:"new_variants,omitempty"`
+	DeleteVariantIds  []string                `protobuf:"bytes,17,rep,name=delete_variant_ids,json=deleteVariantIds,proto3" json:"delete_variant_ids,omitempty"`
+	UserId            string                  `protobuf:"bytes,18,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PrimaryCategoryId *string                 `protobuf:"bytes,19,opt,name=primary_category_id,json=primaryCategoryId,proto3,oneof" json:"primary_category_id,omitempty"`
+	Slug              *string                 `protobuf:"bytes,20,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	SlugMode          string                  `protobuf:"bytes,21,opt,name=slug_mode,json=slugMode,proto3" json:"slug_mode,omitempty"`
+	Status            *string                 `protobuf:"bytes,22,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	PublishAt         *string                 `protobuf:"bytes,23,opt,name=publish_at,json=publishAt,proto3,oneof" json:"publish_at,omitempty"`
+	UnpublishAt       *string                 `protobuf:"bytes,24,opt,name=unpublish_at,json=unpublishAt,proto3,oneof" json:"unpublish_at,omitempty"`
+	Translations      []*TranslationRequest   `protobuf:"bytes,25,rep,name=translations,proto3" json:"translations,omitempty"`
+	ExpectedVersion   *int32                  `protobuf:"varint,26,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UpdateProductRequest) Reset() {
 	*x = UpdateProductRequest{}
-	mi := &file_proto_product_proto_msgTypes[15]
+	mi := &file_proto_product_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -863,7 +5236,7 @@ func (x *UpdateProductRequest) String() string {
 func (*UpdateProductRequest) ProtoMessage() {}
 
 func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[15]
+	mi := &file_proto_product_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,7 +5249,7 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{15}
+	return file_proto_product_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *UpdateProductRequest) GetId() string {
@@ -1005,6 +5378,62 @@ func (x *UpdateProductRequest) GetUserId() string {
 	return ""
 }
 
+func (x *UpdateProductRequest) GetPrimaryCategoryId() string {
+	if x != nil && x.PrimaryCategoryId != nil {
+		return *x.PrimaryCategoryId
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetSlugMode() string {
+	if x != nil {
+		return x.SlugMode
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetPublishAt() string {
+	if x != nil && x.PublishAt != nil {
+		return *x.PublishAt
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetUnpublishAt() string {
+	if x != nil && x.UnpublishAt != nil {
+		return *x.UnpublishAt
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetTranslations() []*TranslationRequest {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateImageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1016,7 +5445,7 @@ type UpdateImageRequest struct {
 
 func (x *UpdateImageRequest) Reset() {
 	*x = UpdateImageRequest{}
-	mi := &file_proto_product_proto_msgTypes[16]
+	mi := &file_proto_product_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1028,7 +5457,7 @@ func (x *UpdateImageRequest) String() string {
 func (*UpdateImageRequest) ProtoMessage() {}
 
 func (x *UpdateImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[16]
+	mi := &file_proto_product_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1041,7 +5470,7 @@ func (x *UpdateImageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateImageRequest.ProtoReflect.Descriptor instead.
 func (*UpdateImageRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{16}
+	return file_proto_product_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *UpdateImageRequest) GetId() string {
@@ -1078,7 +5507,7 @@ type UpdateVariantRequest struct {
 
 func (x *UpdateVariantRequest) Reset() {
 	*x = UpdateVariantRequest{}
-	mi := &file_proto_product_proto_msgTypes[17]
+	mi := &file_proto_product_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1090,7 +5519,7 @@ func (x *UpdateVariantRequest) String() string {
 func (*UpdateVariantRequest) ProtoMessage() {}
 
 func (x *UpdateVariantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[17]
+	mi := &file_proto_product_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1103,7 +5532,7 @@ func (x *UpdateVariantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateVariantRequest.ProtoReflect.Descriptor instead.
 func (*UpdateVariantRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{17}
+	return file_proto_product_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *UpdateVariantRequest) GetId() string {
@@ -1151,7 +5580,7 @@ type ProductsAdminResponse struct {
 
 func (x *ProductsAdminResponse) Reset() {
 	*x = ProductsAdminResponse{}
-	mi := &file_proto_product_proto_msgTypes[18]
+	mi := &file_proto_product_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1163,7 +5592,7 @@ func (x *ProductsAdminResponse) String() string {
 func (*ProductsAdminResponse) ProtoMessage() {}
 
 func (x *ProductsAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[18]
+	mi := &file_proto_product_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1176,7 +5605,7 @@ func (x *ProductsAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductsAdminResponse.ProtoReflect.Descriptor instead.
 func (*ProductsAdminResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{18}
+	return file_proto_product_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *ProductsAdminResponse) GetProducts() []*ProductAdminResponse {
@@ -1203,7 +5632,7 @@ type SimpleImageResponse struct {
 
 func (x *SimpleImageResponse) Reset() {
 	*x = SimpleImageResponse{}
-	mi := &file_proto_product_proto_msgTypes[19]
+	mi := &file_proto_product_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1215,7 +5644,7 @@ func (x *SimpleImageResponse) String() string {
 func (*SimpleImageResponse) ProtoMessage() {}
 
 func (x *SimpleImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[19]
+	mi := &file_proto_product_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1228,7 +5657,7 @@ func (x *SimpleImageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimpleImageResponse.ProtoReflect.Descriptor instead.
 func (*SimpleImageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{19}
+	return file_proto_product_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *SimpleImageResponse) GetId() string {
@@ -1252,13 +5681,14 @@ type ProductAdminResponse struct {
 	Price         float32                 `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
 	Categories    []*BaseCategoryResponse `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
 	Thumbnail     *SimpleImageResponse    `protobuf:"bytes,5,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	Status        string                  `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProductAdminResponse) Reset() {
 	*x = ProductAdminResponse{}
-	mi := &file_proto_product_proto_msgTypes[20]
+	mi := &file_proto_product_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1270,7 +5700,7 @@ func (x *ProductAdminResponse) String() string {
 func (*ProductAdminResponse) ProtoMessage() {}
 
 func (x *ProductAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[20]
+	mi := &file_proto_product_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1283,7 +5713,7 @@ func (x *ProductAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductAdminResponse.ProtoReflect.Descriptor instead.
 func (*ProductAdminResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{20}
+	return file_proto_product_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *ProductAdminResponse) GetId() string {
@@ -1321,6 +5751,13 @@ func (x *ProductAdminResponse) GetThumbnail() *SimpleImageResponse {
 	return nil
 }
 
+func (x *ProductAdminResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type GetOneRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1330,7 +5767,7 @@ type GetOneRequest struct {
 
 func (x *GetOneRequest) Reset() {
 	*x = GetOneRequest{}
-	mi := &file_proto_product_proto_msgTypes[21]
+	mi := &file_proto_product_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1342,7 +5779,7 @@ func (x *GetOneRequest) String() string {
 func (*GetOneRequest) ProtoMessage() {}
 
 func (x *GetOneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[21]
+	mi := &file_proto_product_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1355,7 +5792,7 @@ func (x *GetOneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOneRequest.ProtoReflect.Descriptor instead.
 func (*GetOneRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{21}
+	return file_proto_product_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *GetOneRequest) GetId() string {
@@ -1366,32 +5803,40 @@ func (x *GetOneRequest) GetId() string {
 }
 
 type ProductAdminDetailsResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Slug          string                  `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
-	Description   string                  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float32                 `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
-	IsActive      *bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
-	IsSale        *bool                   `protobuf:"varint,7,opt,name=is_sale,json=isSale,proto3,oneof" json:"is_sale,omitempty"`
-	SalePrice     *float32                `protobuf:"fixed32,8,opt,name=sale_price,json=salePrice,proto3,oneof" json:"sale_price,omitempty"`
-	StartSale     *string                 `protobuf:"bytes,9,opt,name=start_sale,json=startSale,proto3,oneof" json:"start_sale,omitempty"`
-	EndSale       *string                 `protobuf:"bytes,10,opt,name=end_sale,json=endSale,proto3,oneof" json:"end_sale,omitempty"`
-	Categories    []*BaseCategoryResponse `protobuf:"bytes,11,rep,name=categories,proto3" json:"categories,omitempty"`
-	Variants      []*BaseVariantResponse  `protobuf:"bytes,12,rep,name=variants,proto3" json:"variants,omitempty"`
-	Images        []*BaseImageResponse    `protobuf:"bytes,13,rep,name=images,proto3" json:"images,omitempty"`
-	Tags          []*BaseTagResponse      `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
-	CreatedAt     string                  `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                  `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy     *BaseUserResponse       `protobuf:"bytes,17,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedBy     *BaseUserResponse       `protobuf:"bytes,18,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState    `protogen:"open.v1"`
+	Id                string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title             string                    `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Slug              string                    `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description       string                    `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price             float32                   `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
+	IsActive          *bool                     `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	IsSale            *bool                     `protobuf:"varint,7,opt,name=is_sale,json=isSale,proto3,oneof" json:"is_sale,omitempty"`
+	SalePrice         *float32                  `protobuf:"fixed32,8,opt,name=sale_price,json=salePrice,proto3,oneof" json:"sale_price,omitempty"`
+	StartSale         *string                   `protobuf:"bytes,9,opt,name=start_sale,json=startSale,proto3,oneof" json:"start_sale,omitempty"`
+	EndSale           *string                   `protobuf:"bytes,10,opt,name=end_sale,json=endSale,proto3,oneof" json:"end_sale,omitempty"`
+	Categories        []*BaseCategoryResponse   `protobuf:"bytes,11,rep,name=categories,proto3" json:"categories,omitempty"`
+	Variants          []*BaseVariantResponse    `protobuf:"bytes,12,rep,name=variants,proto3" json:"variants,omitempty"`
+	Images            []*BaseImageResponse      `protobuf:"bytes,13,rep,name=images,proto3" json:"images,omitempty"`
+	Tags              []*BaseTagResponse        `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
+	CreatedAt         string                    `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         string                    `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy         *BaseUserResponse         `protobuf:"bytes,17,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy         *BaseUserResponse         `protobuf:"bytes,18,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	IsBundle          *bool                     `protobuf:"varint,19,opt,name=is_bundle,json=isBundle,proto3,oneof" json:"is_bundle,omitempty"`
+	BundleItems       []*BaseBundleItemResponse `protobuf:"bytes,20,rep,name=bundle_items,json=bundleItems,proto3" json:"bundle_items,omitempty"`
+	PrimaryCategoryId *string                   `protobuf:"bytes,21,opt,name=primary_category_id,json=primaryCategoryId,proto3,oneof" json:"primary_category_id,omitempty"`
+	Status            string                    `protobuf:"bytes,22,opt,name=status,proto3" json:"status,omitempty"`
+	PublishAt         *string                   `protobuf:"bytes,23,opt,name=publish_at,json=publishAt,proto3,oneof" json:"publish_at,omitempty"`
+	UnpublishAt       *string                   `protobuf:"bytes,24,opt,name=unpublish_at,json=unpublishAt,proto3,oneof" json:"unpublish_at,omitempty"`
+	Translations      []*TranslationResponse    `protobuf:"bytes,25,rep,name=translations,proto3" json:"translations,omitempty"`
+	Version           int32                     `protobuf:"varint,26,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ProductAdminDetailsResponse) Reset() {
 	*x = ProductAdminDetailsResponse{}
-	mi := &file_proto_product_proto_msgTypes[22]
+	mi := &file_proto_product_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1403,7 +5848,7 @@ func (x *ProductAdminDetailsResponse) String() string {
 func (*ProductAdminDetailsResponse) ProtoMessage() {}
 
 func (x *ProductAdminDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[22]
+	mi := &file_proto_product_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,7 +5861,7 @@ func (x *ProductAdminDetailsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductAdminDetailsResponse.ProtoReflect.Descriptor instead.
 func (*ProductAdminDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{22}
+	return file_proto_product_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *ProductAdminDetailsResponse) GetId() string {
@@ -1517,32 +5962,88 @@ func (x *ProductAdminDetailsResponse) GetTags() []*BaseTagResponse {
 	return nil
 }
 
-func (x *ProductAdminDetailsResponse) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
+func (x *ProductAdminDetailsResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ProductAdminDetailsResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ProductAdminDetailsResponse) GetCreatedBy() *BaseUserResponse {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *ProductAdminDetailsResponse) GetUpdatedBy() *BaseUserResponse {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+func (x *ProductAdminDetailsResponse) GetIsBundle() bool {
+	if x != nil && x.IsBundle != nil {
+		return *x.IsBundle
+	}
+	return false
+}
+
+func (x *ProductAdminDetailsResponse) GetBundleItems() []*BaseBundleItemResponse {
+	if x != nil {
+		return x.BundleItems
+	}
+	return nil
+}
+
+func (x *ProductAdminDetailsResponse) GetPrimaryCategoryId() string {
+	if x != nil && x.PrimaryCategoryId != nil {
+		return *x.PrimaryCategoryId
+	}
+	return ""
+}
+
+func (x *ProductAdminDetailsResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ProductAdminDetailsResponse) GetPublishAt() string {
+	if x != nil && x.PublishAt != nil {
+		return *x.PublishAt
 	}
 	return ""
 }
 
-func (x *ProductAdminDetailsResponse) GetUpdatedAt() string {
-	if x != nil {
-		return x.UpdatedAt
+func (x *ProductAdminDetailsResponse) GetUnpublishAt() string {
+	if x != nil && x.UnpublishAt != nil {
+		return *x.UnpublishAt
 	}
 	return ""
 }
 
-func (x *ProductAdminDetailsResponse) GetCreatedBy() *BaseUserResponse {
+func (x *ProductAdminDetailsResponse) GetTranslations() []*TranslationResponse {
 	if x != nil {
-		return x.CreatedBy
+		return x.Translations
 	}
 	return nil
 }
 
-func (x *ProductAdminDetailsResponse) GetUpdatedBy() *BaseUserResponse {
+func (x *ProductAdminDetailsResponse) GetVersion() int32 {
 	if x != nil {
-		return x.UpdatedBy
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
 type BaseCategoriesResponse struct {
@@ -1554,7 +6055,7 @@ type BaseCategoriesResponse struct {
 
 func (x *BaseCategoriesResponse) Reset() {
 	*x = BaseCategoriesResponse{}
-	mi := &file_proto_product_proto_msgTypes[23]
+	mi := &file_proto_product_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1566,7 +6067,7 @@ func (x *BaseCategoriesResponse) String() string {
 func (*BaseCategoriesResponse) ProtoMessage() {}
 
 func (x *BaseCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[23]
+	mi := &file_proto_product_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1579,7 +6080,7 @@ func (x *BaseCategoriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaseCategoriesResponse.ProtoReflect.Descriptor instead.
 func (*BaseCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{23}
+	return file_proto_product_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *BaseCategoriesResponse) GetCategories() []*BaseCategoryResponse {
@@ -1590,27 +6091,36 @@ func (x *BaseCategoriesResponse) GetCategories() []*BaseCategoryResponse {
 }
 
 type CreateProductRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Title         string                  `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float32                 `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
-	IsActive      bool                    `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	IsSale        bool                    `protobuf:"varint,5,opt,name=is_sale,json=isSale,proto3" json:"is_sale,omitempty"`
-	SalePrice     *float32                `protobuf:"fixed32,6,opt,name=sale_price,json=salePrice,proto3,oneof" json:"sale_price,omitempty"`
-	StartSale     *string                 `protobuf:"bytes,7,opt,name=start_sale,json=startSale,proto3,oneof" json:"start_sale,omitempty"`
-	EndSale       *string                 `protobuf:"bytes,8,opt,name=end_sale,json=endSale,proto3,oneof" json:"end_sale,omitempty"`
-	CategoryIds   []string                `protobuf:"bytes,9,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
-	TagIds        []string                `protobuf:"bytes,10,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
-	Variants      []*CreateVariantRequest `protobuf:"bytes,11,rep,name=variants,proto3" json:"variants,omitempty"`
-	Images        []*CreateImageRequest   `protobuf:"bytes,12,rep,name=images,proto3" json:"images,omitempty"`
-	UserId        string                  `protobuf:"bytes,13,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	Title             string                  `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description       string                  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Price             float32                 `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
+	IsActive          bool                    `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsSale            bool                    `protobuf:"varint,5,opt,name=is_sale,json=isSale,proto3" json:"is_sale,omitempty"`
+	SalePrice         *float32                `protobuf:"fixed32,6,opt,name=sale_price,json=salePrice,proto3,oneof" json:"sale_price,omitempty"`
+	StartSale         *string                 `protobuf:"bytes,7,opt,name=start_sale,json=startSale,proto3,oneof" json:"start_sale,omitempty"`
+	EndSale           *string                 `protobuf:"bytes,8,opt,name=end_sale,json=endSale,proto3,oneof" json:"end_sale,omitempty"`
+	CategoryIds       []string                `protobuf:"bytes,9,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	TagIds            []string                `protobuf:"bytes,10,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	Variants          []*CreateVariantRequest `protobuf:"bytes,11,rep,name=variants,proto3" json:"variants,omitempty"`
+	Images            []*CreateImageRequest   `protobuf:"bytes,12,rep,name=images,proto3" json:"images,omitempty"`
+	UserId            string                  `protobuf:"bytes,13,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsBundle          bool                    `protobuf:"varint,14,opt,name=is_bundle,json=isBundle,proto3" json:"is_bundle,omitempty"`
+	BundleItems       []*BundleItemRequest    `protobuf:"bytes,15,rep,name=bundle_items,json=bundleItems,proto3" json:"bundle_items,omitempty"`
+	PrimaryCategoryId *string                 `protobuf:"bytes,16,opt,name=primary_category_id,json=primaryCategoryId,proto3,oneof" json:"primary_category_id,omitempty"`
+	Slug              *string                 `protobuf:"bytes,17,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	SlugMode          string                  `protobuf:"bytes,18,opt,name=slug_mode,json=slugMode,proto3" json:"slug_mode,omitempty"`
+	Status            *string                 `protobuf:"bytes,19,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	PublishAt         *string                 `protobuf:"bytes,20,opt,name=publish_at,json=publishAt,proto3,oneof" json:"publish_at,omitempty"`
+	UnpublishAt       *string                 `protobuf:"bytes,21,opt,name=unpublish_at,json=unpublishAt,proto3,oneof" json:"unpublish_at,omitempty"`
+	Translations      []*TranslationRequest   `protobuf:"bytes,22,rep,name=translations,proto3" json:"translations,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CreateProductRequest) Reset() {
 	*x = CreateProductRequest{}
-	mi := &file_proto_product_proto_msgTypes[24]
+	mi := &file_proto_product_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1622,7 +6132,7 @@ func (x *CreateProductRequest) String() string {
 func (*CreateProductRequest) ProtoMessage() {}
 
 func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[24]
+	mi := &file_proto_product_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1635,7 +6145,7 @@ func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
 func (*CreateProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{24}
+	return file_proto_product_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *CreateProductRequest) GetTitle() string {
@@ -1729,6 +6239,69 @@ func (x *CreateProductRequest) GetUserId() string {
 	return ""
 }
 
+func (x *CreateProductRequest) GetIsBundle() bool {
+	if x != nil {
+		return x.IsBundle
+	}
+	return false
+}
+
+func (x *CreateProductRequest) GetBundleItems() []*BundleItemRequest {
+	if x != nil {
+		return x.BundleItems
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetPrimaryCategoryId() string {
+	if x != nil && x.PrimaryCategoryId != nil {
+		return *x.PrimaryCategoryId
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetSlugMode() string {
+	if x != nil {
+		return x.SlugMode
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetPublishAt() string {
+	if x != nil && x.PublishAt != nil {
+		return *x.PublishAt
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetUnpublishAt() string {
+	if x != nil && x.UnpublishAt != nil {
+		return *x.UnpublishAt
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetTranslations() []*TranslationRequest {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
 type CreateVariantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
@@ -1741,7 +6314,7 @@ type CreateVariantRequest struct {
 
 func (x *CreateVariantRequest) Reset() {
 	*x = CreateVariantRequest{}
-	mi := &file_proto_product_proto_msgTypes[25]
+	mi := &file_proto_product_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1753,7 +6326,7 @@ func (x *CreateVariantRequest) String() string {
 func (*CreateVariantRequest) ProtoMessage() {}
 
 func (x *CreateVariantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[25]
+	mi := &file_proto_product_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1766,7 +6339,7 @@ func (x *CreateVariantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVariantRequest.ProtoReflect.Descriptor instead.
 func (*CreateVariantRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{25}
+	return file_proto_product_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *CreateVariantRequest) GetSku() string {
@@ -1810,7 +6383,7 @@ type CreateImageRequest struct {
 
 func (x *CreateImageRequest) Reset() {
 	*x = CreateImageRequest{}
-	mi := &file_proto_product_proto_msgTypes[26]
+	mi := &file_proto_product_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1822,7 +6395,7 @@ func (x *CreateImageRequest) String() string {
 func (*CreateImageRequest) ProtoMessage() {}
 
 func (x *CreateImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[26]
+	mi := &file_proto_product_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1835,7 +6408,7 @@ func (x *CreateImageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateImageRequest.ProtoReflect.Descriptor instead.
 func (*CreateImageRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{26}
+	return file_proto_product_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *CreateImageRequest) GetColorId() string {
@@ -1882,7 +6455,7 @@ type TagsPublicResponse struct {
 
 func (x *TagsPublicResponse) Reset() {
 	*x = TagsPublicResponse{}
-	mi := &file_proto_product_proto_msgTypes[27]
+	mi := &file_proto_product_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1894,7 +6467,7 @@ func (x *TagsPublicResponse) String() string {
 func (*TagsPublicResponse) ProtoMessage() {}
 
 func (x *TagsPublicResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[27]
+	mi := &file_proto_product_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1907,7 +6480,7 @@ func (x *TagsPublicResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TagsPublicResponse.ProtoReflect.Descriptor instead.
 func (*TagsPublicResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{27}
+	return file_proto_product_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *TagsPublicResponse) GetTags() []*BaseTagResponse {
@@ -1927,7 +6500,7 @@ type BaseTagResponse struct {
 
 func (x *BaseTagResponse) Reset() {
 	*x = BaseTagResponse{}
-	mi := &file_proto_product_proto_msgTypes[28]
+	mi := &file_proto_product_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1939,7 +6512,7 @@ func (x *BaseTagResponse) String() string {
 func (*BaseTagResponse) ProtoMessage() {}
 
 func (x *BaseTagResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[28]
+	mi := &file_proto_product_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1952,7 +6525,7 @@ func (x *BaseTagResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaseTagResponse.ProtoReflect.Descriptor instead.
 func (*BaseTagResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{28}
+	return file_proto_product_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *BaseTagResponse) GetId() string {
@@ -1978,7 +6551,7 @@ type SizesPublicResponse struct {
 
 func (x *SizesPublicResponse) Reset() {
 	*x = SizesPublicResponse{}
-	mi := &file_proto_product_proto_msgTypes[29]
+	mi := &file_proto_product_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1990,7 +6563,7 @@ func (x *SizesPublicResponse) String() string {
 func (*SizesPublicResponse) ProtoMessage() {}
 
 func (x *SizesPublicResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[29]
+	mi := &file_proto_product_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2003,7 +6576,7 @@ func (x *SizesPublicResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SizesPublicResponse.ProtoReflect.Descriptor instead.
 func (*SizesPublicResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{29}
+	return file_proto_product_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *SizesPublicResponse) GetSizes() []*BaseSizeResponse {
@@ -2022,7 +6595,7 @@ type ColorsPublicResponse struct {
 
 func (x *ColorsPublicResponse) Reset() {
 	*x = ColorsPublicResponse{}
-	mi := &file_proto_product_proto_msgTypes[30]
+	mi := &file_proto_product_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2034,7 +6607,7 @@ func (x *ColorsPublicResponse) String() string {
 func (*ColorsPublicResponse) ProtoMessage() {}
 
 func (x *ColorsPublicResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[30]
+	mi := &file_proto_product_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2047,7 +6620,7 @@ func (x *ColorsPublicResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ColorsPublicResponse.ProtoReflect.Descriptor instead.
 func (*ColorsPublicResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{30}
+	return file_proto_product_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *ColorsPublicResponse) GetColors() []*BaseColorResponse {
@@ -2066,7 +6639,7 @@ type UpdatedResponse struct {
 
 func (x *UpdatedResponse) Reset() {
 	*x = UpdatedResponse{}
-	mi := &file_proto_product_proto_msgTypes[31]
+	mi := &file_proto_product_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2078,7 +6651,7 @@ func (x *UpdatedResponse) String() string {
 func (*UpdatedResponse) ProtoMessage() {}
 
 func (x *UpdatedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[31]
+	mi := &file_proto_product_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2091,7 +6664,7 @@ func (x *UpdatedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatedResponse.ProtoReflect.Descriptor instead.
 func (*UpdatedResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{31}
+	return file_proto_product_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *UpdatedResponse) GetSuccess() bool {
@@ -2106,13 +6679,15 @@ type UpdateTagRequest struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SlugMode      string                 `protobuf:"bytes,4,opt,name=slug_mode,json=slugMode,proto3" json:"slug_mode,omitempty"`
+	Translations  []*TranslationRequest  `protobuf:"bytes,5,rep,name=translations,proto3" json:"translations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateTagRequest) Reset() {
 	*x = UpdateTagRequest{}
-	mi := &file_proto_product_proto_msgTypes[32]
+	mi := &file_proto_product_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2124,7 +6699,7 @@ func (x *UpdateTagRequest) String() string {
 func (*UpdateTagRequest) ProtoMessage() {}
 
 func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[32]
+	mi := &file_proto_product_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2137,7 +6712,7 @@ func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{32}
+	return file_proto_product_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *UpdateTagRequest) GetId() string {
@@ -2161,6 +6736,20 @@ func (x *UpdateTagRequest) GetUserId() string {
 	return ""
 }
 
+func (x *UpdateTagRequest) GetSlugMode() string {
+	if x != nil {
+		return x.SlugMode
+	}
+	return ""
+}
+
+func (x *UpdateTagRequest) GetTranslations() []*TranslationRequest {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
 type TagsAdminResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tags          []*TagAdminResponse    `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
@@ -2170,7 +6759,7 @@ type TagsAdminResponse struct {
 
 func (x *TagsAdminResponse) Reset() {
 	*x = TagsAdminResponse{}
-	mi := &file_proto_product_proto_msgTypes[33]
+	mi := &file_proto_product_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2182,7 +6771,7 @@ func (x *TagsAdminResponse) String() string {
 func (*TagsAdminResponse) ProtoMessage() {}
 
 func (x *TagsAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[33]
+	mi := &file_proto_product_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2195,7 +6784,7 @@ func (x *TagsAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TagsAdminResponse.ProtoReflect.Descriptor instead.
 func (*TagsAdminResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{33}
+	return file_proto_product_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *TagsAdminResponse) GetTags() []*TagAdminResponse {
@@ -2213,13 +6802,14 @@ type TagAdminResponse struct {
 	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CreatedBy     *BaseUserResponse      `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	UpdatedBy     *BaseUserResponse      `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Translations  []*TranslationResponse `protobuf:"bytes,7,rep,name=translations,proto3" json:"translations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TagAdminResponse) Reset() {
 	*x = TagAdminResponse{}
-	mi := &file_proto_product_proto_msgTypes[34]
+	mi := &file_proto_product_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2231,7 +6821,7 @@ func (x *TagAdminResponse) String() string {
 func (*TagAdminResponse) ProtoMessage() {}
 
 func (x *TagAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[34]
+	mi := &file_proto_product_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2244,7 +6834,7 @@ func (x *TagAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TagAdminResponse.ProtoReflect.Descriptor instead.
 func (*TagAdminResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{34}
+	return file_proto_product_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *TagAdminResponse) GetId() string {
@@ -2289,6 +6879,13 @@ func (x *TagAdminResponse) GetUpdatedBy() *BaseUserResponse {
 	return nil
 }
 
+func (x *TagAdminResponse) GetTranslations() []*TranslationResponse {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
 type SizesAdminResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sizes         []*SizeAdminResponse   `protobuf:"bytes,1,rep,name=sizes,proto3" json:"sizes,omitempty"`
@@ -2298,7 +6895,7 @@ type SizesAdminResponse struct {
 
 func (x *SizesAdminResponse) Reset() {
 	*x = SizesAdminResponse{}
-	mi := &file_proto_product_proto_msgTypes[35]
+	mi := &file_proto_product_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2310,7 +6907,7 @@ func (x *SizesAdminResponse) String() string {
 func (*SizesAdminResponse) ProtoMessage() {}
 
 func (x *SizesAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[35]
+	mi := &file_proto_product_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2323,7 +6920,7 @@ func (x *SizesAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SizesAdminResponse.ProtoReflect.Descriptor instead.
 func (*SizesAdminResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{35}
+	return file_proto_product_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *SizesAdminResponse) GetSizes() []*SizeAdminResponse {
@@ -2341,13 +6938,14 @@ type SizeAdminResponse struct {
 	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CreatedBy     *BaseUserResponse      `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	UpdatedBy     *BaseUserResponse      `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Translations  []*TranslationResponse `protobuf:"bytes,7,rep,name=translations,proto3" json:"translations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SizeAdminResponse) Reset() {
 	*x = SizeAdminResponse{}
-	mi := &file_proto_product_proto_msgTypes[36]
+	mi := &file_proto_product_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2359,7 +6957,7 @@ func (x *SizeAdminResponse) String() string {
 func (*SizeAdminResponse) ProtoMessage() {}
 
 func (x *SizeAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[36]
+	mi := &file_proto_product_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2372,7 +6970,7 @@ func (x *SizeAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SizeAdminResponse.ProtoReflect.Descriptor instead.
 func (*SizeAdminResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{36}
+	return file_proto_product_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *SizeAdminResponse) GetId() string {
@@ -2417,6 +7015,13 @@ func (x *SizeAdminResponse) GetUpdatedBy() *BaseUserResponse {
 	return nil
 }
 
+func (x *SizeAdminResponse) GetTranslations() []*TranslationResponse {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
 type ColorsAdminResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Colors        []*ColorAdminResponse  `protobuf:"bytes,1,rep,name=colors,proto3" json:"colors,omitempty"`
@@ -2426,7 +7031,7 @@ type ColorsAdminResponse struct {
 
 func (x *ColorsAdminResponse) Reset() {
 	*x = ColorsAdminResponse{}
-	mi := &file_proto_product_proto_msgTypes[37]
+	mi := &file_proto_product_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2438,7 +7043,7 @@ func (x *ColorsAdminResponse) String() string {
 func (*ColorsAdminResponse) ProtoMessage() {}
 
 func (x *ColorsAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[37]
+	mi := &file_proto_product_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2451,7 +7056,7 @@ func (x *ColorsAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ColorsAdminResponse.ProtoReflect.Descriptor instead.
 func (*ColorsAdminResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{37}
+	return file_proto_product_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *ColorsAdminResponse) GetColors() []*ColorAdminResponse {
@@ -2469,13 +7074,14 @@ type ColorAdminResponse struct {
 	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CreatedBy     *BaseUserResponse      `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	UpdatedBy     *BaseUserResponse      `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Translations  []*TranslationResponse `protobuf:"bytes,7,rep,name=translations,proto3" json:"translations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ColorAdminResponse) Reset() {
 	*x = ColorAdminResponse{}
-	mi := &file_proto_product_proto_msgTypes[38]
+	mi := &file_proto_product_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2487,7 +7093,7 @@ func (x *ColorAdminResponse) String() string {
 func (*ColorAdminResponse) ProtoMessage() {}
 
 func (x *ColorAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[38]
+	mi := &file_proto_product_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2500,7 +7106,7 @@ func (x *ColorAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ColorAdminResponse.ProtoReflect.Descriptor instead.
 func (*ColorAdminResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{38}
+	return file_proto_product_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *ColorAdminResponse) GetId() string {
@@ -2545,20 +7151,37 @@ func (x *ColorAdminResponse) GetUpdatedBy() *BaseUserResponse {
 	return nil
 }
 
+func (x *ColorAdminResponse) GetTranslations() []*TranslationResponse {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
 type UpdateCategoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
-	ParentIds     []string               `protobuf:"bytes,4,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
-	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug               string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	ParentIds          []string               `protobuf:"bytes,4,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
+	UserId             string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Description        string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Banner             *CategoryBannerRequest `protobuf:"bytes,7,opt,name=banner,proto3,oneof" json:"banner,omitempty"`
+	DeleteBanner       bool                   `protobuf:"varint,8,opt,name=delete_banner,json=deleteBanner,proto3" json:"delete_banner,omitempty"`
+	MetaTitle          string                 `protobuf:"bytes,9,opt,name=meta_title,json=metaTitle,proto3" json:"meta_title,omitempty"`
+	MetaDescription    string                 `protobuf:"bytes,10,opt,name=meta_description,json=metaDescription,proto3" json:"meta_description,omitempty"`
+	IsVisible          *bool                  `protobuf:"varint,11,opt,name=is_visible,json=isVisible,proto3,oneof" json:"is_visible,omitempty"`
+	FeaturedProductIds []string               `protobuf:"bytes,12,rep,name=featured_product_ids,json=featuredProductIds,proto3" json:"featured_product_ids,omitempty"`
+	SlugMode           string                 `protobuf:"bytes,13,opt,name=slug_mode,json=slugMode,proto3" json:"slug_mode,omitempty"`
+	Translations       []*TranslationRequest  `protobuf:"bytes,14,rep,name=translations,proto3" json:"translations,omitempty"`
+	ExpectedVersion    *int32                 `protobuf:"varint,15,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *UpdateCategoryRequest) Reset() {
 	*x = UpdateCategoryRequest{}
-	mi := &file_proto_product_proto_msgTypes[39]
+	mi := &file_proto_product_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2570,7 +7193,7 @@ func (x *UpdateCategoryRequest) String() string {
 func (*UpdateCategoryRequest) ProtoMessage() {}
 
 func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[39]
+	mi := &file_proto_product_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2583,7 +7206,7 @@ func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{39}
+	return file_proto_product_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *UpdateCategoryRequest) GetId() string {
@@ -2621,24 +7244,102 @@ func (x *UpdateCategoryRequest) GetUserId() string {
 	return ""
 }
 
+func (x *UpdateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetBanner() *CategoryBannerRequest {
+	if x != nil {
+		return x.Banner
+	}
+	return nil
+}
+
+func (x *UpdateCategoryRequest) GetDeleteBanner() bool {
+	if x != nil {
+		return x.DeleteBanner
+	}
+	return false
+}
+
+func (x *UpdateCategoryRequest) GetMetaTitle() string {
+	if x != nil {
+		return x.MetaTitle
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetMetaDescription() string {
+	if x != nil {
+		return x.MetaDescription
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetIsVisible() bool {
+	if x != nil && x.IsVisible != nil {
+		return *x.IsVisible
+	}
+	return false
+}
+
+func (x *UpdateCategoryRequest) GetFeaturedProductIds() []string {
+	if x != nil {
+		return x.FeaturedProductIds
+	}
+	return nil
+}
+
+func (x *UpdateCategoryRequest) GetSlugMode() string {
+	if x != nil {
+		return x.SlugMode
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetTranslations() []*TranslationRequest {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+func (x *UpdateCategoryRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type CategoryAdminDetailsResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Slug          string                  `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
-	Parents       []*BaseCategoryResponse `protobuf:"bytes,4,rep,name=parents,proto3" json:"parents,omitempty"`
-	CreatedAt     string                  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                  `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy     *BaseUserResponse       `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedBy     *BaseUserResponse       `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	Products      []*BaseProductResponse  `protobuf:"bytes,9,rep,name=products,proto3" json:"products,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	Id               string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug             string                  `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Parents          []*BaseCategoryResponse `protobuf:"bytes,4,rep,name=parents,proto3" json:"parents,omitempty"`
+	CreatedAt        string                  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        string                  `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy        *BaseUserResponse       `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy        *BaseUserResponse       `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Products         []*BaseProductResponse  `protobuf:"bytes,9,rep,name=products,proto3" json:"products,omitempty"`
+	Description      string                  `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	BannerUrl        string                  `protobuf:"bytes,11,opt,name=banner_url,json=bannerUrl,proto3" json:"banner_url,omitempty"`
+	MetaTitle        string                  `protobuf:"bytes,12,opt,name=meta_title,json=metaTitle,proto3" json:"meta_title,omitempty"`
+	MetaDescription  string                  `protobuf:"bytes,13,opt,name=meta_description,json=metaDescription,proto3" json:"meta_description,omitempty"`
+	IsVisible        bool                    `protobuf:"varint,14,opt,name=is_visible,json=isVisible,proto3" json:"is_visible,omitempty"`
+	FeaturedProducts []*BaseProductResponse  `protobuf:"bytes,15,rep,name=featured_products,json=featuredProducts,proto3" json:"featured_products,omitempty"`
+	Translations     []*TranslationResponse  `protobuf:"bytes,16,rep,name=translations,proto3" json:"translations,omitempty"`
+	Version          int32                   `protobuf:"varint,17,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *CategoryAdminDetailsResponse) Reset() {
 	*x = CategoryAdminDetailsResponse{}
-	mi := &file_proto_product_proto_msgTypes[40]
+	mi := &file_proto_product_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2650,7 +7351,7 @@ func (x *CategoryAdminDetailsResponse) String() string {
 func (*CategoryAdminDetailsResponse) ProtoMessage() {}
 
 func (x *CategoryAdminDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[40]
+	mi := &file_proto_product_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2663,7 +7364,7 @@ func (x *CategoryAdminDetailsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryAdminDetailsResponse.ProtoReflect.Descriptor instead.
 func (*CategoryAdminDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{40}
+	return file_proto_product_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *CategoryAdminDetailsResponse) GetId() string {
@@ -2729,6 +7430,62 @@ func (x *CategoryAdminDetailsResponse) GetProducts() []*BaseProductResponse {
 	return nil
 }
 
+func (x *CategoryAdminDetailsResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CategoryAdminDetailsResponse) GetBannerUrl() string {
+	if x != nil {
+		return x.BannerUrl
+	}
+	return ""
+}
+
+func (x *CategoryAdminDetailsResponse) GetMetaTitle() string {
+	if x != nil {
+		return x.MetaTitle
+	}
+	return ""
+}
+
+func (x *CategoryAdminDetailsResponse) GetMetaDescription() string {
+	if x != nil {
+		return x.MetaDescription
+	}
+	return ""
+}
+
+func (x *CategoryAdminDetailsResponse) GetIsVisible() bool {
+	if x != nil {
+		return x.IsVisible
+	}
+	return false
+}
+
+func (x *CategoryAdminDetailsResponse) GetFeaturedProducts() []*BaseProductResponse {
+	if x != nil {
+		return x.FeaturedProducts
+	}
+	return nil
+}
+
+func (x *CategoryAdminDetailsResponse) GetTranslations() []*TranslationResponse {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+func (x *CategoryAdminDetailsResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type BaseProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2741,7 +7498,7 @@ type BaseProductResponse struct {
 
 func (x *BaseProductResponse) Reset() {
 	*x = BaseProductResponse{}
-	mi := &file_proto_product_proto_msgTypes[41]
+	mi := &file_proto_product_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2753,7 +7510,7 @@ func (x *BaseProductResponse) String() string {
 func (*BaseProductResponse) ProtoMessage() {}
 
 func (x *BaseProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[41]
+	mi := &file_proto_product_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2766,7 +7523,7 @@ func (x *BaseProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaseProductResponse.ProtoReflect.Descriptor instead.
 func (*BaseProductResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{41}
+	return file_proto_product_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *BaseProductResponse) GetId() string {
@@ -2808,7 +7565,7 @@ type BaseProfileResponse struct {
 
 func (x *BaseProfileResponse) Reset() {
 	*x = BaseProfileResponse{}
-	mi := &file_proto_product_proto_msgTypes[42]
+	mi := &file_proto_product_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2820,7 +7577,7 @@ func (x *BaseProfileResponse) String() string {
 func (*BaseProfileResponse) ProtoMessage() {}
 
 func (x *BaseProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[42]
+	mi := &file_proto_product_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2833,7 +7590,7 @@ func (x *BaseProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaseProfileResponse.ProtoReflect.Descriptor instead.
 func (*BaseProfileResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{42}
+	return file_proto_product_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *BaseProfileResponse) GetId() string {
@@ -2868,7 +7625,7 @@ type BaseUserResponse struct {
 
 func (x *BaseUserResponse) Reset() {
 	*x = BaseUserResponse{}
-	mi := &file_proto_product_proto_msgTypes[43]
+	mi := &file_proto_product_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2880,7 +7637,7 @@ func (x *BaseUserResponse) String() string {
 func (*BaseUserResponse) ProtoMessage() {}
 
 func (x *BaseUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[43]
+	mi := &file_proto_product_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2893,7 +7650,7 @@ func (x *BaseUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaseUserResponse.ProtoReflect.Descriptor instead.
 func (*BaseUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{43}
+	return file_proto_product_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *BaseUserResponse) GetId() string {
@@ -2933,7 +7690,7 @@ type CategoryAdminResponse struct {
 
 func (x *CategoryAdminResponse) Reset() {
 	*x = CategoryAdminResponse{}
-	mi := &file_proto_product_proto_msgTypes[44]
+	mi := &file_proto_product_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2945,7 +7702,7 @@ func (x *CategoryAdminResponse) String() string {
 func (*CategoryAdminResponse) ProtoMessage() {}
 
 func (x *CategoryAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[44]
+	mi := &file_proto_product_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2958,7 +7715,7 @@ func (x *CategoryAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryAdminResponse.ProtoReflect.Descriptor instead.
 func (*CategoryAdminResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{44}
+	return file_proto_product_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *CategoryAdminResponse) GetId() string {
@@ -3021,13 +7778,15 @@ type CreateTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SlugMode      string                 `protobuf:"bytes,3,opt,name=slug_mode,json=slugMode,proto3" json:"slug_mode,omitempty"`
+	Translations  []*TranslationRequest  `protobuf:"bytes,4,rep,name=translations,proto3" json:"translations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateTagRequest) Reset() {
 	*x = CreateTagRequest{}
-	mi := &file_proto_product_proto_msgTypes[45]
+	mi := &file_proto_product_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3039,7 +7798,7 @@ func (x *CreateTagRequest) String() string {
 func (*CreateTagRequest) ProtoMessage() {}
 
 func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[45]
+	mi := &file_proto_product_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3052,7 +7811,7 @@ func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
 func (*CreateTagRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{45}
+	return file_proto_product_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *CreateTagRequest) GetName() string {
@@ -3069,6 +7828,20 @@ func (x *CreateTagRequest) GetUserId() string {
 	return ""
 }
 
+func (x *CreateTagRequest) GetSlugMode() string {
+	if x != nil {
+		return x.SlugMode
+	}
+	return ""
+}
+
+func (x *CreateTagRequest) GetTranslations() []*TranslationRequest {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
 type GetProductsByCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
@@ -3078,7 +7851,7 @@ type GetProductsByCategoryRequest struct {
 
 func (x *GetProductsByCategoryRequest) Reset() {
 	*x = GetProductsByCategoryRequest{}
-	mi := &file_proto_product_proto_msgTypes[46]
+	mi := &file_proto_product_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3090,7 +7863,7 @@ func (x *GetProductsByCategoryRequest) String() string {
 func (*GetProductsByCategoryRequest) ProtoMessage() {}
 
 func (x *GetProductsByCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[46]
+	mi := &file_proto_product_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3103,7 +7876,7 @@ func (x *GetProductsByCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductsByCategoryRequest.ProtoReflect.Descriptor instead.
 func (*GetProductsByCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{46}
+	return file_proto_product_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *GetProductsByCategoryRequest) GetSlug() string {
@@ -3122,7 +7895,7 @@ type ProductsPublicResponse struct {
 
 func (x *ProductsPublicResponse) Reset() {
 	*x = ProductsPublicResponse{}
-	mi := &file_proto_product_proto_msgTypes[47]
+	mi := &file_proto_product_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3134,7 +7907,7 @@ func (x *ProductsPublicResponse) String() string {
 func (*ProductsPublicResponse) ProtoMessage() {}
 
 func (x *ProductsPublicResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[47]
+	mi := &file_proto_product_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3147,7 +7920,7 @@ func (x *ProductsPublicResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductsPublicResponse.ProtoReflect.Descriptor instead.
 func (*ProductsPublicResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{47}
+	return file_proto_product_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *ProductsPublicResponse) GetProducts() []*ProductPublicResponse {
@@ -3161,13 +7934,15 @@ type CreateSizeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SlugMode      string                 `protobuf:"bytes,3,opt,name=slug_mode,json=slugMode,proto3" json:"slug_mode,omitempty"`
+	Translations  []*TranslationRequest  `protobuf:"bytes,4,rep,name=translations,proto3" json:"translations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateSizeRequest) Reset() {
 	*x = CreateSizeRequest{}
-	mi := &file_proto_product_proto_msgTypes[48]
+	mi := &file_proto_product_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3179,7 +7954,7 @@ func (x *CreateSizeRequest) String() string {
 func (*CreateSizeRequest) ProtoMessage() {}
 
 func (x *CreateSizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[48]
+	mi := &file_proto_product_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3192,7 +7967,7 @@ func (x *CreateSizeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSizeRequest.ProtoReflect.Descriptor instead.
 func (*CreateSizeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{48}
+	return file_proto_product_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *CreateSizeRequest) GetName() string {
@@ -3209,17 +7984,33 @@ func (x *CreateSizeRequest) GetUserId() string {
 	return ""
 }
 
+func (x *CreateSizeRequest) GetSlugMode() string {
+	if x != nil {
+		return x.SlugMode
+	}
+	return ""
+}
+
+func (x *CreateSizeRequest) GetTranslations() []*TranslationRequest {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
 type CreateColorRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SlugMode      string                 `protobuf:"bytes,3,opt,name=slug_mode,json=slugMode,proto3" json:"slug_mode,omitempty"`
+	Translations  []*TranslationRequest  `protobuf:"bytes,4,rep,name=translations,proto3" json:"translations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateColorRequest) Reset() {
 	*x = CreateColorRequest{}
-	mi := &file_proto_product_proto_msgTypes[49]
+	mi := &file_proto_product_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3231,7 +8022,7 @@ func (x *CreateColorRequest) String() string {
 func (*CreateColorRequest) ProtoMessage() {}
 
 func (x *CreateColorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[49]
+	mi := &file_proto_product_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3244,7 +8035,7 @@ func (x *CreateColorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateColorRequest.ProtoReflect.Descriptor instead.
 func (*CreateColorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{49}
+	return file_proto_product_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *CreateColorRequest) GetName() string {
@@ -3261,6 +8052,20 @@ func (x *CreateColorRequest) GetUserId() string {
 	return ""
 }
 
+func (x *CreateColorRequest) GetSlugMode() string {
+	if x != nil {
+		return x.SlugMode
+	}
+	return ""
+}
+
+func (x *CreateColorRequest) GetTranslations() []*TranslationRequest {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
 type CreatedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -3270,7 +8075,7 @@ type CreatedResponse struct {
 
 func (x *CreatedResponse) Reset() {
 	*x = CreatedResponse{}
-	mi := &file_proto_product_proto_msgTypes[50]
+	mi := &file_proto_product_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3282,7 +8087,7 @@ func (x *CreatedResponse) String() string {
 func (*CreatedResponse) ProtoMessage() {}
 
 func (x *CreatedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[50]
+	mi := &file_proto_product_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3295,7 +8100,7 @@ func (x *CreatedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatedResponse.ProtoReflect.Descriptor instead.
 func (*CreatedResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{50}
+	return file_proto_product_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *CreatedResponse) GetId() string {
@@ -3314,7 +8119,7 @@ type GetProductBySlugRequest struct {
 
 func (x *GetProductBySlugRequest) Reset() {
 	*x = GetProductBySlugRequest{}
-	mi := &file_proto_product_proto_msgTypes[51]
+	mi := &file_proto_product_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3326,7 +8131,7 @@ func (x *GetProductBySlugRequest) String() string {
 func (*GetProductBySlugRequest) ProtoMessage() {}
 
 func (x *GetProductBySlugRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[51]
+	mi := &file_proto_product_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3339,7 +8144,7 @@ func (x *GetProductBySlugRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductBySlugRequest.ProtoReflect.Descriptor instead.
 func (*GetProductBySlugRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{51}
+	return file_proto_product_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *GetProductBySlugRequest) GetSlug() string {
@@ -3350,26 +8155,34 @@ func (x *GetProductBySlugRequest) GetSlug() string {
 }
 
 type ProductPublicResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Slug          string                  `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
-	Description   string                  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float32                 `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
-	IsSale        *bool                   `protobuf:"varint,6,opt,name=is_sale,json=isSale,proto3,oneof" json:"is_sale,omitempty"`
-	SalePrice     *float32                `protobuf:"fixed32,7,opt,name=sale_price,json=salePrice,proto3,oneof" json:"sale_price,omitempty"`
-	StartSale     *string                 `protobuf:"bytes,8,opt,name=start_sale,json=startSale,proto3,oneof" json:"start_sale,omitempty"`
-	EndSale       *string                 `protobuf:"bytes,9,opt,name=end_sale,json=endSale,proto3,oneof" json:"end_sale,omitempty"`
-	Categories    []*BaseCategoryResponse `protobuf:"bytes,10,rep,name=categories,proto3" json:"categories,omitempty"`
-	Variants      []*BaseVariantResponse  `protobuf:"bytes,11,rep,name=variants,proto3" json:"variants,omitempty"`
-	Images        []*BaseImageResponse    `protobuf:"bytes,12,rep,name=images,proto3" json:"images,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState    `protogen:"open.v1"`
+	Id              string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           string                    `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Slug            string                    `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description     string                    `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price           float32                   `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
+	IsSale          *bool                     `protobuf:"varint,6,opt,name=is_sale,json=isSale,proto3,oneof" json:"is_sale,omitempty"`
+	SalePrice       *float32                  `protobuf:"fixed32,7,opt,name=sale_price,json=salePrice,proto3,oneof" json:"sale_price,omitempty"`
+	StartSale       *string                   `protobuf:"bytes,8,opt,name=start_sale,json=startSale,proto3,oneof" json:"start_sale,omitempty"`
+	EndSale         *string                   `protobuf:"bytes,9,opt,name=end_sale,json=endSale,proto3,oneof" json:"end_sale,omitempty"`
+	Categories      []*BaseCategoryResponse   `protobuf:"bytes,10,rep,name=categories,proto3" json:"categories,omitempty"`
+	Variants        []*BaseVariantResponse    `protobuf:"bytes,11,rep,name=variants,proto3" json:"variants,omitempty"`
+	Images          []*BaseImageResponse      `protobuf:"bytes,12,rep,name=images,proto3" json:"images,omitempty"`
+	IsBundle        *bool                     `protobuf:"varint,13,opt,name=is_bundle,json=isBundle,proto3,oneof" json:"is_bundle,omitempty"`
+	BundleItems     []*BaseBundleItemResponse `protobuf:"bytes,14,rep,name=bundle_items,json=bundleItems,proto3" json:"bundle_items,omitempty"`
+	BundleStock     *int64                    `protobuf:"varint,15,opt,name=bundle_stock,json=bundleStock,proto3,oneof" json:"bundle_stock,omitempty"`
+	AverageRating   float32                   `protobuf:"fixed32,16,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	ReviewCount     uint32                    `protobuf:"varint,17,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	PrimaryCategory *BaseCategoryResponse     `protobuf:"bytes,18,opt,name=primary_category,json=primaryCategory,proto3" json:"primary_category,omitempty"`
+	Breadcrumbs     []*CategoryPathResponse   `protobuf:"bytes,19,rep,name=breadcrumbs,proto3" json:"breadcrumbs,omitempty"`
+	RedirectTo      *string                   `protobuf:"bytes,20,opt,name=redirect_to,json=redirectTo,proto3,oneof" json:"redirect_to,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ProductPublicResponse) Reset() {
 	*x = ProductPublicResponse{}
-	mi := &file_proto_product_proto_msgTypes[52]
+	mi := &file_proto_product_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3381,7 +8194,7 @@ func (x *ProductPublicResponse) String() string {
 func (*ProductPublicResponse) ProtoMessage() {}
 
 func (x *ProductPublicResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[52]
+	mi := &file_proto_product_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3394,7 +8207,7 @@ func (x *ProductPublicResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductPublicResponse.ProtoReflect.Descriptor instead.
 func (*ProductPublicResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{52}
+	return file_proto_product_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *ProductPublicResponse) GetId() string {
@@ -3481,6 +8294,114 @@ func (x *ProductPublicResponse) GetImages() []*BaseImageResponse {
 	return nil
 }
 
+func (x *ProductPublicResponse) GetIsBundle() bool {
+	if x != nil && x.IsBundle != nil {
+		return *x.IsBundle
+	}
+	return false
+}
+
+func (x *ProductPublicResponse) GetBundleItems() []*BaseBundleItemResponse {
+	if x != nil {
+		return x.BundleItems
+	}
+	return nil
+}
+
+func (x *ProductPublicResponse) GetBundleStock() int64 {
+	if x != nil && x.BundleStock != nil {
+		return *x.BundleStock
+	}
+	return 0
+}
+
+func (x *ProductPublicResponse) GetAverageRating() float32 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *ProductPublicResponse) GetReviewCount() uint32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *ProductPublicResponse) GetPrimaryCategory() *BaseCategoryResponse {
+	if x != nil {
+		return x.PrimaryCategory
+	}
+	return nil
+}
+
+func (x *ProductPublicResponse) GetBreadcrumbs() []*CategoryPathResponse {
+	if x != nil {
+		return x.Breadcrumbs
+	}
+	return nil
+}
+
+func (x *ProductPublicResponse) GetRedirectTo() string {
+	if x != nil && x.RedirectTo != nil {
+		return *x.RedirectTo
+	}
+	return ""
+}
+
+type CategoryPathResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Categories    []*BaseCategoryResponse `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	IsPrimary     bool                    `protobuf:"varint,2,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryPathResponse) Reset() {
+	*x = CategoryPathResponse{}
+	mi := &file_proto_product_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryPathResponse) ProtoMessage() {}
+
+func (x *CategoryPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryPathResponse.ProtoReflect.Descriptor instead.
+func (*CategoryPathResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CategoryPathResponse) GetCategories() []*BaseCategoryResponse {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *CategoryPathResponse) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
 type BaseImageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -3494,7 +8415,7 @@ type BaseImageResponse struct {
 
 func (x *BaseImageResponse) Reset() {
 	*x = BaseImageResponse{}
-	mi := &file_proto_product_proto_msgTypes[53]
+	mi := &file_proto_product_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3506,7 +8427,7 @@ func (x *BaseImageResponse) String() string {
 func (*BaseImageResponse) ProtoMessage() {}
 
 func (x *BaseImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[53]
+	mi := &file_proto_product_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3519,7 +8440,7 @@ func (x *BaseImageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaseImageResponse.ProtoReflect.Descriptor instead.
 func (*BaseImageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{53}
+	return file_proto_product_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *BaseImageResponse) GetId() string {
@@ -3567,7 +8488,7 @@ type BaseColorResponse struct {
 
 func (x *BaseColorResponse) Reset() {
 	*x = BaseColorResponse{}
-	mi := &file_proto_product_proto_msgTypes[54]
+	mi := &file_proto_product_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3579,7 +8500,7 @@ func (x *BaseColorResponse) String() string {
 func (*BaseColorResponse) ProtoMessage() {}
 
 func (x *BaseColorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[54]
+	mi := &file_proto_product_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3592,7 +8513,7 @@ func (x *BaseColorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaseColorResponse.ProtoReflect.Descriptor instead.
 func (*BaseColorResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{54}
+	return file_proto_product_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *BaseColorResponse) GetId() string {
@@ -3619,7 +8540,7 @@ type BaseSizeResponse struct {
 
 func (x *BaseSizeResponse) Reset() {
 	*x = BaseSizeResponse{}
-	mi := &file_proto_product_proto_msgTypes[55]
+	mi := &file_proto_product_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3631,7 +8552,7 @@ func (x *BaseSizeResponse) String() string {
 func (*BaseSizeResponse) ProtoMessage() {}
 
 func (x *BaseSizeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[55]
+	mi := &file_proto_product_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3644,7 +8565,7 @@ func (x *BaseSizeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaseSizeResponse.ProtoReflect.Descriptor instead.
 func (*BaseSizeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{55}
+	return file_proto_product_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *BaseSizeResponse) GetId() string {
@@ -3674,7 +8595,7 @@ type BaseInventoryResponse struct {
 
 func (x *BaseInventoryResponse) Reset() {
 	*x = BaseInventoryResponse{}
-	mi := &file_proto_product_proto_msgTypes[56]
+	mi := &file_proto_product_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3686,7 +8607,7 @@ func (x *BaseInventoryResponse) String() string {
 func (*BaseInventoryResponse) ProtoMessage() {}
 
 func (x *BaseInventoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[56]
+	mi := &file_proto_product_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3699,7 +8620,7 @@ func (x *BaseInventoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaseInventoryResponse.ProtoReflect.Descriptor instead.
 func (*BaseInventoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{56}
+	return file_proto_product_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *BaseInventoryResponse) GetId() string {
@@ -3750,94 +8671,224 @@ type BaseVariantResponse struct {
 
 func (x *BaseVariantResponse) Reset() {
 	*x = BaseVariantResponse{}
-	mi := &file_proto_product_proto_msgTypes[57]
+	mi := &file_proto_product_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BaseVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseVariantResponse) ProtoMessage() {}
+
+func (x *BaseVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaseVariantResponse.ProtoReflect.Descriptor instead.
+func (*BaseVariantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *BaseVariantResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BaseVariantResponse) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *BaseVariantResponse) GetColor() *BaseColorResponse {
+	if x != nil {
+		return x.Color
+	}
+	return nil
+}
+
+func (x *BaseVariantResponse) GetSize() *BaseSizeResponse {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+func (x *BaseVariantResponse) GetInventory() *BaseInventoryResponse {
+	if x != nil {
+		return x.Inventory
+	}
+	return nil
+}
+
+type CreateCategoryRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Slug               *string                `protobuf:"bytes,2,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	ParentIds          []string               `protobuf:"bytes,3,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
+	UserId             string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Description        string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Banner             *CategoryBannerRequest `protobuf:"bytes,6,opt,name=banner,proto3,oneof" json:"banner,omitempty"`
+	MetaTitle          string                 `protobuf:"bytes,7,opt,name=meta_title,json=metaTitle,proto3" json:"meta_title,omitempty"`
+	MetaDescription    string                 `protobuf:"bytes,8,opt,name=meta_description,json=metaDescription,proto3" json:"meta_description,omitempty"`
+	IsVisible          *bool                  `protobuf:"varint,9,opt,name=is_visible,json=isVisible,proto3,oneof" json:"is_visible,omitempty"`
+	FeaturedProductIds []string               `protobuf:"bytes,10,rep,name=featured_product_ids,json=featuredProductIds,proto3" json:"featured_product_ids,omitempty"`
+	SlugMode           string                 `protobuf:"bytes,11,opt,name=slug_mode,json=slugMode,proto3" json:"slug_mode,omitempty"`
+	Translations       []*TranslationRequest  `protobuf:"bytes,12,rep,name=translations,proto3" json:"translations,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_proto_product_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BaseVariantResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetParentIds() []string {
+	if x != nil {
+		return x.ParentIds
+	}
+	return nil
+}
+
+func (x *CreateCategoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
-func (*BaseVariantResponse) ProtoMessage() {}
-
-func (x *BaseVariantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[57]
+func (x *CreateCategoryRequest) GetDescription() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Description
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use BaseVariantResponse.ProtoReflect.Descriptor instead.
-func (*BaseVariantResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{57}
+func (x *CreateCategoryRequest) GetBanner() *CategoryBannerRequest {
+	if x != nil {
+		return x.Banner
+	}
+	return nil
 }
 
-func (x *BaseVariantResponse) GetId() string {
+func (x *CreateCategoryRequest) GetMetaTitle() string {
 	if x != nil {
-		return x.Id
+		return x.MetaTitle
 	}
 	return ""
 }
 
-func (x *BaseVariantResponse) GetSku() string {
+func (x *CreateCategoryRequest) GetMetaDescription() string {
 	if x != nil {
-		return x.Sku
+		return x.MetaDescription
 	}
 	return ""
 }
 
-func (x *BaseVariantResponse) GetColor() *BaseColorResponse {
+func (x *CreateCategoryRequest) GetIsVisible() bool {
+	if x != nil && x.IsVisible != nil {
+		return *x.IsVisible
+	}
+	return false
+}
+
+func (x *CreateCategoryRequest) GetFeaturedProductIds() []string {
 	if x != nil {
-		return x.Color
+		return x.FeaturedProductIds
 	}
 	return nil
 }
 
-func (x *BaseVariantResponse) GetSize() *BaseSizeResponse {
+func (x *CreateCategoryRequest) GetSlugMode() string {
 	if x != nil {
-		return x.Size
+		return x.SlugMode
 	}
-	return nil
+	return ""
 }
 
-func (x *BaseVariantResponse) GetInventory() *BaseInventoryResponse {
+func (x *CreateCategoryRequest) GetTranslations() []*TranslationRequest {
 	if x != nil {
-		return x.Inventory
+		return x.Translations
 	}
 	return nil
 }
 
-type CreateCategoryRequest struct {
+type CategoryBannerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Slug          *string                `protobuf:"bytes,2,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
-	ParentIds     []string               `protobuf:"bytes,3,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
-	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Base64Data    string                 `protobuf:"bytes,1,opt,name=base64_data,json=base64Data,proto3" json:"base64_data,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCategoryRequest) Reset() {
-	*x = CreateCategoryRequest{}
-	mi := &file_proto_product_proto_msgTypes[58]
+func (x *CategoryBannerRequest) Reset() {
+	*x = CategoryBannerRequest{}
+	mi := &file_proto_product_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCategoryRequest) String() string {
+func (x *CategoryBannerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCategoryRequest) ProtoMessage() {}
+func (*CategoryBannerRequest) ProtoMessage() {}
 
-func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[58]
+func (x *CategoryBannerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3848,35 +8899,21 @@ func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use CategoryBannerRequest.ProtoReflect.Descriptor instead.
+func (*CategoryBannerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *CreateCategoryRequest) GetName() string {
+func (x *CategoryBannerRequest) GetBase64Data() string {
 	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateCategoryRequest) GetSlug() string {
-	if x != nil && x.Slug != nil {
-		return *x.Slug
+		return x.Base64Data
 	}
 	return ""
 }
 
-func (x *CreateCategoryRequest) GetParentIds() []string {
-	if x != nil {
-		return x.ParentIds
-	}
-	return nil
-}
-
-func (x *CreateCategoryRequest) GetUserId() string {
+func (x *CategoryBannerRequest) GetFileName() string {
 	if x != nil {
-		return x.UserId
+		return x.FileName
 	}
 	return ""
 }
@@ -3892,7 +8929,7 @@ type BaseCategoryResponse struct {
 
 func (x *BaseCategoryResponse) Reset() {
 	*x = BaseCategoryResponse{}
-	mi := &file_proto_product_proto_msgTypes[59]
+	mi := &file_proto_product_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3904,7 +8941,7 @@ func (x *BaseCategoryResponse) String() string {
 func (*BaseCategoryResponse) ProtoMessage() {}
 
 func (x *BaseCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[59]
+	mi := &file_proto_product_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3917,7 +8954,7 @@ func (x *BaseCategoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaseCategoryResponse.ProtoReflect.Descriptor instead.
 func (*BaseCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{59}
+	return file_proto_product_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *BaseCategoryResponse) GetId() string {
@@ -3953,7 +8990,7 @@ type CategoryPublicResponse struct {
 
 func (x *CategoryPublicResponse) Reset() {
 	*x = CategoryPublicResponse{}
-	mi := &file_proto_product_proto_msgTypes[60]
+	mi := &file_proto_product_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3965,7 +9002,7 @@ func (x *CategoryPublicResponse) String() string {
 func (*CategoryPublicResponse) ProtoMessage() {}
 
 func (x *CategoryPublicResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[60]
+	mi := &file_proto_product_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3978,7 +9015,7 @@ func (x *CategoryPublicResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryPublicResponse.ProtoReflect.Descriptor instead.
 func (*CategoryPublicResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{60}
+	return file_proto_product_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *CategoryPublicResponse) GetId() string {
@@ -4018,7 +9055,7 @@ type CategoryTreeResponse struct {
 
 func (x *CategoryTreeResponse) Reset() {
 	*x = CategoryTreeResponse{}
-	mi := &file_proto_product_proto_msgTypes[61]
+	mi := &file_proto_product_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4030,7 +9067,7 @@ func (x *CategoryTreeResponse) String() string {
 func (*CategoryTreeResponse) ProtoMessage() {}
 
 func (x *CategoryTreeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[61]
+	mi := &file_proto_product_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4043,7 +9080,7 @@ func (x *CategoryTreeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryTreeResponse.ProtoReflect.Descriptor instead.
 func (*CategoryTreeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{61}
+	return file_proto_product_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *CategoryTreeResponse) GetCategories() []*CategoryPublicResponse {
@@ -4053,11 +9090,530 @@ func (x *CategoryTreeResponse) GetCategories() []*CategoryPublicResponse {
 	return nil
 }
 
+type TranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	Fields        map[string]string      `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TranslationRequest) Reset() {
+	*x = TranslationRequest{}
+	mi := &file_proto_product_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranslationRequest) ProtoMessage() {}
+
+func (x *TranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslationRequest.ProtoReflect.Descriptor instead.
+func (*TranslationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *TranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *TranslationRequest) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type TranslationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	Fields        map[string]string      `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TranslationResponse) Reset() {
+	*x = TranslationResponse{}
+	mi := &file_proto_product_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TranslationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranslationResponse) ProtoMessage() {}
+
+func (x *TranslationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslationResponse.ProtoReflect.Descriptor instead.
+func (*TranslationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *TranslationResponse) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *TranslationResponse) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 var File_proto_product_proto protoreflect.FileDescriptor
 
 const file_proto_product_proto_rawDesc = "" +
 	"\n" +
-	"\x13proto/product.proto\x12\aproduct\"/\n" +
+	"\x13proto/product.proto\x12\aproduct\x1a\x1bbuf/validate/validate.proto\"\xa3\x02\n" +
+	"\x13GetAuditLogsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\rR\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\x12\x14\n" +
+	"\x05order\x18\x03 \x01(\tR\x05order\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x12\x1f\n" +
+	"\ventity_type\x18\x06 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\a \x01(\tR\bentityId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\b \x01(\tR\trequestId\x12\x17\n" +
+	"\x04from\x18\t \x01(\tH\x00R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\n" +
+	" \x01(\tH\x01R\x02to\x88\x01\x01B\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"h\n" +
+	"\x16AuditLogChangeResponse\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1d\n" +
+	"\n" +
+	"from_value\x18\x02 \x01(\tR\tfromValue\x12\x19\n" +
+	"\bto_value\x18\x03 \x01(\tR\atoValue\"\xba\x02\n" +
+	"\x10AuditLogResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x1f\n" +
+	"\ventity_type\x18\x04 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x05 \x01(\tR\bentityId\x12\x16\n" +
+	"\x06method\x18\x06 \x01(\tR\x06method\x129\n" +
+	"\achanges\x18\a \x03(\v2\x1f.product.AuditLogChangeResponseR\achanges\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12/\n" +
+	"\x05actor\x18\t \x01(\v2\x19.product.BaseUserResponseR\x05actor\"w\n" +
+	"\x11AuditLogsResponse\x12-\n" +
+	"\x04logs\x18\x01 \x03(\v2\x19.product.AuditLogResponseR\x04logs\x123\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1f.product.PaginationMetaResponseR\x04meta\"e\n" +
+	"\x1aGetProductRevisionsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\rR\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\"\xe0\x01\n" +
+	"\x17ProductRevisionResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\rR\brevision\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04slug\x18\x04 \x01(\tR\x04slug\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x128\n" +
+	"\n" +
+	"created_by\x18\a \x01(\v2\x19.product.BaseUserResponseR\tcreatedBy\"\x8f\x01\n" +
+	"\x18ProductRevisionsResponse\x12>\n" +
+	"\trevisions\x18\x01 \x03(\v2 .product.ProductRevisionResponseR\trevisions\x123\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1f.product.PaginationMetaResponseR\x04meta\"\x8c\x01\n" +
+	"\x1bDiffProductRevisionsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12(\n" +
+	"\x10from_revision_id\x18\x02 \x01(\tR\x0efromRevisionId\x12$\n" +
+	"\x0eto_revision_id\x18\x03 \x01(\tR\ftoRevisionId\"\x98\x01\n" +
+	" ProductRevisionFieldDiffResponse\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\"\n" +
+	"\n" +
+	"from_value\x18\x02 \x01(\tH\x00R\tfromValue\x88\x01\x01\x12\x1e\n" +
+	"\bto_value\x18\x03 \x01(\tH\x01R\atoValue\x88\x01\x01B\r\n" +
+	"\v_from_valueB\v\n" +
+	"\t_to_value\"\xc7\x01\n" +
+	"\x1bProductRevisionDiffResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12#\n" +
+	"\rfrom_revision\x18\x02 \x01(\rR\ffromRevision\x12\x1f\n" +
+	"\vto_revision\x18\x03 \x01(\rR\n" +
+	"toRevision\x12C\n" +
+	"\achanges\x18\x04 \x03(\v2).product.ProductRevisionFieldDiffResponseR\achanges\"\xa6\x01\n" +
+	"\x1eRollbackProductRevisionRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vrevision_id\x18\x02 \x01(\tR\n" +
+	"revisionId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12+\n" +
+	"\x11restore_lifecycle\x18\x04 \x01(\bR\x10restoreLifecycle\"\xe8\x01\n" +
+	"\x17DuplicateProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x17\n" +
+	"\x04slug\x18\x03 \x01(\tH\x01R\x04slug\x88\x01\x01\x12\x1b\n" +
+	"\tslug_mode\x18\x04 \x01(\tR\bslugMode\x12\x1d\n" +
+	"\n" +
+	"sku_suffix\x18\x05 \x01(\tR\tskuSuffix\x12!\n" +
+	"\fsku_template\x18\x06 \x01(\tR\vskuTemplate\x12\x17\n" +
+	"\auser_id\x18\a \x01(\tR\x06userIdB\b\n" +
+	"\x06_titleB\a\n" +
+	"\x05_slug\".\n" +
+	"\x18GetCategoryBySlugRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"\xdf\x03\n" +
+	"\x1dCategoryPublicDetailsResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"banner_url\x18\x05 \x01(\tR\tbannerUrl\x12\x1d\n" +
+	"\n" +
+	"meta_title\x18\x06 \x01(\tR\tmetaTitle\x12)\n" +
+	"\x10meta_description\x18\a \x01(\tR\x0fmetaDescription\x12?\n" +
+	"\vbreadcrumbs\x18\b \x03(\v2\x1d.product.BaseCategoryResponseR\vbreadcrumbs\x129\n" +
+	"\bchildren\x18\t \x03(\v2\x1d.product.BaseCategoryResponseR\bchildren\x12I\n" +
+	"\x11featured_products\x18\n" +
+	" \x03(\v2\x1c.product.BaseProductResponseR\x10featuredProducts\x12$\n" +
+	"\vredirect_to\x18\v \x01(\tH\x00R\n" +
+	"redirectTo\x88\x01\x01B\x0e\n" +
+	"\f_redirect_to\"\xd0\x01\n" +
+	"\x13MoveCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x0efrom_parent_id\x18\x02 \x01(\tH\x00R\ffromParentId\x88\x01\x01\x12%\n" +
+	"\fto_parent_id\x18\x03 \x01(\tH\x01R\n" +
+	"toParentId\x88\x01\x01\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\rR\bposition\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userIdB\x11\n" +
+	"\x0f_from_parent_idB\x0f\n" +
+	"\r_to_parent_id\"s\n" +
+	"\x1eReorderCategoryChildrenRequest\x12\x1b\n" +
+	"\tparent_id\x18\x01 \x01(\tR\bparentId\x12\x1b\n" +
+	"\tchild_ids\x18\x02 \x03(\tR\bchildIds\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"Y\n" +
+	"\x17CategoriesAdminResponse\x12>\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1e.product.CategoryAdminResponseR\n" +
+	"categories\"\x81\x01\n" +
+	"\x14AddToWishlistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\"\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\tH\x00R\tvariantId\x88\x01\x01B\r\n" +
+	"\v_variant_id\"D\n" +
+	"\x19RemoveFromWishlistRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"-\n" +
+	"\x12GetWishlistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x8f\x03\n" +
+	"\x14WishlistItemResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x126\n" +
+	"\aproduct\x18\x02 \x01(\v2\x1c.product.BaseProductResponseR\aproduct\x126\n" +
+	"\avariant\x18\x03 \x01(\v2\x1c.product.BaseVariantResponseR\avariant\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x02R\x05price\x12%\n" +
+	"\x0eoriginal_price\x18\x05 \x01(\x02R\roriginalPrice\x12\x1c\n" +
+	"\ais_sale\x18\x06 \x01(\bH\x00R\x06isSale\x88\x01\x01\x12\x14\n" +
+	"\x05stock\x18\a \x01(\x03R\x05stock\x12\x1e\n" +
+	"\bis_stock\x18\b \x01(\bH\x01R\aisStock\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\t \x01(\bH\x02R\bisActive\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAtB\n" +
+	"\n" +
+	"\b_is_saleB\v\n" +
+	"\t_is_stockB\f\n" +
+	"\n" +
+	"_is_active\"G\n" +
+	"\x10WishlistResponse\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.product.WishlistItemResponseR\x05items\"{\n" +
+	"\x15CreateQuestionRequest\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tproductId\x12!\n" +
+	"\acontent\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\acontent\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"{\n" +
+	"\x13CreateAnswerRequest\x12(\n" +
+	"\vquestion_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"questionId\x12!\n" +
+	"\acontent\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\acontent\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"8\n" +
+	"\rUpvoteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x8f\x01\n" +
+	"\x1aGetProductQuestionsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\rR\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05order\x18\x05 \x01(\tR\x05order\"\x90\x01\n" +
+	"\x19GetQuestionAnswersRequest\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\tR\n" +
+	"questionId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\rR\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05order\x18\x05 \x01(\tR\x05order\"\xc0\x01\n" +
+	"\x1bGetAllQuestionsAdminRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\rR\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05order\x18\x04 \x01(\tR\x05order\x12\x16\n" +
+	"\x06search\x18\x05 \x01(\tR\x06search\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x06 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\"\xc0\x01\n" +
+	"\x19GetAllAnswersAdminRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\rR\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05order\x18\x04 \x01(\tR\x05order\x12\x16\n" +
+	"\x06search\x18\x05 \x01(\tR\x06search\x12\x1f\n" +
+	"\vquestion_id\x18\x06 \x01(\tR\n" +
+	"questionId\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\"R\n" +
+	"\x0fModerateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\xd6\x01\n" +
+	"\x16QuestionPublicResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12!\n" +
+	"\fupvote_count\x18\x03 \x01(\rR\vupvoteCount\x12!\n" +
+	"\fanswer_count\x18\x04 \x01(\rR\vanswerCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12-\n" +
+	"\x04user\x18\x06 \x01(\v2\x19.product.BaseUserResponseR\x04user\"\x8d\x01\n" +
+	"\x17QuestionsPublicResponse\x12=\n" +
+	"\tquestions\x18\x01 \x03(\v2\x1f.product.QuestionPublicResponseR\tquestions\x123\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1f.product.PaginationMetaResponseR\x04meta\"\xde\x01\n" +
+	"\x14AnswerPublicResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1e\n" +
+	"\bis_staff\x18\x03 \x01(\bH\x00R\aisStaff\x88\x01\x01\x12!\n" +
+	"\fupvote_count\x18\x04 \x01(\rR\vupvoteCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12-\n" +
+	"\x04user\x18\x06 \x01(\v2\x19.product.BaseUserResponseR\x04userB\v\n" +
+	"\t_is_staff\"\x85\x01\n" +
+	"\x15AnswersPublicResponse\x127\n" +
+	"\aanswers\x18\x01 \x03(\v2\x1d.product.AnswerPublicResponseR\aanswers\x123\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1f.product.PaginationMetaResponseR\x04meta\"\xa2\x03\n" +
+	"\x15QuestionAdminResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12!\n" +
+	"\fupvote_count\x18\x05 \x01(\rR\vupvoteCount\x12!\n" +
+	"\fanswer_count\x18\x06 \x01(\rR\vanswerCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\x12-\n" +
+	"\x04user\x18\t \x01(\v2\x19.product.BaseUserResponseR\x04user\x12<\n" +
+	"\fmoderated_by\x18\n" +
+	" \x01(\v2\x19.product.BaseUserResponseR\vmoderatedBy\x12&\n" +
+	"\fmoderated_at\x18\v \x01(\tH\x00R\vmoderatedAt\x88\x01\x01B\x0f\n" +
+	"\r_moderated_at\"\x8b\x01\n" +
+	"\x16QuestionsAdminResponse\x12<\n" +
+	"\tquestions\x18\x01 \x03(\v2\x1e.product.QuestionAdminResponseR\tquestions\x123\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1f.product.PaginationMetaResponseR\x04meta\"\xac\x03\n" +
+	"\x13AnswerAdminResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1e\n" +
+	"\bis_staff\x18\x04 \x01(\bH\x00R\aisStaff\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12!\n" +
+	"\fupvote_count\x18\x06 \x01(\rR\vupvoteCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\x12-\n" +
+	"\x04user\x18\t \x01(\v2\x19.product.BaseUserResponseR\x04user\x12<\n" +
+	"\fmoderated_by\x18\n" +
+	" \x01(\v2\x19.product.BaseUserResponseR\vmoderatedBy\x12&\n" +
+	"\fmoderated_at\x18\v \x01(\tH\x01R\vmoderatedAt\x88\x01\x01B\v\n" +
+	"\t_is_staffB\x0f\n" +
+	"\r_moderated_at\"\x83\x01\n" +
+	"\x14AnswersAdminResponse\x126\n" +
+	"\aanswers\x18\x01 \x03(\v2\x1c.product.AnswerAdminResponseR\aanswers\x123\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1f.product.PaginationMetaResponseR\x04meta\"\xda\x01\n" +
+	"\x13CreateReviewRequest\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tproductId\x12!\n" +
+	"\x06rating\x18\x02 \x01(\rB\t\xbaH\x06*\x04\x18\x05(\x01R\x06rating\x12 \n" +
+	"\x05title\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05title\x12!\n" +
+	"\acontent\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\acontent\x12\x17\n" +
+	"\auser_id\x18\x06 \x01(\tR\x06userIdJ\x04\b\x05\x10\x06R\x14is_verified_purchase\"\xb5\x01\n" +
+	"\x18GetProductReviewsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\rR\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05order\x18\x05 \x01(\tR\x05order\x12\x1b\n" +
+	"\x06rating\x18\x06 \x01(\rH\x00R\x06rating\x88\x01\x01B\t\n" +
+	"\a_rating\"\xe6\x01\n" +
+	"\x19GetAllReviewsAdminRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\rR\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05order\x18\x04 \x01(\tR\x05order\x12\x16\n" +
+	"\x06search\x18\x05 \x01(\tR\x06search\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x06 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1b\n" +
+	"\x06rating\x18\b \x01(\rH\x00R\x06rating\x88\x01\x01B\t\n" +
+	"\a_rating\"\xa8\x01\n" +
+	"\x15ModerateReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x125\n" +
+	"\x14is_verified_purchase\x18\x04 \x01(\bH\x00R\x12isVerifiedPurchase\x88\x01\x01B\x17\n" +
+	"\x15_is_verified_purchase\"\x8c\x02\n" +
+	"\x14ReviewPublicResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\rR\x06rating\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x125\n" +
+	"\x14is_verified_purchase\x18\x05 \x01(\bH\x00R\x12isVerifiedPurchase\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12-\n" +
+	"\x04user\x18\a \x01(\v2\x19.product.BaseUserResponseR\x04userB\x17\n" +
+	"\x15_is_verified_purchase\"\xcf\x01\n" +
+	"\x15ReviewsPublicResponse\x127\n" +
+	"\areviews\x18\x01 \x03(\v2\x1d.product.ReviewPublicResponseR\areviews\x123\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1f.product.PaginationMetaResponseR\x04meta\x12%\n" +
+	"\x0eaverage_rating\x18\x03 \x01(\x02R\raverageRating\x12!\n" +
+	"\freview_count\x18\x04 \x01(\rR\vreviewCount\"\xd8\x03\n" +
+	"\x13ReviewAdminResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\rR\x06rating\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x125\n" +
+	"\x14is_verified_purchase\x18\x06 \x01(\bH\x00R\x12isVerifiedPurchase\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\x12-\n" +
+	"\x04user\x18\n" +
+	" \x01(\v2\x19.product.BaseUserResponseR\x04user\x12<\n" +
+	"\fmoderated_by\x18\v \x01(\v2\x19.product.BaseUserResponseR\vmoderatedBy\x12&\n" +
+	"\fmoderated_at\x18\f \x01(\tH\x01R\vmoderatedAt\x88\x01\x01B\x17\n" +
+	"\x15_is_verified_purchaseB\x0f\n" +
+	"\r_moderated_at\"\x83\x01\n" +
+	"\x14ReviewsAdminResponse\x126\n" +
+	"\areviews\x18\x01 \x03(\v2\x1c.product.ReviewAdminResponseR\areviews\x123\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1f.product.PaginationMetaResponseR\x04meta\"\x82\x01\n" +
+	"\x16ProductRelationRequest\x125\n" +
+	"\x12related_product_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x10relatedProductId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x03 \x01(\x05R\tsortOrder\"\x93\x01\n" +
+	"\x1aAddProductRelationsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12=\n" +
+	"\trelations\x18\x02 \x03(\v2\x1f.product.ProductRelationRequestR\trelations\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"i\n" +
+	"\x1dRemoveProductRelationsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\tR\x03ids\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\x94\x01\n" +
+	"\x17ProductRelationResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x03 \x01(\x05R\tsortOrder\x126\n" +
+	"\aproduct\x18\x04 \x01(\v2\x1c.product.BaseProductResponseR\aproduct\"Z\n" +
+	"\x18ProductRelationsResponse\x12>\n" +
+	"\trelations\x18\x01 \x03(\v2 .product.ProductRelationResponseR\trelations\"r\n" +
+	"\x19GetRelatedProductsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x17\n" +
+	"\x04type\x18\x02 \x01(\tH\x00R\x04type\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limitB\a\n" +
+	"\x05_type\"\xd7\x01\n" +
+	"\x16RelatedProductResponse\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x126\n" +
+	"\aproduct\x18\x02 \x01(\v2\x1c.product.BaseProductResponseR\aproduct\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x02R\x05price\x12\x1c\n" +
+	"\ais_sale\x18\x04 \x01(\bH\x00R\x06isSale\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"sale_price\x18\x05 \x01(\x02H\x01R\tsalePrice\x88\x01\x01B\n" +
+	"\n" +
+	"\b_is_saleB\r\n" +
+	"\v_sale_price\"V\n" +
+	"\x17RelatedProductsResponse\x12;\n" +
+	"\bproducts\x18\x01 \x03(\v2\x1f.product.RelatedProductResponseR\bproducts\"`\n" +
+	"\x11BundleItemRequest\x12&\n" +
+	"\n" +
+	"variant_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tvariantId\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bquantity\"\x82\x01\n" +
+	"\x18UpdateBundleItemsRequest\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\tR\bbundleId\x120\n" +
+	"\x05items\x18\x02 \x03(\v2\x1a.product.BundleItemRequestR\x05items\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"w\n" +
+	"\x11SellBundleRequest\x12$\n" +
+	"\tbundle_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bbundleId\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bquantity\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"z\n" +
+	"\x14ReserveBundleRequest\x12$\n" +
+	"\tbundle_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bbundleId\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bquantity\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"z\n" +
+	"\x14ReleaseBundleRequest\x12$\n" +
+	"\tbundle_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bbundleId\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bquantity\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\xb4\x01\n" +
+	"\x16BaseBundleItemResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x126\n" +
+	"\avariant\x18\x03 \x01(\v2\x1c.product.BaseVariantResponseR\avariant\x126\n" +
+	"\aproduct\x18\x04 \x01(\v2\x1c.product.BaseProductResponseR\aproduct\"\x8f\x01\n" +
+	"\x13BundleItemsResponse\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.product.BaseBundleItemResponseR\x05items\x12\x14\n" +
+	"\x05stock\x18\x02 \x01(\x03R\x05stock\x12\x1e\n" +
+	"\bis_stock\x18\x03 \x01(\bH\x00R\aisStock\x88\x01\x01B\v\n" +
+	"\t_is_stock\"/\n" +
 	"\x0eGetByProductId\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\"D\n" +
@@ -4072,7 +9628,7 @@ const file_proto_product_proto_rawDesc = "" +
 	"\bhas_prev\x18\x05 \x01(\bH\x00R\ahasPrev\x88\x01\x01\x12\x1e\n" +
 	"\bhas_next\x18\x06 \x01(\bH\x01R\ahasNext\x88\x01\x01B\v\n" +
 	"\t_has_prevB\v\n" +
-	"\t_has_next\"\xd9\x01\n" +
+	"\t_has_next\"\x81\x02\n" +
 	"\x1aGetAllProductsAdminRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\rR\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\rR\x05limit\x12\x12\n" +
@@ -4081,13 +9637,17 @@ const file_proto_product_proto_rawDesc = "" +
 	"\x06search\x18\x05 \x01(\tR\x06search\x12\x1f\n" +
 	"\vcategory_id\x18\x06 \x01(\tR\n" +
 	"categoryId\x12 \n" +
-	"\tis_active\x18\a \x01(\bH\x00R\bisActive\x88\x01\x01B\f\n" +
+	"\tis_active\x18\a \x01(\bH\x00R\bisActive\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\b \x01(\tH\x01R\x06status\x88\x01\x01B\f\n" +
 	"\n" +
-	"_is_active\"0\n" +
+	"_is_activeB\t\n" +
+	"\a_status\"I\n" +
 	"\x1cPermanentlyDeleteManyRequest\x12\x10\n" +
-	"\x03ids\x18\x01 \x03(\tR\x03ids\"-\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"F\n" +
 	"\x1bPermanentlyDeleteOneRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"?\n" +
 	"\x12RestoreManyRequest\x12\x10\n" +
 	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"<\n" +
@@ -4095,15 +9655,19 @@ const file_proto_product_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\",\n" +
 	"\x10RestoredResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"P\n" +
-	"\x11UpdateSizeRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\tR\x06userId\"Q\n" +
-	"\x12UpdateColorRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\tR\x06userId\"\x0f\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc2\x01\n" +
+	"\x11UpdateSizeRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\x04name\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tslug_mode\x18\x04 \x01(\tR\bslugMode\x12?\n" +
+	"\ftranslations\x18\x05 \x03(\v2\x1b.product.TranslationRequestR\ftranslations\"\xc3\x01\n" +
+	"\x12UpdateColorRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\x04name\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tslug_mode\x18\x04 \x01(\tR\bslugMode\x12?\n" +
+	"\ftranslations\x18\x05 \x03(\v2\x1b.product.TranslationRequestR\ftranslations\"\x0f\n" +
 	"\rGetAllRequest\";\n" +
 	"\x10DeleteOneRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
@@ -4112,30 +9676,46 @@ const file_proto_product_proto_rawDesc = "" +
 	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"+\n" +
 	"\x0fDeletedResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc9\x06\n" +
-	"\x14UpdateProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
-	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x19\n" +
-	"\x05price\x18\x04 \x01(\x02H\x02R\x05price\x88\x01\x01\x12 \n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xcd\n" +
+	"\n" +
+	"\x14UpdateProductRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12%\n" +
+	"\x05title\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x05title\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12%\n" +
+	"\x05price\x18\x04 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00H\x02R\x05price\x88\x01\x01\x12 \n" +
 	"\tis_active\x18\x05 \x01(\bH\x03R\bisActive\x88\x01\x01\x12\x1c\n" +
-	"\ais_sale\x18\x06 \x01(\bH\x04R\x06isSale\x88\x01\x01\x12\"\n" +
+	"\ais_sale\x18\x06 \x01(\bH\x04R\x06isSale\x88\x01\x01\x12.\n" +
 	"\n" +
-	"sale_price\x18\a \x01(\x02H\x05R\tsalePrice\x88\x01\x01\x12\"\n" +
+	"sale_price\x18\a \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00H\x05R\tsalePrice\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"start_sale\x18\b \x01(\tH\x06R\tstartSale\x88\x01\x01\x12\x1e\n" +
-	"\bend_sale\x18\t \x01(\tH\aR\aendSale\x88\x01\x01\x12!\n" +
+	"\bend_sale\x18\t \x01(\tH\aR\aendSale\x88\x01\x01\x12+\n" +
 	"\fcategory_ids\x18\n" +
-	" \x03(\tR\vcategoryIds\x12\x17\n" +
-	"\atag_ids\x18\v \x03(\tR\x06tagIds\x12@\n" +
+	" \x03(\tB\b\xbaH\x05\x92\x01\x02\x18\x01R\vcategoryIds\x12!\n" +
+	"\atag_ids\x18\v \x03(\tB\b\xbaH\x05\x92\x01\x02\x18\x01R\x06tagIds\x12@\n" +
 	"\rupdate_images\x18\f \x03(\v2\x1b.product.UpdateImageRequestR\fupdateImages\x12:\n" +
 	"\n" +
-	"new_images\x18\r \x03(\v2\x1b.product.CreateImageRequestR\tnewImages\x12(\n" +
-	"\x10delete_image_ids\x18\x0e \x03(\tR\x0edeleteImageIds\x12F\n" +
+	"new_images\x18\r \x03(\v2\x1b.product.CreateImageRequestR\tnewImages\x122\n" +
+	"\x10delete_image_ids\x18\x0e \x03(\tB\b\xbaH\x05\x92\x01\x02\x18\x01R\x0edeleteImageIds\x12F\n" +
 	"\x0fupdate_variants\x18\x0f \x03(\v2\x1d.product.UpdateVariantRequestR\x0eupdateVariants\x12@\n" +
-	"\fnew_variants\x18\x10 \x03(\v2\x1d.product.CreateVariantRequestR\vnewVariants\x12,\n" +
-	"\x12delete_variant_ids\x18\x11 \x03(\tR\x10deleteVariantIds\x12\x17\n" +
-	"\auser_id\x18\x12 \x01(\tR\x06userIdB\b\n" +
+	"\fnew_variants\x18\x10 \x03(\v2\x1d.product.CreateVariantRequestR\vnewVariants\x126\n" +
+	"\x12delete_variant_ids\x18\x11 \x03(\tB\b\xbaH\x05\x92\x01\x02\x18\x01R\x10deleteVariantIds\x12\x17\n" +
+	"\auser_id\x18\x12 \x01(\tR\x06userId\x123\n" +
+	"\x13primary_category_id\x18\x13 \x01(\tH\bR\x11primaryCategoryId\x88\x01\x01\x12\x17\n" +
+	"\x04slug\x18\x14 \x01(\tH\tR\x04slug\x88\x01\x01\x12\x1b\n" +
+	"\tslug_mode\x18\x15 \x01(\tR\bslugMode\x12\x1b\n" +
+	"\x06status\x18\x16 \x01(\tH\n" +
+	"R\x06status\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"publish_at\x18\x17 \x01(\tH\vR\tpublishAt\x88\x01\x01\x12&\n" +
+	"\funpublish_at\x18\x18 \x01(\tH\fR\vunpublishAt\x88\x01\x01\x12?\n" +
+	"\ftranslations\x18\x19 \x03(\v2\x1b.product.TranslationRequestR\ftranslations\x127\n" +
+	"\x10expected_version\x18\x1a \x01(\x05B\a\xbaH\x04\x1a\x02(\x01H\rR\x0fexpectedVersion\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\b\n" +
 	"\x06_priceB\f\n" +
@@ -4145,20 +9725,26 @@ const file_proto_product_proto_rawDesc = "" +
 	"\b_is_saleB\r\n" +
 	"\v_sale_priceB\r\n" +
 	"\v_start_saleB\v\n" +
-	"\t_end_sale\"\x90\x01\n" +
-	"\x12UpdateImageRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
-	"\fis_thumbnail\x18\x02 \x01(\bH\x00R\visThumbnail\x88\x01\x01\x12\"\n" +
+	"\t_end_saleB\x16\n" +
+	"\x14_primary_category_idB\a\n" +
+	"\x05_slugB\t\n" +
+	"\a_statusB\r\n" +
+	"\v_publish_atB\x0f\n" +
+	"\r_unpublish_atB\x13\n" +
+	"\x11_expected_version\"\xa2\x01\n" +
+	"\x12UpdateImageRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12&\n" +
+	"\fis_thumbnail\x18\x02 \x01(\bH\x00R\visThumbnail\x88\x01\x01\x12+\n" +
 	"\n" +
-	"sort_order\x18\x03 \x01(\x05H\x01R\tsortOrder\x88\x01\x01B\x0f\n" +
+	"sort_order\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x01R\tsortOrder\x88\x01\x01B\x0f\n" +
 	"\r_is_thumbnailB\r\n" +
-	"\v_sort_order\"\xca\x01\n" +
-	"\x14UpdateVariantRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
-	"\x03sku\x18\x02 \x01(\tH\x00R\x03sku\x88\x01\x01\x12\x1e\n" +
-	"\bcolor_id\x18\x03 \x01(\tH\x01R\acolorId\x88\x01\x01\x12\x1c\n" +
-	"\asize_id\x18\x04 \x01(\tH\x02R\x06sizeId\x88\x01\x01\x12\x1f\n" +
-	"\bquantity\x18\x05 \x01(\x03H\x03R\bquantity\x88\x01\x01B\x06\n" +
+	"\v_sort_order\"\xf9\x01\n" +
+	"\x14UpdateVariantRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12 \n" +
+	"\x03sku\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182H\x00R\x03sku\x88\x01\x01\x12'\n" +
+	"\bcolor_id\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x01R\acolorId\x88\x01\x01\x12%\n" +
+	"\asize_id\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x02R\x06sizeId\x88\x01\x01\x12(\n" +
+	"\bquantity\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00H\x03R\bquantity\x88\x01\x01B\x06\n" +
 	"\x04_skuB\v\n" +
 	"\t_color_idB\n" +
 	"\n" +
@@ -4169,7 +9755,7 @@ const file_proto_product_proto_rawDesc = "" +
 	"\x04meta\x18\x02 \x01(\v2\x1f.product.PaginationMetaResponseR\x04meta\"7\n" +
 	"\x13SimpleImageResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
-	"\x03url\x18\x02 \x01(\tR\x03url\"\xcd\x01\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"\xe5\x01\n" +
 	"\x14ProductAdminResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
@@ -4177,9 +9763,10 @@ const file_proto_product_proto_rawDesc = "" +
 	"\n" +
 	"categories\x18\x04 \x03(\v2\x1d.product.BaseCategoryResponseR\n" +
 	"categories\x12:\n" +
-	"\tthumbnail\x18\x05 \x01(\v2\x1c.product.SimpleImageResponseR\tthumbnail\"\x1f\n" +
+	"\tthumbnail\x18\x05 \x01(\v2\x1c.product.SimpleImageResponseR\tthumbnail\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\"\x1f\n" +
 	"\rGetOneRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x89\x06\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xaa\t\n" +
 	"\x1bProductAdminDetailsResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
@@ -4207,51 +9794,85 @@ const file_proto_product_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\x11 \x01(\v2\x19.product.BaseUserResponseR\tcreatedBy\x128\n" +
 	"\n" +
-	"updated_by\x18\x12 \x01(\v2\x19.product.BaseUserResponseR\tupdatedByB\f\n" +
+	"updated_by\x18\x12 \x01(\v2\x19.product.BaseUserResponseR\tupdatedBy\x12 \n" +
+	"\tis_bundle\x18\x13 \x01(\bH\x05R\bisBundle\x88\x01\x01\x12B\n" +
+	"\fbundle_items\x18\x14 \x03(\v2\x1f.product.BaseBundleItemResponseR\vbundleItems\x123\n" +
+	"\x13primary_category_id\x18\x15 \x01(\tH\x06R\x11primaryCategoryId\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x16 \x01(\tR\x06status\x12\"\n" +
+	"\n" +
+	"publish_at\x18\x17 \x01(\tH\aR\tpublishAt\x88\x01\x01\x12&\n" +
+	"\funpublish_at\x18\x18 \x01(\tH\bR\vunpublishAt\x88\x01\x01\x12@\n" +
+	"\ftranslations\x18\x19 \x03(\v2\x1c.product.TranslationResponseR\ftranslations\x12\x18\n" +
+	"\aversion\x18\x1a \x01(\x05R\aversionB\f\n" +
 	"\n" +
 	"_is_activeB\n" +
 	"\n" +
 	"\b_is_saleB\r\n" +
 	"\v_sale_priceB\r\n" +
 	"\v_start_saleB\v\n" +
-	"\t_end_sale\"W\n" +
+	"\t_end_saleB\f\n" +
+	"\n" +
+	"_is_bundleB\x16\n" +
+	"\x14_primary_category_idB\r\n" +
+	"\v_publish_atB\x0f\n" +
+	"\r_unpublish_at\"W\n" +
 	"\x16BaseCategoriesResponse\x12=\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x1d.product.BaseCategoryResponseR\n" +
-	"categories\"\xf2\x03\n" +
-	"\x14CreateProductRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x02R\x05price\x12\x1b\n" +
+	"categories\"\xe7\a\n" +
+	"\x14CreateProductRequest\x12 \n" +
+	"\x05title\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12 \n" +
+	"\x05price\x18\x03 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x05price\x12\x1b\n" +
 	"\tis_active\x18\x04 \x01(\bR\bisActive\x12\x17\n" +
-	"\ais_sale\x18\x05 \x01(\bR\x06isSale\x12\"\n" +
+	"\ais_sale\x18\x05 \x01(\bR\x06isSale\x12.\n" +
 	"\n" +
-	"sale_price\x18\x06 \x01(\x02H\x00R\tsalePrice\x88\x01\x01\x12\"\n" +
+	"sale_price\x18\x06 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00H\x00R\tsalePrice\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"start_sale\x18\a \x01(\tH\x01R\tstartSale\x88\x01\x01\x12\x1e\n" +
-	"\bend_sale\x18\b \x01(\tH\x02R\aendSale\x88\x01\x01\x12!\n" +
-	"\fcategory_ids\x18\t \x03(\tR\vcategoryIds\x12\x17\n" +
+	"\bend_sale\x18\b \x01(\tH\x02R\aendSale\x88\x01\x01\x12+\n" +
+	"\fcategory_ids\x18\t \x03(\tB\b\xbaH\x05\x92\x01\x02\x18\x01R\vcategoryIds\x12!\n" +
 	"\atag_ids\x18\n" +
-	" \x03(\tR\x06tagIds\x129\n" +
+	" \x03(\tB\b\xbaH\x05\x92\x01\x02\x18\x01R\x06tagIds\x129\n" +
 	"\bvariants\x18\v \x03(\v2\x1d.product.CreateVariantRequestR\bvariants\x123\n" +
 	"\x06images\x18\f \x03(\v2\x1b.product.CreateImageRequestR\x06images\x12\x17\n" +
-	"\auser_id\x18\r \x01(\tR\x06userIdB\r\n" +
+	"\auser_id\x18\r \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_bundle\x18\x0e \x01(\bR\bisBundle\x12=\n" +
+	"\fbundle_items\x18\x0f \x03(\v2\x1a.product.BundleItemRequestR\vbundleItems\x123\n" +
+	"\x13primary_category_id\x18\x10 \x01(\tH\x03R\x11primaryCategoryId\x88\x01\x01\x12\x17\n" +
+	"\x04slug\x18\x11 \x01(\tH\x04R\x04slug\x88\x01\x01\x12\x1b\n" +
+	"\tslug_mode\x18\x12 \x01(\tR\bslugMode\x12\x1b\n" +
+	"\x06status\x18\x13 \x01(\tH\x05R\x06status\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"publish_at\x18\x14 \x01(\tH\x06R\tpublishAt\x88\x01\x01\x12&\n" +
+	"\funpublish_at\x18\x15 \x01(\tH\aR\vunpublishAt\x88\x01\x01\x12?\n" +
+	"\ftranslations\x18\x16 \x03(\v2\x1b.product.TranslationRequestR\ftranslationsB\r\n" +
 	"\v_sale_priceB\r\n" +
 	"\v_start_saleB\v\n" +
-	"\t_end_sale\"x\n" +
-	"\x14CreateVariantRequest\x12\x10\n" +
-	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x19\n" +
-	"\bcolor_id\x18\x02 \x01(\tR\acolorId\x12\x17\n" +
-	"\asize_id\x18\x03 \x01(\tR\x06sizeId\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x03R\bquantity\"\xaf\x01\n" +
+	"\t_end_saleB\x16\n" +
+	"\x14_primary_category_idB\a\n" +
+	"\x05_slugB\t\n" +
+	"\a_statusB\r\n" +
+	"\v_publish_atB\x0f\n" +
+	"\r_unpublish_at\"\x9e\x01\n" +
+	"\x14CreateVariantRequest\x12\x1b\n" +
+	"\x03sku\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\x03sku\x12\"\n" +
+	"\bcolor_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\acolorId\x12 \n" +
+	"\asize_id\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06sizeId\x12#\n" +
+	"\bquantity\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\bquantity\"\xca\x01\n" +
 	"\x12CreateImageRequest\x12\x19\n" +
-	"\bcolor_id\x18\x01 \x01(\tR\acolorId\x12\x1f\n" +
-	"\vbase64_data\x18\x02 \x01(\tR\n" +
-	"base64Data\x12\x1b\n" +
-	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
-	"\fis_thumbnail\x18\x04 \x01(\bR\visThumbnail\x12\x1d\n" +
+	"\bcolor_id\x18\x01 \x01(\tR\acolorId\x12(\n" +
+	"\vbase64_data\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"base64Data\x12$\n" +
+	"\tfile_name\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bfileName\x12!\n" +
+	"\fis_thumbnail\x18\x04 \x01(\bR\visThumbnail\x12&\n" +
 	"\n" +
-	"sort_order\x18\x05 \x01(\x05R\tsortOrder\"B\n" +
+	"sort_order\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\tsortOrder\"B\n" +
 	"\x12TagsPublicResponse\x12,\n" +
 	"\x04tags\x18\x01 \x03(\v2\x18.product.BaseTagResponseR\x04tags\"5\n" +
 	"\x0fBaseTagResponse\x12\x0e\n" +
@@ -4262,13 +9883,15 @@ const file_proto_product_proto_rawDesc = "" +
 	"\x14ColorsPublicResponse\x122\n" +
 	"\x06colors\x18\x01 \x03(\v2\x1a.product.BaseColorResponseR\x06colors\"+\n" +
 	"\x0fUpdatedResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"O\n" +
-	"\x10UpdateTagRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\tR\x06userId\"B\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc1\x01\n" +
+	"\x10UpdateTagRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\x04name\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tslug_mode\x18\x04 \x01(\tR\bslugMode\x12?\n" +
+	"\ftranslations\x18\x05 \x03(\v2\x1b.product.TranslationRequestR\ftranslations\"B\n" +
 	"\x11TagsAdminResponse\x12-\n" +
-	"\x04tags\x18\x01 \x03(\v2\x19.product.TagAdminResponseR\x04tags\"\xe8\x01\n" +
+	"\x04tags\x18\x01 \x03(\v2\x19.product.TagAdminResponseR\x04tags\"\xaa\x02\n" +
 	"\x10TagAdminResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
@@ -4279,9 +9902,10 @@ const file_proto_product_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\x05 \x01(\v2\x19.product.BaseUserResponseR\tcreatedBy\x128\n" +
 	"\n" +
-	"updated_by\x18\x06 \x01(\v2\x19.product.BaseUserResponseR\tupdatedBy\"F\n" +
+	"updated_by\x18\x06 \x01(\v2\x19.product.BaseUserResponseR\tupdatedBy\x12@\n" +
+	"\ftranslations\x18\a \x03(\v2\x1c.product.TranslationResponseR\ftranslations\"F\n" +
 	"\x12SizesAdminResponse\x120\n" +
-	"\x05sizes\x18\x01 \x03(\v2\x1a.product.SizeAdminResponseR\x05sizes\"\xe9\x01\n" +
+	"\x05sizes\x18\x01 \x03(\v2\x1a.product.SizeAdminResponseR\x05sizes\"\xab\x02\n" +
 	"\x11SizeAdminResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
@@ -4292,9 +9916,10 @@ const file_proto_product_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\x05 \x01(\v2\x19.product.BaseUserResponseR\tcreatedBy\x128\n" +
 	"\n" +
-	"updated_by\x18\x06 \x01(\v2\x19.product.BaseUserResponseR\tupdatedBy\"J\n" +
+	"updated_by\x18\x06 \x01(\v2\x19.product.BaseUserResponseR\tupdatedBy\x12@\n" +
+	"\ftranslations\x18\a \x03(\v2\x1c.product.TranslationResponseR\ftranslations\"J\n" +
 	"\x13ColorsAdminResponse\x123\n" +
-	"\x06colors\x18\x01 \x03(\v2\x1b.product.ColorAdminResponseR\x06colors\"\xea\x01\n" +
+	"\x06colors\x18\x01 \x03(\v2\x1b.product.ColorAdminResponseR\x06colors\"\xac\x02\n" +
 	"\x12ColorAdminResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
@@ -4305,14 +9930,31 @@ const file_proto_product_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\x05 \x01(\v2\x19.product.BaseUserResponseR\tcreatedBy\x128\n" +
 	"\n" +
-	"updated_by\x18\x06 \x01(\v2\x19.product.BaseUserResponseR\tupdatedBy\"\x87\x01\n" +
-	"\x15UpdateCategoryRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04slug\x18\x03 \x01(\tR\x04slug\x12\x1d\n" +
+	"updated_by\x18\x06 \x01(\v2\x19.product.BaseUserResponseR\tupdatedBy\x12@\n" +
+	"\ftranslations\x18\a \x03(\v2\x1c.product.TranslationResponseR\ftranslations\"\xa3\x05\n" +
+	"\x15UpdateCategoryRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12'\n" +
+	"\n" +
+	"parent_ids\x18\x04 \x03(\tB\b\xbaH\x05\x92\x01\x02\x18\x01R\tparentIds\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12;\n" +
+	"\x06banner\x18\a \x01(\v2\x1e.product.CategoryBannerRequestH\x00R\x06banner\x88\x01\x01\x12#\n" +
+	"\rdelete_banner\x18\b \x01(\bR\fdeleteBanner\x12'\n" +
 	"\n" +
-	"parent_ids\x18\x04 \x03(\tR\tparentIds\x12\x17\n" +
-	"\auser_id\x18\x05 \x01(\tR\x06userId\"\xfb\x02\n" +
+	"meta_title\x18\t \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\tmetaTitle\x123\n" +
+	"\x10meta_description\x18\n" +
+	" \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\x0fmetaDescription\x12\"\n" +
+	"\n" +
+	"is_visible\x18\v \x01(\bH\x01R\tisVisible\x88\x01\x01\x120\n" +
+	"\x14featured_product_ids\x18\f \x03(\tR\x12featuredProductIds\x12\x1b\n" +
+	"\tslug_mode\x18\r \x01(\tR\bslugMode\x12?\n" +
+	"\ftranslations\x18\x0e \x03(\v2\x1b.product.TranslationRequestR\ftranslations\x127\n" +
+	"\x10expected_version\x18\x0f \x01(\x05B\a\xbaH\x04\x1a\x02(\x01H\x02R\x0fexpectedVersion\x88\x01\x01B\t\n" +
+	"\a_bannerB\r\n" +
+	"\v_is_visibleB\x13\n" +
+	"\x11_expected_version\"\xcc\x05\n" +
 	"\x1cCategoryAdminDetailsResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -4326,7 +9968,19 @@ const file_proto_product_proto_rawDesc = "" +
 	"created_by\x18\a \x01(\v2\x19.product.BaseUserResponseR\tcreatedBy\x128\n" +
 	"\n" +
 	"updated_by\x18\b \x01(\v2\x19.product.BaseUserResponseR\tupdatedBy\x128\n" +
-	"\bproducts\x18\t \x03(\v2\x1c.product.BaseProductResponseR\bproducts\"\x81\x01\n" +
+	"\bproducts\x18\t \x03(\v2\x1c.product.BaseProductResponseR\bproducts\x12 \n" +
+	"\vdescription\x18\n" +
+	" \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"banner_url\x18\v \x01(\tR\tbannerUrl\x12\x1d\n" +
+	"\n" +
+	"meta_title\x18\f \x01(\tR\tmetaTitle\x12)\n" +
+	"\x10meta_description\x18\r \x01(\tR\x0fmetaDescription\x12\x1d\n" +
+	"\n" +
+	"is_visible\x18\x0e \x01(\bR\tisVisible\x12I\n" +
+	"\x11featured_products\x18\x0f \x03(\v2\x1c.product.BaseProductResponseR\x10featuredProducts\x12@\n" +
+	"\ftranslations\x18\x10 \x03(\v2\x1c.product.TranslationResponseR\ftranslations\x12\x18\n" +
+	"\aversion\x18\x11 \x01(\x05R\aversion\"\x81\x01\n" +
 	"\x13BaseProductResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
@@ -4353,24 +10007,30 @@ const file_proto_product_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\a \x01(\v2\x19.product.BaseUserResponseR\tcreatedBy\x128\n" +
 	"\n" +
-	"updated_by\x18\b \x01(\v2\x19.product.BaseUserResponseR\tupdatedBy\"?\n" +
-	"\x10CreateTagRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"2\n" +
+	"updated_by\x18\b \x01(\v2\x19.product.BaseUserResponseR\tupdatedBy\"\xa8\x01\n" +
+	"\x10CreateTagRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\x04name\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tslug_mode\x18\x03 \x01(\tR\bslugMode\x12?\n" +
+	"\ftranslations\x18\x04 \x03(\v2\x1b.product.TranslationRequestR\ftranslations\"2\n" +
 	"\x1cGetProductsByCategoryRequest\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\"T\n" +
 	"\x16ProductsPublicResponse\x12:\n" +
-	"\bproducts\x18\x01 \x03(\v2\x1e.product.ProductPublicResponseR\bproducts\"@\n" +
-	"\x11CreateSizeRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"A\n" +
-	"\x12CreateColorRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"!\n" +
+	"\bproducts\x18\x01 \x03(\v2\x1e.product.ProductPublicResponseR\bproducts\"\xa9\x01\n" +
+	"\x11CreateSizeRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\x04name\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tslug_mode\x18\x03 \x01(\tR\bslugMode\x12?\n" +
+	"\ftranslations\x18\x04 \x03(\v2\x1b.product.TranslationRequestR\ftranslations\"\xaa\x01\n" +
+	"\x12CreateColorRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\x04name\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tslug_mode\x18\x03 \x01(\tR\bslugMode\x12?\n" +
+	"\ftranslations\x18\x04 \x03(\v2\x1b.product.TranslationRequestR\ftranslations\"!\n" +
 	"\x0fCreatedResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"-\n" +
 	"\x17GetProductBySlugRequest\x12\x12\n" +
-	"\x04slug\x18\x01 \x01(\tR\x04slug\"\xf3\x03\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"\xab\a\n" +
 	"\x15ProductPublicResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
@@ -4388,12 +10048,31 @@ const file_proto_product_proto_rawDesc = "" +
 	" \x03(\v2\x1d.product.BaseCategoryResponseR\n" +
 	"categories\x128\n" +
 	"\bvariants\x18\v \x03(\v2\x1c.product.BaseVariantResponseR\bvariants\x122\n" +
-	"\x06images\x18\f \x03(\v2\x1a.product.BaseImageResponseR\x06imagesB\n" +
+	"\x06images\x18\f \x03(\v2\x1a.product.BaseImageResponseR\x06images\x12 \n" +
+	"\tis_bundle\x18\r \x01(\bH\x04R\bisBundle\x88\x01\x01\x12B\n" +
+	"\fbundle_items\x18\x0e \x03(\v2\x1f.product.BaseBundleItemResponseR\vbundleItems\x12&\n" +
+	"\fbundle_stock\x18\x0f \x01(\x03H\x05R\vbundleStock\x88\x01\x01\x12%\n" +
+	"\x0eaverage_rating\x18\x10 \x01(\x02R\raverageRating\x12!\n" +
+	"\freview_count\x18\x11 \x01(\rR\vreviewCount\x12H\n" +
+	"\x10primary_category\x18\x12 \x01(\v2\x1d.product.BaseCategoryResponseR\x0fprimaryCategory\x12?\n" +
+	"\vbreadcrumbs\x18\x13 \x03(\v2\x1d.product.CategoryPathResponseR\vbreadcrumbs\x12$\n" +
+	"\vredirect_to\x18\x14 \x01(\tH\x06R\n" +
+	"redirectTo\x88\x01\x01B\n" +
 	"\n" +
 	"\b_is_saleB\r\n" +
 	"\v_sale_priceB\r\n" +
 	"\v_start_saleB\v\n" +
-	"\t_end_sale\"\xbf\x01\n" +
+	"\t_end_saleB\f\n" +
+	"\n" +
+	"_is_bundleB\x0f\n" +
+	"\r_bundle_stockB\x0e\n" +
+	"\f_redirect_to\"t\n" +
+	"\x14CategoryPathResponse\x12=\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1d.product.BaseCategoryResponseR\n" +
+	"categories\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x02 \x01(\bR\tisPrimary\"\xbf\x01\n" +
 	"\x11BaseImageResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
 	"\x05color\x18\x02 \x01(\v2\x1a.product.BaseColorResponseR\x05color\x12\x10\n" +
@@ -4421,14 +10100,31 @@ const file_proto_product_proto_rawDesc = "" +
 	"\x03sku\x18\x02 \x01(\tR\x03sku\x120\n" +
 	"\x05color\x18\x03 \x01(\v2\x1a.product.BaseColorResponseR\x05color\x12-\n" +
 	"\x04size\x18\x04 \x01(\v2\x19.product.BaseSizeResponseR\x04size\x12<\n" +
-	"\tinventory\x18\x05 \x01(\v2\x1e.product.BaseInventoryResponseR\tinventory\"\x85\x01\n" +
-	"\x15CreateCategoryRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
-	"\x04slug\x18\x02 \x01(\tH\x00R\x04slug\x88\x01\x01\x12\x1d\n" +
+	"\tinventory\x18\x05 \x01(\v2\x1e.product.BaseInventoryResponseR\tinventory\"\xa5\x04\n" +
+	"\x15CreateCategoryRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12\x17\n" +
+	"\x04slug\x18\x02 \x01(\tH\x00R\x04slug\x88\x01\x01\x12'\n" +
 	"\n" +
-	"parent_ids\x18\x03 \x03(\tR\tparentIds\x12\x17\n" +
-	"\auser_id\x18\x04 \x01(\tR\x06userIdB\a\n" +
-	"\x05_slug\"N\n" +
+	"parent_ids\x18\x03 \x03(\tB\b\xbaH\x05\x92\x01\x02\x18\x01R\tparentIds\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12;\n" +
+	"\x06banner\x18\x06 \x01(\v2\x1e.product.CategoryBannerRequestH\x01R\x06banner\x88\x01\x01\x12'\n" +
+	"\n" +
+	"meta_title\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\tmetaTitle\x123\n" +
+	"\x10meta_description\x18\b \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\x0fmetaDescription\x12\"\n" +
+	"\n" +
+	"is_visible\x18\t \x01(\bH\x02R\tisVisible\x88\x01\x01\x120\n" +
+	"\x14featured_product_ids\x18\n" +
+	" \x03(\tR\x12featuredProductIds\x12\x1b\n" +
+	"\tslug_mode\x18\v \x01(\tR\bslugMode\x12?\n" +
+	"\ftranslations\x18\f \x03(\v2\x1b.product.TranslationRequestR\ftranslationsB\a\n" +
+	"\x05_slugB\t\n" +
+	"\a_bannerB\r\n" +
+	"\v_is_visible\"U\n" +
+	"\x15CategoryBannerRequest\x12\x1f\n" +
+	"\vbase64_data\x18\x01 \x01(\tR\n" +
+	"base64Data\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\"N\n" +
 	"\x14BaseCategoryResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -4441,7 +10137,19 @@ const file_proto_product_proto_rawDesc = "" +
 	"\x14CategoryTreeResponse\x12?\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x1f.product.CategoryPublicResponseR\n" +
-	"categories2\xd7\"\n" +
+	"categories\"\xd4\x01\n" +
+	"\x12TranslationRequest\x12B\n" +
+	"\x06locale\x18\x01 \x01(\tB*\xbaH'r%2#^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})?$R\x06locale\x12?\n" +
+	"\x06fields\x18\x02 \x03(\v2'.product.TranslationRequest.FieldsEntryR\x06fields\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xaa\x01\n" +
+	"\x13TranslationResponse\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\x12@\n" +
+	"\x06fields\x18\x02 \x03(\v2(.product.TranslationResponse.FieldsEntryR\x06fields\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xb4;\n" +
 	"\x0eProductService\x12J\n" +
 	"\x0eCreateCategory\x12\x1e.product.CreateCategoryRequest\x1a\x18.product.CreatedResponse\x12H\n" +
 	"\x0fGetCategoryTree\x12\x16.product.GetAllRequest\x1a\x1d.product.CategoryTreeResponse\x12T\n" +
@@ -4505,7 +10213,47 @@ const file_proto_product_proto_rawDesc = "" +
 	"\x16PermanentlyDeleteSizes\x12%.product.PermanentlyDeleteManyRequest\x1a\x18.product.DeletedResponse\x12V\n" +
 	"\x14PermanentlyDeleteTag\x12$.product.PermanentlyDeleteOneRequest\x1a\x18.product.DeletedResponse\x12X\n" +
 	"\x15PermanentlyDeleteTags\x12%.product.PermanentlyDeleteManyRequest\x1a\x18.product.DeletedResponse\x12H\n" +
-	"\x14GetImagesByProductId\x12\x17.product.GetByProductId\x1a\x17.product.ImagesResponseB\x03Z\x01.b\x06proto3"
+	"\x14GetImagesByProductId\x12\x17.product.GetByProductId\x1a\x17.product.ImagesResponse\x12P\n" +
+	"\x11UpdateBundleItems\x12!.product.UpdateBundleItemsRequest\x1a\x18.product.UpdatedResponse\x12G\n" +
+	"\x0eGetBundleItems\x12\x17.product.GetByProductId\x1a\x1c.product.BundleItemsResponse\x12B\n" +
+	"\n" +
+	"SellBundle\x12\x1a.product.SellBundleRequest\x1a\x18.product.UpdatedResponse\x12H\n" +
+	"\rReserveBundle\x12\x1d.product.ReserveBundleRequest\x1a\x18.product.UpdatedResponse\x12H\n" +
+	"\rReleaseBundle\x12\x1d.product.ReleaseBundleRequest\x1a\x18.product.UpdatedResponse\x12T\n" +
+	"\x13AddProductRelations\x12#.product.AddProductRelationsRequest\x1a\x18.product.UpdatedResponse\x12Z\n" +
+	"\x16RemoveProductRelations\x12&.product.RemoveProductRelationsRequest\x1a\x18.product.DeletedResponse\x12Q\n" +
+	"\x13GetProductRelations\x12\x17.product.GetByProductId\x1a!.product.ProductRelationsResponse\x12Z\n" +
+	"\x12GetRelatedProducts\x12\".product.GetRelatedProductsRequest\x1a .product.RelatedProductsResponse\x12F\n" +
+	"\fCreateReview\x12\x1c.product.CreateReviewRequest\x1a\x18.product.CreatedResponse\x12V\n" +
+	"\x11GetProductReviews\x12!.product.GetProductReviewsRequest\x1a\x1e.product.ReviewsPublicResponse\x12W\n" +
+	"\x12GetAllReviewsAdmin\x12\".product.GetAllReviewsAdminRequest\x1a\x1d.product.ReviewsAdminResponse\x12J\n" +
+	"\x0eModerateReview\x12\x1e.product.ModerateReviewRequest\x1a\x18.product.UpdatedResponse\x12J\n" +
+	"\x0eCreateQuestion\x12\x1e.product.CreateQuestionRequest\x1a\x18.product.CreatedResponse\x12F\n" +
+	"\fCreateAnswer\x12\x1c.product.CreateAnswerRequest\x1a\x18.product.CreatedResponse\x12B\n" +
+	"\x0eUpvoteQuestion\x12\x16.product.UpvoteRequest\x1a\x18.product.UpdatedResponse\x12@\n" +
+	"\fUpvoteAnswer\x12\x16.product.UpvoteRequest\x1a\x18.product.UpdatedResponse\x12\\\n" +
+	"\x13GetProductQuestions\x12#.product.GetProductQuestionsRequest\x1a .product.QuestionsPublicResponse\x12X\n" +
+	"\x12GetQuestionAnswers\x12\".product.GetQuestionAnswersRequest\x1a\x1e.product.AnswersPublicResponse\x12]\n" +
+	"\x14GetAllQuestionsAdmin\x12$.product.GetAllQuestionsAdminRequest\x1a\x1f.product.QuestionsAdminResponse\x12W\n" +
+	"\x12GetAllAnswersAdmin\x12\".product.GetAllAnswersAdminRequest\x1a\x1d.product.AnswersAdminResponse\x12F\n" +
+	"\x10ModerateQuestion\x12\x18.product.ModerateRequest\x1a\x18.product.UpdatedResponse\x12D\n" +
+	"\x0eModerateAnswer\x12\x18.product.ModerateRequest\x1a\x18.product.UpdatedResponse\x12H\n" +
+	"\rAddToWishlist\x12\x1d.product.AddToWishlistRequest\x1a\x18.product.CreatedResponse\x12R\n" +
+	"\x12RemoveFromWishlist\x12\".product.RemoveFromWishlistRequest\x1a\x18.product.DeletedResponse\x12E\n" +
+	"\vGetWishlist\x12\x1b.product.GetWishlistRequest\x1a\x19.product.WishlistResponse\x12E\n" +
+	"\x0eDeleteCategory\x12\x19.product.DeleteOneRequest\x1a\x18.product.DeletedResponse\x12H\n" +
+	"\x10DeleteCategories\x12\x1a.product.DeleteManyRequest\x1a\x18.product.DeletedResponse\x12P\n" +
+	"\x14GetDeletedCategories\x12\x16.product.GetAllRequest\x1a .product.CategoriesAdminResponse\x12H\n" +
+	"\x0fRestoreCategory\x12\x1a.product.RestoreOneRequest\x1a\x19.product.RestoredResponse\x12K\n" +
+	"\x11RestoreCategories\x12\x1b.product.RestoreManyRequest\x1a\x19.product.RestoredResponse\x12F\n" +
+	"\fMoveCategory\x12\x1c.product.MoveCategoryRequest\x1a\x18.product.UpdatedResponse\x12\\\n" +
+	"\x17ReorderCategoryChildren\x12'.product.ReorderCategoryChildrenRequest\x1a\x18.product.UpdatedResponse\x12^\n" +
+	"\x11GetCategoryBySlug\x12!.product.GetCategoryBySlugRequest\x1a&.product.CategoryPublicDetailsResponse\x12N\n" +
+	"\x10DuplicateProduct\x12 .product.DuplicateProductRequest\x1a\x18.product.CreatedResponse\x12]\n" +
+	"\x13GetProductRevisions\x12#.product.GetProductRevisionsRequest\x1a!.product.ProductRevisionsResponse\x12b\n" +
+	"\x14DiffProductRevisions\x12$.product.DiffProductRevisionsRequest\x1a$.product.ProductRevisionDiffResponse\x12h\n" +
+	"\x17RollbackProductRevision\x12'.product.RollbackProductRevisionRequest\x1a$.product.ProductAdminDetailsResponse\x12H\n" +
+	"\fGetAuditLogs\x12\x1c.product.GetAuditLogsRequest\x1a\x1a.product.AuditLogsResponseB\x03Z\x01.b\x06proto3"
 
 var (
 	file_proto_product_proto_rawDescOnce sync.Once
@@ -4519,240 +10267,456 @@ func file_proto_product_proto_rawDescGZIP() []byte {
 	return file_proto_product_proto_rawDescData
 }
 
-var file_proto_product_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
+var file_proto_product_proto_msgTypes = make([]protoimpl.MessageInfo, 129)
 var file_proto_product_proto_goTypes = []any{
-	(*GetByProductId)(nil),               // 0: product.GetByProductId
-	(*ImagesResponse)(nil),               // 1: product.ImagesResponse
-	(*PaginationMetaResponse)(nil),       // 2: product.PaginationMetaResponse
-	(*GetAllProductsAdminRequest)(nil),   // 3: product.GetAllProductsAdminRequest
-	(*PermanentlyDeleteManyRequest)(nil), // 4: product.PermanentlyDeleteManyRequest
-	(*PermanentlyDeleteOneRequest)(nil),  // 5: product.PermanentlyDeleteOneRequest
-	(*RestoreManyRequest)(nil),           // 6: product.RestoreManyRequest
-	(*RestoreOneRequest)(nil),            // 7: product.RestoreOneRequest
-	(*RestoredResponse)(nil),             // 8: product.RestoredResponse
-	(*UpdateSizeRequest)(nil),            // 9: product.UpdateSizeRequest
-	(*UpdateColorRequest)(nil),           // 10: product.UpdateColorRequest
-	(*GetAllRequest)(nil),                // 11: product.GetAllRequest
-	(*DeleteOneRequest)(nil),             // 12: product.DeleteOneRequest
-	(*DeleteManyRequest)(nil),            // 13: product.DeleteManyRequest
-	(*DeletedResponse)(nil),              // 14: product.DeletedResponse
-	(*UpdateProductRequest)(nil),         // 15: product.UpdateProductRequest
-	(*UpdateImageRequest)(nil),           // 16: product.UpdateImageRequest
-	(*UpdateVariantRequest)(nil),         // 17: product.UpdateVariantRequest
-	(*ProductsAdminResponse)(nil),        // 18: product.ProductsAdminResponse
-	(*SimpleImageResponse)(nil),          // 19: product.SimpleImageResponse
-	(*ProductAdminResponse)(nil),         // 20: product.ProductAdminResponse
-	(*GetOneRequest)(nil),                // 21: product.GetOneRequest
-	(*ProductAdminDetailsResponse)(nil),  // 22: product.ProductAdminDetailsResponse
-	(*BaseCategoriesResponse)(nil),       // 23: product.BaseCategoriesResponse
-	(*CreateProductRequest)(nil),         // 24: product.CreateProductRequest
-	(*CreateVariantRequest)(nil),         // 25: product.CreateVariantRequest
-	(*CreateImageRequest)(nil),           // 26: product.CreateImageRequest
-	(*TagsPublicResponse)(nil),           // 27: product.TagsPublicResponse
-	(*BaseTagResponse)(nil),              // 28: product.BaseTagResponse
-	(*SizesPublicResponse)(nil),          // 29: product.SizesPublicResponse
-	(*ColorsPublicResponse)(nil),         // 30: product.ColorsPublicResponse
-	(*UpdatedResponse)(nil),              // 31: product.UpdatedResponse
-	(*UpdateTagRequest)(nil),             // 32: product.UpdateTagRequest
-	(*TagsAdminResponse)(nil),            // 33: product.TagsAdminResponse
-	(*TagAdminResponse)(nil),             // 34: product.TagAdminResponse
-	(*SizesAdminResponse)(nil),           // 35: product.SizesAdminResponse
-	(*SizeAdminResponse)(nil),            // 36: product.SizeAdminResponse
-	(*ColorsAdminResponse)(nil),          // 37: product.ColorsAdminResponse
-	(*ColorAdminResponse)(nil),           // 38: product.ColorAdminResponse
-	(*UpdateCategoryRequest)(nil),        // 39: product.UpdateCategoryRequest
-	(*CategoryAdminDetailsResponse)(nil), // 40: product.CategoryAdminDetailsResponse
-	(*BaseProductResponse)(nil),          // 41: product.BaseProductResponse
-	(*BaseProfileResponse)(nil),          // 42: product.BaseProfileResponse
-	(*BaseUserResponse)(nil),             // 43: product.BaseUserResponse
-	(*CategoryAdminResponse)(nil),        // 44: product.CategoryAdminResponse
-	(*CreateTagRequest)(nil),             // 45: product.CreateTagRequest
-	(*GetProductsByCategoryRequest)(nil), // 46: product.GetProductsByCategoryRequest
-	(*ProductsPublicResponse)(nil),       // 47: product.ProductsPublicResponse
-	(*CreateSizeRequest)(nil),            // 48: product.CreateSizeRequest
-	(*CreateColorRequest)(nil),           // 49: product.CreateColorRequest
-	(*CreatedResponse)(nil),              // 50: product.CreatedResponse
-	(*GetProductBySlugRequest)(nil),      // 51: product.GetProductBySlugRequest
-	(*ProductPublicResponse)(nil),        // 52: product.ProductPublicResponse
-	(*BaseImageResponse)(nil),            // 53: product.BaseImageResponse
-	(*BaseColorResponse)(nil),            // 54: product.BaseColorResponse
-	(*BaseSizeResponse)(nil),             // 55: product.BaseSizeResponse
-	(*BaseInventoryResponse)(nil),        // 56: product.BaseInventoryResponse
-	(*BaseVariantResponse)(nil),          // 57: product.BaseVariantResponse
-	(*CreateCategoryRequest)(nil),        // 58: product.CreateCategoryRequest
-	(*BaseCategoryResponse)(nil),         // 59: product.BaseCategoryResponse
-	(*CategoryPublicResponse)(nil),       // 60: product.CategoryPublicResponse
-	(*CategoryTreeResponse)(nil),         // 61: product.CategoryTreeResponse
+	(*GetAuditLogsRequest)(nil),              // 0: product.GetAuditLogsRequest
+	(*AuditLogChangeResponse)(nil),           // 1: product.AuditLogChangeResponse
+	(*AuditLogResponse)(nil),                 // 2: product.AuditLogResponse
+	(*AuditLogsResponse)(nil),                // 3: product.AuditLogsResponse
+	(*GetProductRevisionsRequest)(nil),       // 4: product.GetProductRevisionsRequest
+	(*ProductRevisionResponse)(nil),          // 5: product.ProductRevisionResponse
+	(*ProductRevisionsResponse)(nil),         // 6: product.ProductRevisionsResponse
+	(*DiffProductRevisionsRequest)(nil),      // 7: product.DiffProductRevisionsRequest
+	(*ProductRevisionFieldDiffResponse)(nil), // 8: product.ProductRevisionFieldDiffResponse
+	(*ProductRevisionDiffResponse)(nil),      // 9: product.ProductRevisionDiffResponse
+	(*RollbackProductRevisionRequest)(nil),   // 10: product.RollbackProductRevisionRequest
+	(*DuplicateProductRequest)(nil),          // 11: product.DuplicateProductRequest
+	(*GetCategoryBySlugRequest)(nil),         // 12: product.GetCategoryBySlugRequest
+	(*CategoryPublicDetailsResponse)(nil),    // 13: product.CategoryPublicDetailsResponse
+	(*MoveCategoryRequest)(nil),              // 14: product.MoveCategoryRequest
+	(*ReorderCategoryChildrenRequest)(nil),   // 15: product.ReorderCategoryChildrenRequest
+	(*CategoriesAdminResponse)(nil),          // 16: product.CategoriesAdminResponse
+	(*AddToWishlistRequest)(nil),             // 17: product.AddToWishlistRequest
+	(*RemoveFromWishlistRequest)(nil),        // 18: product.RemoveFromWishlistRequest
+	(*GetWishlistRequest)(nil),               // 19: product.GetWishlistRequest
+	(*WishlistItemResponse)(nil),             // 20: product.WishlistItemResponse
+	(*WishlistResponse)(nil),                 // 21: product.WishlistResponse
+	(*CreateQuestionRequest)(nil),            // 22: product.CreateQuestionRequest
+	(*CreateAnswerRequest)(nil),              // 23: product.CreateAnswerRequest
+	(*UpvoteRequest)(nil),                    // 24: product.UpvoteRequest
+	(*GetProductQuestionsRequest)(nil),       // 25: product.GetProductQuestionsRequest
+	(*GetQuestionAnswersRequest)(nil),        // 26: product.GetQuestionAnswersRequest
+	(*GetAllQuestionsAdminRequest)(nil),      // 27: product.GetAllQuestionsAdminRequest
+	(*GetAllAnswersAdminRequest)(nil),        // 28: product.GetAllAnswersAdminRequest
+	(*ModerateRequest)(nil),                  // 29: product.ModerateRequest
+	(*QuestionPublicResponse)(nil),           // 30: product.QuestionPublicResponse
+	(*QuestionsPublicResponse)(nil),          // 31: product.QuestionsPublicResponse
+	(*AnswerPublicResponse)(nil),             // 32: product.AnswerPublicResponse
+	(*AnswersPublicResponse)(nil),            // 33: product.AnswersPublicResponse
+	(*QuestionAdminResponse)(nil),            // 34: product.QuestionAdminResponse
+	(*QuestionsAdminResponse)(nil),           // 35: product.QuestionsAdminResponse
+	(*AnswerAdminResponse)(nil),              // 36: product.AnswerAdminResponse
+	(*AnswersAdminResponse)(nil),             // 37: product.AnswersAdminResponse
+	(*CreateReviewRequest)(nil),              // 38: product.CreateReviewRequest
+	(*GetProductReviewsRequest)(nil),         // 39: product.GetProductReviewsRequest
+	(*GetAllReviewsAdminRequest)(nil),        // 40: product.GetAllReviewsAdminRequest
+	(*ModerateReviewRequest)(nil),            // 41: product.ModerateReviewRequest
+	(*ReviewPublicResponse)(nil),             // 42: product.ReviewPublicResponse
+	(*ReviewsPublicResponse)(nil),            // 43: product.ReviewsPublicResponse
+	(*ReviewAdminResponse)(nil),              // 44: product.ReviewAdminResponse
+	(*ReviewsAdminResponse)(nil),             // 45: product.ReviewsAdminResponse
+	(*ProductRelationRequest)(nil),           // 46: product.ProductRelationRequest
+	(*AddProductRelationsRequest)(nil),       // 47: product.AddProductRelationsRequest
+	(*RemoveProductRelationsRequest)(nil),    // 48: product.RemoveProductRelationsRequest
+	(*ProductRelationResponse)(nil),          // 49: product.ProductRelationResponse
+	(*ProductRelationsResponse)(nil),         // 50: product.ProductRelationsResponse
+	(*GetRelatedProductsRequest)(nil),        // 51: product.GetRelatedProductsRequest
+	(*RelatedProductResponse)(nil),           // 52: product.RelatedProductResponse
+	(*RelatedProductsResponse)(nil),          // 53: product.RelatedProductsResponse
+	(*BundleItemRequest)(nil),                // 54: product.BundleItemRequest
+	(*UpdateBundleItemsRequest)(nil),         // 55: product.UpdateBundleItemsRequest
+	(*SellBundleRequest)(nil),                // 56: product.SellBundleRequest
+	(*ReserveBundleRequest)(nil),             // 57: product.ReserveBundleRequest
+	(*ReleaseBundleRequest)(nil),             // 58: product.ReleaseBundleRequest
+	(*BaseBundleItemResponse)(nil),           // 59: product.BaseBundleItemResponse
+	(*BundleItemsResponse)(nil),              // 60: product.BundleItemsResponse
+	(*GetByProductId)(nil),                   // 61: product.GetByProductId
+	(*ImagesResponse)(nil),                   // 62: product.ImagesResponse
+	(*PaginationMetaResponse)(nil),           // 63: product.PaginationMetaResponse
+	(*GetAllProductsAdminRequest)(nil),       // 64: product.GetAllProductsAdminRequest
+	(*PermanentlyDeleteManyRequest)(nil),     // 65: product.PermanentlyDeleteManyRequest
+	(*PermanentlyDeleteOneRequest)(nil),      // 66: product.PermanentlyDeleteOneRequest
+	(*RestoreManyRequest)(nil),               // 67: product.RestoreManyRequest
+	(*RestoreOneRequest)(nil),                // 68: product.RestoreOneRequest
+	(*RestoredResponse)(nil),                 // 69: product.RestoredResponse
+	(*UpdateSizeRequest)(nil),                // 70: product.UpdateSizeRequest
+	(*UpdateColorRequest)(nil),               // 71: product.UpdateColorRequest
+	(*GetAllRequest)(nil),                    // 72: product.GetAllRequest
+	(*DeleteOneRequest)(nil),                 // 73: product.DeleteOneRequest
+	(*DeleteManyRequest)(nil),                // 74: product.DeleteManyRequest
+	(*DeletedResponse)(nil),                  // 75: product.DeletedResponse
+	(*UpdateProductRequest)(nil),             // 76: product.UpdateProductRequest
+	(*UpdateImageRequest)(nil),               // 77: product.UpdateImageRequest
+	(*UpdateVariantRequest)(nil),             // 78: product.UpdateVariantRequest
+	(*ProductsAdminResponse)(nil),            // 79: product.ProductsAdminResponse
+	(*SimpleImageResponse)(nil),              // 80: product.SimpleImageResponse
+	(*ProductAdminResponse)(nil),             // 81: product.ProductAdminResponse
+	(*GetOneRequest)(nil),                    // 82: product.GetOneRequest
+	(*ProductAdminDetailsResponse)(nil),      // 83: product.ProductAdminDetailsResponse
+	(*BaseCategoriesResponse)(nil),           // 84: product.BaseCategoriesResponse
+	(*CreateProductRequest)(nil),             // 85: product.CreateProductRequest
+	(*CreateVariantRequest)(nil),             // 86: product.CreateVariantRequest
+	(*CreateImageRequest)(nil),               // 87: product.CreateImageRequest
+	(*TagsPublicResponse)(nil),               // 88: product.TagsPublicResponse
+	(*BaseTagResponse)(nil),                  // 89: product.BaseTagResponse
+	(*SizesPublicResponse)(nil),              // 90: product.SizesPublicResponse
+	(*ColorsPublicResponse)(nil),             // 91: product.ColorsPublicResponse
+	(*UpdatedResponse)(nil),                  // 92: product.UpdatedResponse
+	(*UpdateTagRequest)(nil),                 // 93: product.UpdateTagRequest
+	(*TagsAdminResponse)(nil),                // 94: product.TagsAdminResponse
+	(*TagAdminResponse)(nil),                 // 95: product.TagAdminResponse
+	(*SizesAdminResponse)(nil),               // 96: product.SizesAdminResponse
+	(*SizeAdminResponse)(nil),                // 97: product.SizeAdminResponse
+	(*ColorsAdminResponse)(nil),              // 98: product.ColorsAdminResponse
+	(*ColorAdminResponse)(nil),               // 99: product.ColorAdminResponse
+	(*UpdateCategoryRequest)(nil),            // 100: product.UpdateCategoryRequest
+	(*CategoryAdminDetailsResponse)(nil),     // 101: product.CategoryAdminDetailsResponse
+	(*BaseProductResponse)(nil),              // 102: product.BaseProductResponse
+	(*BaseProfileResponse)(nil),              // 103: product.BaseProfileResponse
+	(*BaseUserResponse)(nil),                 // 104: product.BaseUserResponse
+	(*CategoryAdminResponse)(nil),            // 105: product.CategoryAdminResponse
+	(*CreateTagRequest)(nil),                 // 106: product.CreateTagRequest
+	(*GetProductsByCategoryRequest)(nil),     // 107: product.GetProductsByCategoryRequest
+	(*ProductsPublicResponse)(nil),           // 108: product.ProductsPublicResponse
+	(*CreateSizeRequest)(nil),                // 109: product.CreateSizeRequest
+	(*CreateColorRequest)(nil),               // 110: product.CreateColorRequest
+	(*CreatedResponse)(nil),                  // 111: product.CreatedResponse
+	(*GetProductBySlugRequest)(nil),          // 112: product.GetProductBySlugRequest
+	(*ProductPublicResponse)(nil),            // 113: product.ProductPublicResponse
+	(*CategoryPathResponse)(nil),             // 114: product.CategoryPathResponse
+	(*BaseImageResponse)(nil),                // 115: product.BaseImageResponse
+	(*BaseColorResponse)(nil),                // 116: product.BaseColorResponse
+	(*BaseSizeResponse)(nil),                 // 117: product.BaseSizeResponse
+	(*BaseInventoryResponse)(nil),            // 118: product.BaseInventoryResponse
+	(*BaseVariantResponse)(nil),              // 119: product.BaseVariantResponse
+	(*CreateCategoryRequest)(nil),            // 120: product.CreateCategoryRequest
+	(*CategoryBannerRequest)(nil),            // 121: product.CategoryBannerRequest
+	(*BaseCategoryResponse)(nil),             // 122: product.BaseCategoryResponse
+	(*CategoryPublicResponse)(nil),           // 123: product.CategoryPublicResponse
+	(*CategoryTreeResponse)(nil),             // 124: product.CategoryTreeResponse
+	(*TranslationRequest)(nil),               // 125: product.TranslationRequest
+	(*TranslationResponse)(nil),              // 126: product.TranslationResponse
+	nil,                                      // 127: product.TranslationRequest.FieldsEntry
+	nil,                                      // 128: product.TranslationResponse.FieldsEntry
 }
 var file_proto_product_proto_depIdxs = []int32{
-	53,  // 0: product.ImagesResponse.images:type_name -> product.BaseImageResponse
-	16,  // 1: product.UpdateProductRequest.update_images:type_name -> product.UpdateImageRequest
-	26,  // 2: product.UpdateProductRequest.new_images:type_name -> product.CreateImageRequest
-	17,  // 3: product.UpdateProductRequest.update_variants:type_name -> product.UpdateVariantRequest
-	25,  // 4: product.UpdateProductRequest.new_variants:type_name -> product.CreateVariantRequest
-	20,  // 5: product.ProductsAdminResponse.products:type_name -> product.ProductAdminResponse
-	2,   // 6: product.ProductsAdminResponse.meta:type_name -> product.PaginationMetaResponse
-	59,  // 7: product.ProductAdminResponse.categories:type_name -> product.BaseCategoryResponse
-	19,  // 8: product.ProductAdminResponse.thumbnail:type_name -> product.SimpleImageResponse
-	59,  // 9: product.ProductAdminDetailsResponse.categories:type_name -> product.BaseCategoryResponse
-	57,  // 10: product.ProductAdminDetailsResponse.variants:type_name -> product.BaseVariantResponse
-	53,  // 11: product.ProductAdminDetailsResponse.images:type_name -> product.BaseImageResponse
-	28,  // 12: product.ProductAdminDetailsResponse.tags:type_name -> product.BaseTagResponse
-	43,  // 13: product.ProductAdminDetailsResponse.created_by:type_name -> product.BaseUserResponse
-	43,  // 14: product.ProductAdminDetailsResponse.updated_by:type_name -> product.BaseUserResponse
-	59,  // 15: product.BaseCategoriesResponse.categories:type_name -> product.BaseCategoryResponse
-	25,  // 16: product.CreateProductRequest.variants:type_name -> product.CreateVariantRequest
-	26,  // 17: product.CreateProductRequest.images:type_name -> product.CreateImageRequest
-	28,  // 18: product.TagsPublicResponse.tags:type_name -> product.BaseTagResponse
-	55,  // 19: product.SizesPublicResponse.sizes:type_name -> product.BaseSizeResponse
-	54,  // 20: product.ColorsPublicResponse.colors:type_name -> product.BaseColorResponse
-	34,  // 21: product.TagsAdminResponse.tags:type_name -> product.TagAdminResponse
-	43,  // 22: product.TagAdminResponse.created_by:type_name -> product.BaseUserResponse
-	43,  // 23: product.TagAdminResponse.updated_by:type_name -> product.BaseUserResponse
-	36,  // 24: product.SizesAdminResponse.sizes:type_name -> product.SizeAdminResponse
-	43,  // 25: product.SizeAdminResponse.created_by:type_name -> product.BaseUserResponse
-	43,  // 26: product.SizeAdminResponse.updated_by:type_name -> product.BaseUserResponse
-	38,  // 27: product.ColorsAdminResponse.colors:type_name -> product.ColorAdminResponse
-	43,  // 28: product.ColorAdminResponse.created_by:type_name -> product.BaseUserResponse
-	43,  // 29: product.ColorAdminResponse.updated_by:type_name -> product.BaseUserResponse
-	59,  // 30: product.CategoryAdminDetailsResponse.parents:type_name -> product.BaseCategoryResponse
-	43,  // 31: product.CategoryAdminDetailsResponse.created_by:type_name -> product.BaseUserResponse
-	43,  // 32: product.CategoryAdminDetailsResponse.updated_by:type_name -> product.BaseUserResponse
-	41,  // 33: product.CategoryAdminDetailsResponse.products:type_name -> product.BaseProductResponse
-	53,  // 34: product.BaseProductResponse.image:type_name -> product.BaseImageResponse
-	42,  // 35: product.BaseUserResponse.profile:type_name -> product.BaseProfileResponse
-	59,  // 36: product.CategoryAdminResponse.parents:type_name -> product.BaseCategoryResponse
-	43,  // 37: product.CategoryAdminResponse.created_by:type_name -> product.BaseUserResponse
-	43,  // 38: product.CategoryAdminResponse.updated_by:type_name -> product.BaseUserResponse
-	52,  // 39: product.ProductsPublicResponse.products:type_name -> product.ProductPublicResponse
-	59,  // 40: product.ProductPublicResponse.categories:type_name -> product.BaseCategoryResponse
-	57,  // 41: product.ProductPublicResponse.variants:type_name -> product.BaseVariantResponse
-	53,  // 42: product.ProductPublicResponse.images:type_name -> product.BaseImageResponse
-	54,  // 43: product.BaseImageResponse.color:type_name -> product.BaseColorResponse
-	54,  // 44: product.BaseVariantResponse.color:type_name -> product.BaseColorResponse
-	55,  // 45: product.BaseVariantResponse.size:type_name -> product.BaseSizeResponse
-	56,  // 46: product.BaseVariantResponse.inventory:type_name -> product.BaseInventoryResponse
-	60,  // 47: product.CategoryPublicResponse.children:type_name -> product.CategoryPublicResponse
-	60,  // 48: product.CategoryTreeResponse.categories:type_name -> product.CategoryPublicResponse
-	58,  // 49: product.ProductService.CreateCategory:input_type -> product.CreateCategoryRequest
-	11,  // 50: product.ProductService.GetCategoryTree:input_type -> product.GetAllRequest
-	51,  // 51: product.ProductService.GetProductBySlug:input_type -> product.GetProductBySlugRequest
-	49,  // 52: product.ProductService.CreateColor:input_type -> product.CreateColorRequest
-	48,  // 53: product.ProductService.CreateSize:input_type -> product.CreateSizeRequest
-	46,  // 54: product.ProductService.GetProductsByCategory:input_type -> product.GetProductsByCategoryRequest
-	45,  // 55: product.ProductService.CreateTag:input_type -> product.CreateTagRequest
-	11,  // 56: product.ProductService.GetAllCategoriesAdmin:input_type -> product.GetAllRequest
-	21,  // 57: product.ProductService.GetCategoryById:input_type -> product.GetOneRequest
-	39,  // 58: product.ProductService.UpdateCategory:input_type -> product.UpdateCategoryRequest
-	11,  // 59: product.ProductService.GetAllColorsAdmin:input_type -> product.GetAllRequest
-	11,  // 60: product.ProductService.GetAllSizesAdmin:input_type -> product.GetAllRequest
-	11,  // 61: product.ProductService.GetAllTagsAdmin:input_type -> product.GetAllRequest
-	32,  // 62: product.ProductService.UpdateTag:input_type -> product.UpdateTagRequest
-	11,  // 63: product.ProductService.GetAllColors:input_type -> product.GetAllRequest
-	11,  // 64: product.ProductService.GetAllSizes:input_type -> product.GetAllRequest
-	11,  // 65: product.ProductService.GetAllTags:input_type -> product.GetAllRequest
-	24,  // 66: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
-	11,  // 67: product.ProductService.GetCategoriesNoChild:input_type -> product.GetAllRequest
-	21,  // 68: product.ProductService.GetProductById:input_type -> product.GetOneRequest
-	3,   // 69: product.ProductService.GetAllProductsAdmin:input_type -> product.GetAllProductsAdminRequest
-	15,  // 70: product.ProductService.UpdateProduct:input_type -> product.UpdateProductRequest
-	12,  // 71: product.ProductService.DeleteProduct:input_type -> product.DeleteOneRequest
-	13,  // 72: product.ProductService.DeleteProducts:input_type -> product.DeleteManyRequest
-	5,   // 73: product.ProductService.PermanentlyDeleteCategory:input_type -> product.PermanentlyDeleteOneRequest
-	4,   // 74: product.ProductService.PermanentlyDeleteCategories:input_type -> product.PermanentlyDeleteManyRequest
-	11,  // 75: product.ProductService.GetCategoriesNoProduct:input_type -> product.GetAllRequest
-	10,  // 76: product.ProductService.UpdateColor:input_type -> product.UpdateColorRequest
-	9,   // 77: product.ProductService.UpdateSize:input_type -> product.UpdateSizeRequest
-	12,  // 78: product.ProductService.DeleteColor:input_type -> product.DeleteOneRequest
-	12,  // 79: product.ProductService.DeleteSize:input_type -> product.DeleteOneRequest
-	13,  // 80: product.ProductService.DeleteColors:input_type -> product.DeleteManyRequest
-	13,  // 81: product.ProductService.DeleteSizes:input_type -> product.DeleteManyRequest
-	3,   // 82: product.ProductService.GetDeletedProducts:input_type -> product.GetAllProductsAdminRequest
-	21,  // 83: product.ProductService.GetDeletedProductById:input_type -> product.GetOneRequest
-	11,  // 84: product.ProductService.GetDeletedColors:input_type -> product.GetAllRequest
-	11,  // 85: product.ProductService.GetDeletedSizes:input_type -> product.GetAllRequest
-	11,  // 86: product.ProductService.GetDeletedTags:input_type -> product.GetAllRequest
-	12,  // 87: product.ProductService.DeleteTag:input_type -> product.DeleteOneRequest
-	13,  // 88: product.ProductService.DeleteTags:input_type -> product.DeleteManyRequest
-	7,   // 89: product.ProductService.RestoreProduct:input_type -> product.RestoreOneRequest
-	6,   // 90: product.ProductService.RestoreProducts:input_type -> product.RestoreManyRequest
-	7,   // 91: product.ProductService.RestoreColor:input_type -> product.RestoreOneRequest
-	6,   // 92: product.ProductService.RestoreColors:input_type -> product.RestoreManyRequest
-	7,   // 93: product.ProductService.RestoreSize:input_type -> product.RestoreOneRequest
-	6,   // 94: product.ProductService.RestoreSizes:input_type -> product.RestoreManyRequest
-	7,   // 95: product.ProductService.RestoreTag:input_type -> product.RestoreOneRequest
-	6,   // 96: product.ProductService.RestoreTags:input_type -> product.RestoreManyRequest
-	5,   // 97: product.ProductService.PermanentlyDeleteProduct:input_type -> product.PermanentlyDeleteOneRequest
-	4,   // 98: product.ProductService.PermanentlyDeleteProducts:input_type -> product.PermanentlyDeleteManyRequest
-	5,   // 99: product.ProductService.PermanentlyDeleteColor:input_type -> product.PermanentlyDeleteOneRequest
-	4,   // 100: product.ProductService.PermanentlyDeleteColors:input_type -> product.PermanentlyDeleteManyRequest
-	5,   // 101: product.ProductService.PermanentlyDeleteSize:input_type -> product.PermanentlyDeleteOneRequest
-	4,   // 102: product.ProductService.PermanentlyDeleteSizes:input_type -> product.PermanentlyDeleteManyRequest
-	5,   // 103: product.ProductService.PermanentlyDeleteTag:input_type -> product.PermanentlyDeleteOneRequest
-	4,   // 104: product.ProductService.PermanentlyDeleteTags:input_type -> product.PermanentlyDeleteManyRequest
-	0,   // 105: product.ProductService.GetImagesByProductId:input_type -> product.GetByProductId
-	50,  // 106: product.ProductService.CreateCategory:output_type -> product.CreatedResponse
-	61,  // 107: product.ProductService.GetCategoryTree:output_type -> product.CategoryTreeResponse
-	52,  // 108: product.ProductService.GetProductBySlug:output_type -> product.ProductPublicResponse
-	50,  // 109: product.ProductService.CreateColor:output_type -> product.CreatedResponse
-	50,  // 110: product.ProductService.CreateSize:output_type -> product.CreatedResponse
-	47,  // 111: product.ProductService.GetProductsByCategory:output_type -> product.ProductsPublicResponse
-	50,  // 112: product.ProductService.CreateTag:output_type -> product.CreatedResponse
-	23,  // 113: product.ProductService.GetAllCategoriesAdmin:output_type -> product.BaseCategoriesResponse
-	40,  // 114: product.ProductService.GetCategoryById:output_type -> product.CategoryAdminDetailsResponse
-	40,  // 115: product.ProductService.UpdateCategory:output_type -> product.CategoryAdminDetailsResponse
-	37,  // 116: product.ProductService.GetAllColorsAdmin:output_type -> product.ColorsAdminResponse
-	35,  // 117: product.ProductService.GetAllSizesAdmin:output_type -> product.SizesAdminResponse
-	33,  // 118: product.ProductService.GetAllTagsAdmin:output_type -> product.TagsAdminResponse
-	31,  // 119: product.ProductService.UpdateTag:output_type -> product.UpdatedResponse
-	30,  // 120: product.ProductService.GetAllColors:output_type -> product.ColorsPublicResponse
-	29,  // 121: product.ProductService.GetAllSizes:output_type -> product.SizesPublicResponse
-	27,  // 122: product.ProductService.GetAllTags:output_type -> product.TagsPublicResponse
-	50,  // 123: product.ProductService.CreateProduct:output_type -> product.CreatedResponse
-	23,  // 124: product.ProductService.GetCategoriesNoChild:output_type -> product.BaseCategoriesResponse
-	22,  // 125: product.ProductService.GetProductById:output_type -> product.ProductAdminDetailsResponse
-	18,  // 126: product.ProductService.GetAllProductsAdmin:output_type -> product.ProductsAdminResponse
-	22,  // 127: product.ProductService.UpdateProduct:output_type -> product.ProductAdminDetailsResponse
-	14,  // 128: product.ProductService.DeleteProduct:output_type -> product.DeletedResponse
-	14,  // 129: product.ProductService.DeleteProducts:output_type -> product.DeletedResponse
-	14,  // 130: product.ProductService.PermanentlyDeleteCategory:output_type -> product.DeletedResponse
-	14,  // 131: product.ProductService.PermanentlyDeleteCategories:output_type -> product.DeletedResponse
-	23,  // 132: product.ProductService.GetCategoriesNoProduct:output_type -> product.BaseCategoriesResponse
-	31,  // 133: product.ProductService.UpdateColor:output_type -> product.UpdatedResponse
-	31,  // 134: product.ProductService.UpdateSize:output_type -> product.UpdatedResponse
-	14,  // 135: product.ProductService.DeleteColor:output_type -> product.DeletedResponse
-	14,  // 136: product.ProductService.DeleteSize:output_type -> product.DeletedResponse
-	14,  // 137: product.ProductService.DeleteColors:output_type -> product.DeletedResponse
-	14,  // 138: product.ProductService.DeleteSizes:output_type -> product.DeletedResponse
-	18,  // 139: product.ProductService.GetDeletedProducts:output_type -> product.ProductsAdminResponse
-	22,  // 140: product.ProductService.GetDeletedProductById:output_type -> product.ProductAdminDetailsResponse
-	37,  // 141: product.ProductService.GetDeletedColors:output_type -> product.ColorsAdminResponse
-	35,  // 142: product.ProductService.GetDeletedSizes:output_type -> product.SizesAdminResponse
-	33,  // 143: product.ProductService.GetDeletedTags:output_type -> product.TagsAdminResponse
-	14,  // 144: product.ProductService.DeleteTag:output_type -> product.DeletedResponse
-	14,  // 145: product.ProductService.DeleteTags:output_type -> product.DeletedResponse
-	8,   // 146: product.ProductService.RestoreProduct:output_type -> product.RestoredResponse
-	8,   // 147: product.ProductService.RestoreProducts:output_type -> product.RestoredResponse
-	8,   // 148: product.ProductService.RestoreColor:output_type -> product.RestoredResponse
-	8,   // 149: product.ProductService.RestoreColors:output_type -> product.RestoredResponse
-	8,   // 150: product.ProductService.RestoreSize:output_type -> product.RestoredResponse
-	8,   // 151: product.ProductService.RestoreSizes:output_type -> product.RestoredResponse
-	8,   // 152: product.ProductService.RestoreTag:output_type -> product.RestoredResponse
-	8,   // 153: product.ProductService.RestoreTags:output_type -> product.RestoredResponse
-	14,  // 154: product.ProductService.PermanentlyDeleteProduct:output_type -> product.DeletedResponse
-	14,  // 155: product.ProductService.PermanentlyDeleteProducts:output_type -> product.DeletedResponse
-	14,  // 156: product.ProductService.PermanentlyDeleteColor:output_type -> product.DeletedResponse
-	14,  // 157: product.ProductService.PermanentlyDeleteColors:output_type -> product.DeletedResponse
-	14,  // 158: product.ProductService.PermanentlyDeleteSize:output_type -> product.DeletedResponse
-	14,  // 159: product.ProductService.PermanentlyDeleteSizes:output_type -> product.DeletedResponse
-	14,  // 160: product.ProductService.PermanentlyDeleteTag:output_type -> product.DeletedResponse
-	14,  // 161: product.ProductService.PermanentlyDeleteTags:output_type -> product.DeletedResponse
-	1,   // 162: product.ProductService.GetImagesByProductId:output_type -> product.ImagesResponse
-	106, // [106:163] is the sub-list for method output_type
-	49,  // [49:106] is the sub-list for method input_type
-	49,  // [49:49] is the sub-list for extension type_name
-	49,  // [49:49] is the sub-list for extension extendee
-	0,   // [0:49] is the sub-list for field type_name
+	1,   // 0: product.AuditLogResponse.changes:type_name -> product.AuditLogChangeResponse
+	104, // 1: product.AuditLogResponse.actor:type_name -> product.BaseUserResponse
+	2,   // 2: product.AuditLogsResponse.logs:type_name -> product.AuditLogResponse
+	63,  // 3: product.AuditLogsResponse.meta:type_name -> product.PaginationMetaResponse
+	104, // 4: product.ProductRevisionResponse.created_by:type_name -> product.BaseUserResponse
+	5,   // 5: product.ProductRevisionsResponse.revisions:type_name -> product.ProductRevisionResponse
+	63,  // 6: product.ProductRevisionsResponse.meta:type_name -> product.PaginationMetaResponse
+	8,   // 7: product.ProductRevisionDiffResponse.changes:type_name -> product.ProductRevisionFieldDiffResponse
+	122, // 8: product.CategoryPublicDetailsResponse.breadcrumbs:type_name -> product.BaseCategoryResponse
+	122, // 9: product.CategoryPublicDetailsResponse.children:type_name -> product.BaseCategoryResponse
+	102, // 10: product.CategoryPublicDetailsResponse.featured_products:type_name -> product.BaseProductResponse
+	105, // 11: product.CategoriesAdminResponse.categories:type_name -> product.CategoryAdminResponse
+	102, // 12: product.WishlistItemResponse.product:type_name -> product.BaseProductResponse
+	119, // 13: product.WishlistItemResponse.variant:type_name -> product.BaseVariantResponse
+	20,  // 14: product.WishlistResponse.items:type_name -> product.WishlistItemResponse
+	104, // 15: product.QuestionPublicResponse.user:type_name -> product.BaseUserResponse
+	30,  // 16: product.QuestionsPublicResponse.questions:type_name -> product.QuestionPublicResponse
+	63,  // 17: product.QuestionsPublicResponse.meta:type_name -> product.PaginationMetaResponse
+	104, // 18: product.AnswerPublicResponse.user:type_name -> product.BaseUserResponse
+	32,  // 19: product.AnswersPublicResponse.answers:type_name -> product.AnswerPublicResponse
+	63,  // 20: product.AnswersPublicResponse.meta:type_name -> product.PaginationMetaResponse
+	104, // 21: product.QuestionAdminResponse.user:type_name -> product.BaseUserResponse
+	104, // 22: product.QuestionAdminResponse.moderated_by:type_name -> product.BaseUserResponse
+	34,  // 23: product.QuestionsAdminResponse.questions:type_name -> product.QuestionAdminResponse
+	63,  // 24: product.QuestionsAdminResponse.meta:type_name -> product.PaginationMetaResponse
+	104, // 25: product.AnswerAdminResponse.user:type_name -> product.BaseUserResponse
+	104, // 26: product.AnswerAdminResponse.moderated_by:type_name -> product.BaseUserResponse
+	36,  // 27: product.AnswersAdminResponse.answers:type_name -> product.AnswerAdminResponse
+	63,  // 28: product.AnswersAdminResponse.meta:type_name -> product.PaginationMetaResponse
+	104, // 29: product.ReviewPublicResponse.user:type_name -> product.BaseUserResponse
+	42,  // 30: product.ReviewsPublicResponse.reviews:type_name -> product.ReviewPublicResponse
+	63,  // 31: product.ReviewsPublicResponse.meta:type_name -> product.PaginationMetaResponse
+	104, // 32: product.ReviewAdminResponse.user:type_name -> product.BaseUserResponse
+	104, // 33: product.ReviewAdminResponse.moderated_by:type_name -> product.BaseUserResponse
+	44,  // 34: product.ReviewsAdminResponse.reviews:type_name -> product.ReviewAdminResponse
+	63,  // 35: product.ReviewsAdminResponse.meta:type_name -> product.PaginationMetaResponse
+	46,  // 36: product.AddProductRelationsRequest.relations:type_name -> product.ProductRelationRequest
+	102, // 37: product.ProductRelationResponse.product:type_name -> product.BaseProductResponse
+	49,  // 38: product.ProductRelationsResponse.relations:type_name -> product.ProductRelationResponse
+	102, // 39: product.RelatedProductResponse.product:type_name -> product.BaseProductResponse
+	52,  // 40: product.RelatedProductsResponse.products:type_name -> product.RelatedProductResponse
+	54,  // 41: product.UpdateBundleItemsRequest.items:type_name -> product.BundleItemRequest
+	119, // 42: product.BaseBundleItemResponse.variant:type_name -> product.BaseVariantResponse
+	102, // 43: product.BaseBundleItemResponse.product:type_name -> product.BaseProductResponse
+	59,  // 44: product.BundleItemsResponse.items:type_name -> product.BaseBundleItemResponse
+	115, // 45: product.ImagesResponse.images:type_name -> product.BaseImageResponse
+	125, // 46: product.UpdateSizeRequest.translations:type_name -> product.TranslationRequest
+	125, // 47: product.UpdateColorRequest.translations:type_name -> product.TranslationRequest
+	77,  // 48: product.UpdateProductRequest.update_images:type_name -> product.UpdateImageRequest
+	87,  // 49: product.UpdateProductRequest.new_images:type_name -> product.CreateImageRequest
+	78,  // 50: product.UpdateProductRequest.update_variants:type_name -> product.UpdateVariantRequest
+	86,  // 51: product.UpdateProductRequest.new_variants:type_name -> product.CreateVariantRequest
+	125, // 52: product.UpdateProductRequest.translations:type_name -> product.TranslationRequest
+	81,  // 53: product.ProductsAdminResponse.products:type_name -> product.ProductAdminResponse
+	63,  // 54: product.ProductsAdminResponse.meta:type_name -> product.PaginationMetaResponse
+	122, // 55: product.ProductAdminResponse.categories:type_name -> product.BaseCategoryResponse
+	80,  // 56: product.ProductAdminResponse.thumbnail:type_name -> product.SimpleImageResponse
+	122, // 57: product.ProductAdminDetailsResponse.categories:type_name -> product.BaseCategoryResponse
+	119, // 58: product.ProductAdminDetailsResponse.variants:type_name -> product.BaseVariantResponse
+	115, // 59: product.ProductAdminDetailsResponse.images:type_name -> product.BaseImageResponse
+	89,  // 60: product.ProductAdminDetailsResponse.tags:type_name -> product.BaseTagResponse
+	104, // 61: product.ProductAdminDetailsResponse.created_by:type_name -> product.BaseUserResponse
+	104, // 62: product.ProductAdminDetailsResponse.updated_by:type_name -> product.BaseUserResponse
+	59,  // 63: product.ProductAdminDetailsResponse.bundle_items:type_name -> product.BaseBundleItemResponse
+	126, // 64: product.ProductAdminDetailsResponse.translations:type_name -> product.TranslationResponse
+	122, // 65: product.BaseCategoriesResponse.categories:type_name -> product.BaseCategoryResponse
+	86,  // 66: product.CreateProductRequest.variants:type_name -> product.CreateVariantRequest
+	87,  // 67: product.CreateProductRequest.images:type_name -> product.CreateImageRequest
+	54,  // 68: product.CreateProductRequest.bundle_items:type_name -> product.BundleItemRequest
+	125, // 69: product.CreateProductRequest.translations:type_name -> product.TranslationRequest
+	89,  // 70: product.TagsPublicResponse.tags:type_name -> product.BaseTagResponse
+	117, // 71: product.SizesPublicResponse.sizes:type_name -> product.BaseSizeResponse
+	116, // 72: product.ColorsPublicResponse.colors:type_name -> product.BaseColorResponse
+	125, // 73: product.UpdateTagRequest.translations:type_name -> product.TranslationRequest
+	95,  // 74: product.TagsAdminResponse.tags:type_name -> product.TagAdminResponse
+	104, // 75: product.TagAdminResponse.created_by:type_name -> product.BaseUserResponse
+	104, // 76: product.TagAdminResponse.updated_by:type_name -> product.BaseUserResponse
+	126, // 77: product.TagAdminResponse.translations:type_name -> product.TranslationResponse
+	97,  // 78: product.SizesAdminResponse.sizes:type_name -> product.SizeAdminResponse
+	104, // 79: product.SizeAdminResponse.created_by:type_name -> product.BaseUserResponse
+	104, // 80: product.SizeAdminResponse.updated_by:type_name -> product.BaseUserResponse
+	126, // 81: product.SizeAdminResponse.translations:type_name -> product.TranslationResponse
+	99,  // 82: product.ColorsAdminResponse.colors:type_name -> product.ColorAdminResponse
+	104, // 83: product.ColorAdminResponse.created_by:type_name -> product.BaseUserResponse
+	104, // 84: product.ColorAdminResponse.updated_by:type_name -> product.BaseUserResponse
+	126, // 85: product.ColorAdminResponse.translations:type_name -> product.TranslationResponse
+	121, // 86: product.UpdateCategoryRequest.banner:type_name -> product.CategoryBannerRequest
+	125, // 87: product.UpdateCategoryRequest.translations:type_name -> product.TranslationRequest
+	122, // 88: product.CategoryAdminDetailsResponse.parents:type_name -> product.BaseCategoryResponse
+	104, // 89: product.CategoryAdminDetailsResponse.created_by:type_name -> product.BaseUserResponse
+	104, // 90: product.CategoryAdminDetailsResponse.updated_by:type_name -> product.BaseUserResponse
+	102, // 91: product.CategoryAdminDetailsResponse.products:type_name -> product.BaseProductResponse
+	102, // 92: product.CategoryAdminDetailsResponse.featured_products:type_name -> product.BaseProductResponse
+	126, // 93: product.CategoryAdminDetailsResponse.translations:type_name -> product.TranslationResponse
+	115, // 94: product.BaseProductResponse.image:type_name -> product.BaseImageResponse
+	103, // 95: product.BaseUserResponse.profile:type_name -> product.BaseProfileResponse
+	122, // 96: product.CategoryAdminResponse.parents:type_name -> product.BaseCategoryResponse
+	104, // 97: product.CategoryAdminResponse.created_by:type_name -> product.BaseUserResponse
+	104, // 98: product.CategoryAdminResponse.updated_by:type_name -> product.BaseUserResponse
+	125, // 99: product.CreateTagRequest.translations:type_name -> product.TranslationRequest
+	113, // 100: product.ProductsPublicResponse.products:type_name -> product.ProductPublicResponse
+	125, // 101: product.CreateSizeRequest.translations:type_name -> product.TranslationRequest
+	125, // 102: product.CreateColorRequest.translations:type_name -> product.TranslationRequest
+	122, // 103: product.ProductPublicResponse.categories:type_name -> product.BaseCategoryResponse
+	119, // 104: product.ProductPublicResponse.variants:type_name -> product.BaseVariantResponse
+	115, // 105: product.ProductPublicResponse.images:type_name -> product.BaseImageResponse
+	59,  // 106: product.ProductPublicResponse.bundle_items:type_name -> product.BaseBundleItemResponse
+	122, // 107: product.ProductPublicResponse.primary_category:type_name -> product.BaseCategoryResponse
+	114, // 108: product.ProductPublicResponse.breadcrumbs:type_name -> product.CategoryPathResponse
+	122, // 109: product.CategoryPathResponse.categories:type_name -> product.BaseCategoryResponse
+	116, // 110: product.BaseImageResponse.color:type_name -> product.BaseColorResponse
+	116, // 111: product.BaseVariantResponse.color:type_name -> product.BaseColorResponse
+	117, // 112: product.BaseVariantResponse.size:type_name -> product.BaseSizeResponse
+	118, // 113: product.BaseVariantResponse.inventory:type_name -> product.BaseInventoryResponse
+	121, // 114: product.CreateCategoryRequest.banner:type_name -> product.CategoryBannerRequest
+	125, // 115: product.CreateCategoryRequest.translations:type_name -> product.TranslationRequest
+	123, // 116: product.CategoryPublicResponse.children:type_name -> product.CategoryPublicResponse
+	123, // 117: product.CategoryTreeResponse.categories:type_name -> product.CategoryPublicResponse
+	127, // 118: product.TranslationRequest.fields:type_name -> product.TranslationRequest.FieldsEntry
+	128, // 119: product.TranslationResponse.fields:type_name -> product.TranslationResponse.FieldsEntry
+	120, // 120: product.ProductService.CreateCategory:input_type -> product.CreateCategoryRequest
+	72,  // 121: product.ProductService.GetCategoryTree:input_type -> product.GetAllRequest
+	112, // 122: product.ProductService.GetProductBySlug:input_type -> product.GetProductBySlugRequest
+	110, // 123: product.ProductService.CreateColor:input_type -> product.CreateColorRequest
+	109, // 124: product.ProductService.CreateSize:input_type -> product.CreateSizeRequest
+	107, // 125: product.ProductService.GetProductsByCategory:input_type -> product.GetProductsByCategoryRequest
+	106, // 126: product.ProductService.CreateTag:input_type -> product.CreateTagRequest
+	72,  // 127: product.ProductService.GetAllCategoriesAdmin:input_type -> product.GetAllRequest
+	82,  // 128: product.ProductService.GetCategoryById:input_type -> product.GetOneRequest
+	100, // 129: product.ProductService.UpdateCategory:input_type -> product.UpdateCategoryRequest
+	72,  // 130: product.ProductService.GetAllColorsAdmin:input_type -> product.GetAllRequest
+	72,  // 131: product.ProductService.GetAllSizesAdmin:input_type -> product.GetAllRequest
+	72,  // 132: product.ProductService.GetAllTagsAdmin:input_type -> product.GetAllRequest
+	93,  // 133: product.ProductService.UpdateTag:input_type -> product.UpdateTagRequest
+	72,  // 134: product.ProductService.GetAllColors:input_type -> product.GetAllRequest
+	72,  // 135: product.ProductService.GetAllSizes:input_type -> product.GetAllRequest
+	72,  // 136: product.ProductService.GetAllTags:input_type -> product.GetAllRequest
+	85,  // 137: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
+	72,  // 138: product.ProductService.GetCategoriesNoChild:input_type -> product.GetAllRequest
+	82,  // 139: product.ProductService.GetProductById:input_type -> product.GetOneRequest
+	64,  // 140: product.ProductService.GetAllProductsAdmin:input_type -> product.GetAllProductsAdminRequest
+	76,  // 141: product.ProductService.UpdateProduct:input_type -> product.UpdateProductRequest
+	73,  // 142: product.ProductService.DeleteProduct:input_type -> product.DeleteOneRequest
+	74,  // 143: product.ProductService.DeleteProducts:input_type -> product.DeleteManyRequest
+	66,  // 144: product.ProductService.PermanentlyDeleteCategory:input_type -> product.PermanentlyDeleteOneRequest
+	65,  // 145: product.ProductService.PermanentlyDeleteCategories:input_type -> product.PermanentlyDeleteManyRequest
+	72,  // 146: product.ProductService.GetCategoriesNoProduct:input_type -> product.GetAllRequest
+	71,  // 147: product.ProductService.UpdateColor:input_type -> product.UpdateColorRequest
+	70,  // 148: product.ProductService.UpdateSize:input_type -> product.UpdateSizeRequest
+	73,  // 149: product.ProductService.DeleteColor:input_type -> product.DeleteOneRequest
+	73,  // 150: product.ProductService.DeleteSize:input_type -> product.DeleteOneRequest
+	74,  // 151: product.ProductService.DeleteColors:input_type -> product.DeleteManyRequest
+	74,  // 152: product.ProductService.DeleteSizes:input_type -> product.DeleteManyRequest
+	64,  // 153: product.ProductService.GetDeletedProducts:input_type -> product.GetAllProductsAdminRequest
+	82,  // 154: product.ProductService.GetDeletedProductById:input_type -> product.GetOneRequest
+	72,  // 155: product.ProductService.GetDeletedColors:input_type -> product.GetAllRequest
+	72,  // 156: product.ProductService.GetDeletedSizes:input_type -> product.GetAllRequest
+	72,  // 157: product.ProductService.GetDeletedTags:input_type -> product.GetAllRequest
+	73,  // 158: product.ProductService.DeleteTag:input_type -> product.DeleteOneRequest
+	74,  // 159: product.ProductService.DeleteTags:input_type -> product.DeleteManyRequest
+	68,  // 160: product.ProductService.RestoreProduct:input_type -> product.RestoreOneRequest
+	67,  // 161: product.ProductService.RestoreProducts:input_type -> product.RestoreManyRequest
+	68,  // 162: product.ProductService.RestoreColor:input_type -> product.RestoreOneRequest
+	67,  // 163: product.ProductService.RestoreColors:input_type -> product.RestoreManyRequest
+	68,  // 164: product.ProductService.RestoreSize:input_type -> product.RestoreOneRequest
+	67,  // 165: product.ProductService.RestoreSizes:input_type -> product.RestoreManyRequest
+	68,  // 166: product.ProductService.RestoreTag:input_type -> product.RestoreOneRequest
+	67,  // 167: product.ProductService.RestoreTags:input_type -> product.RestoreManyRequest
+	66,  // 168: product.ProductService.PermanentlyDeleteProduct:input_type -> product.PermanentlyDeleteOneRequest
+	65,  // 169: product.ProductService.PermanentlyDeleteProducts:input_type -> product.PermanentlyDeleteManyRequest
+	66,  // 170: product.ProductService.PermanentlyDeleteColor:input_type -> product.PermanentlyDeleteOneRequest
+	65,  // 171: product.ProductService.PermanentlyDeleteColors:input_type -> product.PermanentlyDeleteManyRequest
+	66,  // 172: product.ProductService.PermanentlyDeleteSize:input_type -> product.PermanentlyDeleteOneRequest
+	65,  // 173: product.ProductService.PermanentlyDeleteSizes:input_type -> product.PermanentlyDeleteManyRequest
+	66,  // 174: product.ProductService.PermanentlyDeleteTag:input_type -> product.PermanentlyDeleteOneRequest
+	65,  // 175: product.ProductService.PermanentlyDeleteTags:input_type -> product.PermanentlyDeleteManyRequest
+	61,  // 176: product.ProductService.GetImagesByProductId:input_type -> product.GetByProductId
+	55,  // 177: product.ProductService.UpdateBundleItems:input_type -> product.UpdateBundleItemsRequest
+	61,  // 178: product.ProductService.GetBundleItems:input_type -> product.GetByProductId
+	56,  // 179: product.ProductService.SellBundle:input_type -> product.SellBundleRequest
+	57,  // 180: product.ProductService.ReserveBundle:input_type -> product.ReserveBundleRequest
+	58,  // 181: product.ProductService.ReleaseBundle:input_type -> product.ReleaseBundleRequest
+	47,  // 182: product.ProductService.AddProductRelations:input_type -> product.AddProductRelationsRequest
+	48,  // 183: product.ProductService.RemoveProductRelations:input_type -> product.RemoveProductRelationsRequest
+	61,  // 184: product.ProductService.GetProductRelations:input_type -> product.GetByProductId
+	51,  // 185: product.ProductService.GetRelatedProducts:input_type -> product.GetRelatedProductsRequest
+	38,  // 186: product.ProductService.CreateReview:input_type -> product.CreateReviewRequest
+	39,  // 187: product.ProductService.GetProductReviews:input_type -> product.GetProductReviewsRequest
+	40,  // 188: product.ProductService.GetAllReviewsAdmin:input_type -> product.GetAllReviewsAdminRequest
+	41,  // 189: product.ProductService.ModerateReview:input_type -> product.ModerateReviewRequest
+	22,  // 190: product.ProductService.CreateQuestion:input_type -> product.CreateQuestionRequest
+	23,  // 191: product.ProductService.CreateAnswer:input_type -> product.CreateAnswerRequest
+	24,  // 192: product.ProductService.UpvoteQuestion:input_type -> product.UpvoteRequest
+	24,  // 193: product.ProductService.UpvoteAnswer:input_type -> product.UpvoteRequest
+	25,  // 194: product.ProductService.GetProductQuestions:input_type -> product.GetProductQuestionsRequest
+	26,  // 195: product.ProductService.GetQuestionAnswers:input_type -> product.GetQuestionAnswersRequest
+	27,  // 196: product.ProductService.GetAllQuestionsAdmin:input_type -> product.GetAllQuestionsAdminRequest
+	28,  // 197: product.ProductService.GetAllAnswersAdmin:input_type -> product.GetAllAnswersAdminRequest
+	29,  // 198: product.ProductService.ModerateQuestion:input_type -> product.ModerateRequest
+	29,  // 199: product.ProductService.ModerateAnswer:input_type -> product.ModerateRequest
+	17,  // 200: product.ProductService.AddToWishlist:input_type -> product.AddToWishlistRequest
+	18,  // 201: product.ProductService.RemoveFromWishlist:input_type -> product.RemoveFromWishlistRequest
+	19,  // 202: product.ProductService.GetWishlist:input_type -> product.GetWishlistRequest
+	73,  // 203: product.ProductService.DeleteCategory:input_type -> product.DeleteOneRequest
+	74,  // 204: product.ProductService.DeleteCategories:input_type -> product.DeleteManyRequest
+	72,  // 205: product.ProductService.GetDeletedCategories:input_type -> product.GetAllRequest
+	68,  // 206: product.ProductService.RestoreCategory:input_type -> product.RestoreOneRequest
+	67,  // 207: product.ProductService.RestoreCategories:input_type -> product.RestoreManyRequest
+	14,  // 208: product.ProductService.MoveCategory:input_type -> product.MoveCategoryRequest
+	15,  // 209: product.ProductService.ReorderCategoryChildren:input_type -> product.ReorderCategoryChildrenRequest
+	12,  // 210: product.ProductService.GetCategoryBySlug:input_type -> product.GetCategoryBySlugRequest
+	11,  // 211: product.ProductService.DuplicateProduct:input_type -> product.DuplicateProductRequest
+	4,   // 212: product.ProductService.GetProductRevisions:input_type -> product.GetProductRevisionsRequest
+	7,   // 213: product.ProductService.DiffProductRevisions:input_type -> product.DiffProductRevisionsRequest
+	10,  // 214: product.ProductService.RollbackProductRevision:input_type -> product.RollbackProductRevisionRequest
+	0,   // 215: product.ProductService.GetAuditLogs:input_type -> product.GetAuditLogsRequest
+	111, // 216: product.ProductService.CreateCategory:output_type -> product.CreatedResponse
+	124, // 217: product.ProductService.GetCategoryTree:output_type -> product.CategoryTreeResponse
+	113, // 218: product.ProductService.GetProductBySlug:output_type -> product.ProductPublicResponse
+	111, // 219: product.ProductService.CreateColor:output_type -> product.CreatedResponse
+	111, // 220: product.ProductService.CreateSize:output_type -> product.CreatedResponse
+	108, // 221: product.ProductService.GetProductsByCategory:output_type -> product.ProductsPublicResponse
+	111, // 222: product.ProductService.CreateTag:output_type -> product.CreatedResponse
+	84,  // 223: product.ProductService.GetAllCategoriesAdmin:output_type -> product.BaseCategoriesResponse
+	101, // 224: product.ProductService.GetCategoryById:output_type -> product.CategoryAdminDetailsResponse
+	101, // 225: product.ProductService.UpdateCategory:output_type -> product.CategoryAdminDetailsResponse
+	98,  // 226: product.ProductService.GetAllColorsAdmin:output_type -> product.ColorsAdminResponse
+	96,  // 227: product.ProductService.GetAllSizesAdmin:output_type -> product.SizesAdminResponse
+	94,  // 228: product.ProductService.GetAllTagsAdmin:output_type -> product.TagsAdminResponse
+	92,  // 229: product.ProductService.UpdateTag:output_type -> product.UpdatedResponse
+	91,  // 230: product.ProductService.GetAllColors:output_type -> product.ColorsPublicResponse
+	90,  // 231: product.ProductService.GetAllSizes:output_type -> product.SizesPublicResponse
+	88,  // 232: product.ProductService.GetAllTags:output_type -> product.TagsPublicResponse
+	111, // 233: product.ProductService.CreateProduct:output_type -> product.CreatedResponse
+	84,  // 234: product.ProductService.GetCategoriesNoChild:output_type -> product.BaseCategoriesResponse
+	83,  // 235: product.ProductService.GetProductById:output_type -> product.ProductAdminDetailsResponse
+	79,  // 236: product.ProductService.GetAllProductsAdmin:output_type -> product.ProductsAdminResponse
+	83,  // 237: product.ProductService.UpdateProduct:output_type -> product.ProductAdminDetailsResponse
+	75,  // 238: product.ProductService.DeleteProduct:output_type -> product.DeletedResponse
+	75,  // 239: product.ProductService.DeleteProducts:output_type -> product.DeletedResponse
+	75,  // 240: product.ProductService.PermanentlyDeleteCategory:output_type -> product.DeletedResponse
+	75,  // 241: product.ProductService.PermanentlyDeleteCategories:output_type -> product.DeletedResponse
+	84,  // 242: product.ProductService.GetCategoriesNoProduct:output_type -> product.BaseCategoriesResponse
+	92,  // 243: product.ProductService.UpdateColor:output_type -> product.UpdatedResponse
+	92,  // 244: product.ProductService.UpdateSize:output_type -> product.UpdatedResponse
+	75,  // 245: product.ProductService.DeleteColor:output_type -> product.DeletedResponse
+	75,  // 246: product.ProductService.DeleteSize:output_type -> product.DeletedResponse
+	75,  // 247: product.ProductService.DeleteColors:output_type -> product.DeletedResponse
+	75,  // 248: product.ProductService.DeleteSizes:output_type -> product.DeletedResponse
+	79,  // 249: product.ProductService.GetDeletedProducts:output_type -> product.ProductsAdminResponse
+	83,  // 250: product.ProductService.GetDeletedProductById:output_type -> product.ProductAdminDetailsResponse
+	98,  // 251: product.ProductService.GetDeletedColors:output_type -> product.ColorsAdminResponse
+	96,  // 252: product.ProductService.GetDeletedSizes:output_type -> product.SizesAdminResponse
+	94,  // 253: product.ProductService.GetDeletedTags:output_type -> product.TagsAdminResponse
+	75,  // 254: product.ProductService.DeleteTag:output_type -> product.DeletedResponse
+	75,  // 255: product.ProductService.DeleteTags:output_type -> product.DeletedResponse
+	69,  // 256: product.ProductService.RestoreProduct:output_type -> product.RestoredResponse
+	69,  // 257: product.ProductService.RestoreProducts:output_type -> product.RestoredResponse
+	69,  // 258: product.ProductService.RestoreColor:output_type -> product.RestoredResponse
+	69,  // 259: product.ProductService.RestoreColors:output_type -> product.RestoredResponse
+	69,  // 260: product.ProductService.RestoreSize:output_type -> product.RestoredResponse
+	69,  // 261: product.ProductService.RestoreSizes:output_type -> product.RestoredResponse
+	69,  // 262: product.ProductService.RestoreTag:output_type -> product.RestoredResponse
+	69,  // 263: product.ProductService.RestoreTags:output_type -> product.RestoredResponse
+	75,  // 264: product.ProductService.PermanentlyDeleteProduct:output_type -> product.DeletedResponse
+	75,  // 265: product.ProductService.PermanentlyDeleteProducts:output_type -> product.DeletedResponse
+	75,  // 266: product.ProductService.PermanentlyDeleteColor:output_type -> product.DeletedResponse
+	75,  // 267: product.ProductService.PermanentlyDeleteColors:output_type -> product.DeletedResponse
+	75,  // 268: product.ProductService.PermanentlyDeleteSize:output_type -> product.DeletedResponse
+	75,  // 269: product.ProductService.PermanentlyDeleteSizes:output_type -> product.DeletedResponse
+	75,  // 270: product.ProductService.PermanentlyDeleteTag:output_type -> product.DeletedResponse
+	75,  // 271: product.ProductService.PermanentlyDeleteTags:output_type -> product.DeletedResponse
+	62,  // 272: product.ProductService.GetImagesByProductId:output_type -> product.ImagesResponse
+	92,  // 273: product.ProductService.UpdateBundleItems:output_type -> product.UpdatedResponse
+	60,  // 274: product.ProductService.GetBundleItems:output_type -> product.BundleItemsResponse
+	92,  // 275: product.ProductService.SellBundle:output_type -> product.UpdatedResponse
+	92,  // 276: product.ProductService.ReserveBundle:output_type -> product.UpdatedResponse
+	92,  // 277: product.ProductService.ReleaseBundle:output_type -> product.UpdatedResponse
+	92,  // 278: product.ProductService.AddProductRelations:output_type -> product.UpdatedResponse
+	75,  // 279: product.ProductService.RemoveProductRelations:output_type -> product.DeletedResponse
+	50,  // 280: product.ProductService.GetProductRelations:output_type -> product.ProductRelationsResponse
+	53,  // 281: product.ProductService.GetRelatedProducts:output_type -> product.RelatedProductsResponse
+	111, // 282: product.ProductService.CreateReview:output_type -> product.CreatedResponse
+	43,  // 283: product.ProductService.GetProductReviews:output_type -> product.ReviewsPublicResponse
+	45,  // 284: product.ProductService.GetAllReviewsAdmin:output_type -> product.ReviewsAdminResponse
+	92,  // 285: product.ProductService.ModerateReview:output_type -> product.UpdatedResponse
+	111, // 286: product.ProductService.CreateQuestion:output_type -> product.CreatedResponse
+	111, // 287: product.ProductService.CreateAnswer:output_type -> product.CreatedResponse
+	92,  // 288: product.ProductService.UpvoteQuestion:output_type -> product.UpdatedResponse
+	92,  // 289: product.ProductService.UpvoteAnswer:output_type -> product.UpdatedResponse
+	31,  // 290: product.ProductService.GetProductQuestions:output_type -> product.QuestionsPublicResponse
+	33,  // 291: product.ProductService.GetQuestionAnswers:output_type -> product.AnswersPublicResponse
+	35,  // 292: product.ProductService.GetAllQuestionsAdmin:output_type -> product.QuestionsAdminResponse
+	37,  // 293: product.ProductService.GetAllAnswersAdmin:output_type -> product.AnswersAdminResponse
+	92,  // 294: product.ProductService.ModerateQuestion:output_type -> product.UpdatedResponse
+	92,  // 295: product.ProductService.ModerateAnswer:output_type -> product.UpdatedResponse
+	111, // 296: product.ProductService.AddToWishlist:output_type -> product.CreatedResponse
+	75,  // 297: product.ProductService.RemoveFromWishlist:output_type -> product.DeletedResponse
+	21,  // 298: product.ProductService.GetWishlist:output_type -> product.WishlistResponse
+	75,  // 299: product.ProductService.DeleteCategory:output_type -> product.DeletedResponse
+	75,  // 300: product.ProductService.DeleteCategories:output_type -> product.DeletedResponse
+	16,  // 301: product.ProductService.GetDeletedCategories:output_type -> product.CategoriesAdminResponse
+	69,  // 302: product.ProductService.RestoreCategory:output_type -> product.RestoredResponse
+	69,  // 303: product.ProductService.RestoreCategories:output_type -> product.RestoredResponse
+	92,  // 304: product.ProductService.MoveCategory:output_type -> product.UpdatedResponse
+	92,  // 305: product.ProductService.ReorderCategoryChildren:output_type -> product.UpdatedResponse
+	13,  // 306: product.ProductService.GetCategoryBySlug:output_type -> product.CategoryPublicDetailsResponse
+	111, // 307: product.ProductService.DuplicateProduct:output_type -> product.CreatedResponse
+	6,   // 308: product.ProductService.GetProductRevisions:output_type -> product.ProductRevisionsResponse
+	9,   // 309: product.ProductService.DiffProductRevisions:output_type -> product.ProductRevisionDiffResponse
+	83,  // 310: product.ProductService.RollbackProductRevision:output_type -> product.ProductAdminDetailsResponse
+	3,   // 311: product.ProductService.GetAuditLogs:output_type -> product.AuditLogsResponse
+	216, // [216:312] is the sub-list for method output_type
+	120, // [120:216] is the sub-list for method input_type
+	120, // [120:120] is the sub-list for extension type_name
+	120, // [120:120] is the sub-list for extension extendee
+	0,   // [0:120] is the sub-list for field type_name
 }
 
 func init() { file_proto_product_proto_init() }
@@ -4760,24 +10724,43 @@ func file_proto_product_proto_init() {
 	if File_proto_product_proto != nil {
 		return
 	}
-	file_proto_product_proto_msgTypes[2].OneofWrappers = []any{}
-	file_proto_product_proto_msgTypes[3].OneofWrappers = []any{}
-	file_proto_product_proto_msgTypes[15].OneofWrappers = []any{}
-	file_proto_product_proto_msgTypes[16].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[0].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[8].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[11].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[13].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[14].OneofWrappers = []any{}
 	file_proto_product_proto_msgTypes[17].OneofWrappers = []any{}
-	file_proto_product_proto_msgTypes[22].OneofWrappers = []any{}
-	file_proto_product_proto_msgTypes[24].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[20].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[32].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[34].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[36].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[39].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[40].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[41].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[42].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[44].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[51].OneofWrappers = []any{}
 	file_proto_product_proto_msgTypes[52].OneofWrappers = []any{}
-	file_proto_product_proto_msgTypes[53].OneofWrappers = []any{}
-	file_proto_product_proto_msgTypes[56].OneofWrappers = []any{}
-	file_proto_product_proto_msgTypes[58].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[60].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[63].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[64].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[76].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[77].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[78].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[83].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[85].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[100].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[113].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[115].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[118].OneofWrappers = []any{}
+	file_proto_product_proto_msgTypes[120].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_product_proto_rawDesc), len(file_proto_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   62,
+			NumMessages:   129,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
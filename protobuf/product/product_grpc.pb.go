@@ -76,6 +76,45 @@ const (
 	ProductService_PermanentlyDeleteTag_FullMethodName        = "/product.ProductService/PermanentlyDeleteTag"
 	ProductService_PermanentlyDeleteTags_FullMethodName       = "/product.ProductService/PermanentlyDeleteTags"
 	ProductService_GetImagesByProductId_FullMethodName        = "/product.ProductService/GetImagesByProductId"
+	ProductService_UpdateBundleItems_FullMethodName           = "/product.ProductService/UpdateBundleItems"
+	ProductService_GetBundleItems_FullMethodName              = "/product.ProductService/GetBundleItems"
+	ProductService_SellBundle_FullMethodName                  = "/product.ProductService/SellBundle"
+	ProductService_ReserveBundle_FullMethodName               = "/product.ProductService/ReserveBundle"
+	ProductService_ReleaseBundle_FullMethodName               = "/product.ProductService/ReleaseBundle"
+	ProductService_AddProductRelations_FullMethodName         = "/product.ProductService/AddProductRelations"
+	ProductService_RemoveProductRelations_FullMethodName      = "/product.ProductService/RemoveProductRelations"
+	ProductService_GetProductRelations_FullMethodName         = "/product.ProductService/GetProductRelations"
+	ProductService_GetRelatedProducts_FullMethodName          = "/product.ProductService/GetRelatedProducts"
+	ProductService_CreateReview_FullMethodName                = "/product.ProductService/CreateReview"
+	ProductService_GetProductReviews_FullMethodName           = "/product.ProductService/GetProductReviews"
+	ProductService_GetAllReviewsAdmin_FullMethodName          = "/product.ProductService/GetAllReviewsAdmin"
+	ProductService_ModerateReview_FullMethodName              = "/product.ProductService/ModerateReview"
+	ProductService_CreateQuestion_FullMethodName              = "/product.ProductService/CreateQuestion"
+	ProductService_CreateAnswer_FullMethodName                = "/product.ProductService/CreateAnswer"
+	ProductService_UpvoteQuestion_FullMethodName              = "/product.ProductService/UpvoteQuestion"
+	ProductService_UpvoteAnswer_FullMethodName                = "/product.ProductService/UpvoteAnswer"
+	ProductService_GetProductQuestions_FullMethodName         = "/product.ProductService/GetProductQuestions"
+	ProductService_GetQuestionAnswers_FullMethodName          = "/product.ProductService/GetQuestionAnswers"
+	ProductService_GetAllQuestionsAdmin_FullMethodName        = "/product.ProductService/GetAllQuestionsAdmin"
+	ProductService_GetAllAnswersAdmin_FullMethodName          = "/product.ProductService/GetAllAnswersAdmin"
+	ProductService_ModerateQuestion_FullMethodName            = "/product.ProductService/ModerateQuestion"
+	ProductService_ModerateAnswer_FullMethodName              = "/product.ProductService/ModerateAnswer"
+	ProductService_AddToWishlist_FullMethodName               = "/product.ProductService/AddToWishlist"
+	ProductService_RemoveFromWishlist_FullMethodName          = "/product.ProductService/RemoveFromWishlist"
+	ProductService_GetWishlist_FullMethodName                 = "/product.ProductService/GetWishlist"
+	ProductService_DeleteCategory_FullMethodName              = "/product.ProductService/DeleteCategory"
+	ProductService_DeleteCategories_FullMethodName            = "/product.ProductService/DeleteCategories"
+	ProductService_GetDeletedCategories_FullMethodName        = "/product.ProductService/GetDeletedCategories"
+	ProductService_RestoreCategory_FullMethodName             = "/product.ProductService/RestoreCategory"
+	ProductService_RestoreCategories_FullMethodName           = "/product.ProductService/RestoreCategories"
+	ProductService_MoveCategory_FullMethodName                = "/product.ProductService/MoveCategory"
+	ProductService_ReorderCategoryChildren_FullMethodName     = "/product.ProductService/ReorderCategoryChildren"
+	ProductService_GetCategoryBySlug_FullMethodName           = "/product.ProductService/GetCategoryBySlug"
+	ProductService_DuplicateProduct_FullMethodName            = "/product.ProductService/DuplicateProduct"
+	ProductService_GetProductRevisions_FullMethodName         = "/product.ProductService/GetProductRevisions"
+	ProductService_DiffProductRevisions_FullMethodName        = "/product.ProductService/DiffProductRevisions"
+	ProductService_RollbackProductRevision_FullMethodName     = "/product.ProductService/RollbackProductRevision"
+	ProductService_GetAuditLogs_FullMethodName                = "/product.ProductService/GetAuditLogs"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -139,6 +178,45 @@ type ProductServiceClient interface {
 	PermanentlyDeleteTag(ctx context.Context, in *PermanentlyDeleteOneRequest, opts ...grpc.CallOption) (*DeletedResponse, error)
 	PermanentlyDeleteTags(ctx context.Context, in *PermanentlyDeleteManyRequest, opts ...grpc.CallOption) (*DeletedResponse, error)
 	GetImagesByProductId(ctx context.Context, in *GetByProductId, opts ...grpc.CallOption) (*ImagesResponse, error)
+	UpdateBundleItems(ctx context.Context, in *UpdateBundleItemsRequest, opts ...grpc.CallOption) (*UpdatedResponse, error)
+	GetBundleItems(ctx context.Context, in *GetByProductId, opts ...grpc.CallOption) (*BundleItemsResponse, error)
+	SellBundle(ctx context.Context, in *SellBundleRequest, opts ...grpc.CallOption) (*UpdatedResponse, error)
+	ReserveBundle(ctx context.Context, in *ReserveBundleRequest, opts ...grpc.CallOption) (*UpdatedResponse, error)
+	ReleaseBundle(ctx context.Context, in *ReleaseBundleRequest, opts ...grpc.CallOption) (*UpdatedResponse, error)
+	AddProductRelations(ctx context.Context, in *AddProductRelationsRequest, opts ...grpc.CallOption) (*UpdatedResponse, error)
+	RemoveProductRelations(ctx context.Context, in *RemoveProductRelationsRequest, opts ...grpc.CallOption) (*DeletedResponse, error)
+	GetProductRelations(ctx context.Context, in *GetByProductId, opts ...grpc.CallOption) (*ProductRelationsResponse, error)
+	GetRelatedProducts(ctx context.Context, in *GetRelatedProductsRequest, opts ...grpc.CallOption) (*RelatedProductsResponse, error)
+	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*CreatedResponse, error)
+	GetProductReviews(ctx context.Context, in *GetProductReviewsRequest, opts ...grpc.CallOption) (*ReviewsPublicResponse, error)
+	GetAllReviewsAdmin(ctx context.Context, in *GetAllReviewsAdminRequest, opts ...grpc.CallOption) (*ReviewsAdminResponse, error)
+	ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*UpdatedResponse, error)
+	CreateQuestion(ctx context.Context, in *CreateQuestionRequest, opts ...grpc.CallOption) (*CreatedResponse, error)
+	CreateAnswer(ctx context.Context, in *CreateAnswerRequest, opts ...grpc.CallOption) (*CreatedResponse, error)
+	UpvoteQuestion(ctx context.Context, in *UpvoteRequest, opts ...grpc.CallOption) (*UpdatedResponse, error)
+	UpvoteAnswer(ctx context.Context, in *UpvoteRequest, opts ...grpc.CallOption) (*UpdatedResponse, error)
+	GetProductQuestions(ctx context.Context, in *GetProductQuestionsRequest, opts ...grpc.CallOption) (*QuestionsPublicResponse, error)
+	GetQuestionAnswers(ctx context.Context, in *GetQuestionAnswersRequest, opts ...grpc.CallOption) (*AnswersPublicResponse, error)
+	GetAllQuestionsAdmin(ctx context.Context, in *GetAllQuestionsAdminRequest, opts ...grpc.CallOption) (*QuestionsAdminResponse, error)
+	GetAllAnswersAdmin(ctx context.Context, in *GetAllAnswersAdminRequest, opts ...grpc.CallOption) (*AnswersAdminResponse, error)
+	ModerateQuestion(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*UpdatedResponse, error)
+	ModerateAnswer(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*UpdatedResponse, error)
+	AddToWishlist(ctx context.Context, in *AddToWishlistRequest, opts ...grpc.CallOption) (*CreatedResponse, error)
+	RemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest, opts ...grpc.CallOption) (*DeletedResponse, error)
+	GetWishlist(ctx context.Context, in *GetWishlistRequest, opts ...grpc.CallOption) (*WishlistResponse, error)
+	DeleteCategory(ctx context.Context, in *DeleteOneRequest, opts ...grpc.CallOption) (*DeletedResponse, error)
+	DeleteCategories(ctx context.Context, in *DeleteManyRequest, opts ...grpc.CallOption) (*DeletedResponse, error)
+	GetDeletedCategories(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*CategoriesAdminResponse, error)
+	RestoreCategory(ctx context.Context, in *RestoreOneRequest, opts ...grpc.CallOption) (*RestoredResponse, error)
+	RestoreCategories(ctx context.Context, in *RestoreManyRequest, opts ...grpc.CallOption) (*RestoredResponse, error)
+	MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*UpdatedResponse, error)
+	ReorderCategoryChildren(ctx context.Context, in *ReorderCategoryChildrenRequest, opts ...grpc.CallOption) (*UpdatedResponse, error)
+	GetCategoryBySlug(ctx context.Context, in *GetCategoryBySlugRequest, opts ...grpc.CallOption) (*CategoryPublicDetailsResponse, error)
+	DuplicateProduct(ctx context.Context, in *DuplicateProductRequest, opts ...grpc.CallOption) (*CreatedResponse, error)
+	GetProductRevisions(ctx context.Context, in *GetProductRevisionsRequest, opts ...grpc.CallOption) (*ProductRevisionsResponse, error)
+	DiffProductRevisions(ctx context.Context, in *DiffProductRevisionsRequest, opts ...grpc.CallOption) (*ProductRevisionDiffResponse, error)
+	RollbackProductRevision(ctx context.Context, in *RollbackProductRevisionRequest, opts ...grpc.CallOption) (*ProductAdminDetailsResponse, error)
+	GetAuditLogs(ctx context.Context, in *GetAuditLogsRequest, opts ...grpc.CallOption) (*AuditLogsResponse, error)
 }
 
 type productServiceClient struct {
@@ -719,223 +797,652 @@ func (c *productServiceClient) GetImagesByProductId(ctx context.Context, in *Get
 	return out, nil
 }
 
-// ProductServiceServer is the server API for ProductService service.
-// All implementations must embed UnimplementedProductServiceServer
-// for forward compatibility.
-type ProductServiceServer interface {
-	CreateCategory(context.Context, *CreateCategoryRequest) (*CreatedResponse, error)
-	GetCategoryTree(context.Context, *GetAllRequest) (*CategoryTreeResponse, error)
-	GetProductBySlug(context.Context, *GetProductBySlugRequest) (*ProductPublicResponse, error)
-	CreateColor(context.Context, *CreateColorRequest) (*CreatedResponse, error)
-	CreateSize(context.Context, *CreateSizeRequest) (*CreatedResponse, error)
-	GetProductsByCategory(context.Context, *GetProductsByCategoryRequest) (*ProductsPublicResponse, error)
-	CreateTag(context.Context, *CreateTagRequest) (*CreatedResponse, error)
-	GetAllCategoriesAdmin(context.Context, *GetAllRequest) (*BaseCategoriesResponse, error)
-	GetCategoryById(context.Context, *GetOneRequest) (*CategoryAdminDetailsResponse, error)
-	UpdateCategory(context.Context, *UpdateCategoryRequest) (*CategoryAdminDetailsResponse, error)
-	GetAllColorsAdmin(context.Context, *GetAllRequest) (*ColorsAdminResponse, error)
-	GetAllSizesAdmin(context.Context, *GetAllRequest) (*SizesAdminResponse, error)
-	GetAllTagsAdmin(context.Context, *GetAllRequest) (*TagsAdminResponse, error)
-	UpdateTag(context.Context, *UpdateTagRequest) (*UpdatedResponse, error)
-	GetAllColors(context.Context, *GetAllRequest) (*ColorsPublicResponse, error)
-	GetAllSizes(context.Context, *GetAllRequest) (*SizesPublicResponse, error)
-	GetAllTags(context.Context, *GetAllRequest) (*TagsPublicResponse, error)
-	CreateProduct(context.Context, *CreateProductRequest) (*CreatedResponse, error)
-	GetCategoriesNoChild(context.Context, *GetAllRequest) (*BaseCategoriesResponse, error)
-	GetProductById(context.Context, *GetOneRequest) (*ProductAdminDetailsResponse, error)
-	GetAllProductsAdmin(context.Context, *GetAllProductsAdminRequest) (*ProductsAdminResponse, error)
-	UpdateProduct(context.Context, *UpdateProductRequest) (*ProductAdminDetailsResponse, error)
-	DeleteProduct(context.Context, *DeleteOneRequest) (*DeletedResponse, error)
-	DeleteProducts(context.Context, *DeleteManyRequest) (*DeletedResponse, error)
-	PermanentlyDeleteCategory(context.Context, *PermanentlyDeleteOneRequest) (*DeletedResponse, error)
-	PermanentlyDeleteCategories(context.Context, *PermanentlyDeleteManyRequest) (*DeletedResponse, error)
-	GetCategoriesNoProduct(context.Context, *GetAllRequest) (*BaseCategoriesResponse, error)
-	UpdateColor(context.Context, *UpdateColorRequest) (*UpdatedResponse, error)
-	UpdateSize(context.Context, *UpdateSizeRequest) (*UpdatedResponse, error)
-	DeleteColor(context.Context, *DeleteOneRequest) (*DeletedResponse, error)
-	DeleteSize(context.Context, *DeleteOneRequest) (*DeletedResponse, error)
-	DeleteColors(context.Context, *DeleteManyRequest) (*DeletedResponse, error)
-	DeleteSizes(context.Context, *DeleteManyRequest) (*DeletedResponse, error)
-	GetDeletedProducts(context.Context, *GetAllProductsAdminRequest) (*ProductsAdminResponse, error)
-	GetDeletedProductById(context.Context, *GetOneRequest) (*ProductAdminDetailsResponse, error)
-	GetDeletedColors(context.Context, *GetAllRequest) (*ColorsAdminResponse, error)
-	GetDeletedSizes(context.Context, *GetAllRequest) (*SizesAdminResponse, error)
-	GetDeletedTags(context.Context, *GetAllRequest) (*TagsAdminResponse, error)
-	DeleteTag(context.Context, *DeleteOneRequest) (*DeletedResponse, error)
-	DeleteTags(context.Context, *DeleteManyRequest) (*DeletedResponse, error)
-	RestoreProduct(context.Context, *RestoreOneRequest) (*RestoredResponse, error)
-	RestoreProducts(context.Context, *RestoreManyRequest) (*RestoredResponse, error)
-	RestoreColor(context.Context, *RestoreOneRequest) (*RestoredResponse, error)
-	RestoreColors(context.Context, *RestoreManyRequest) (*RestoredResponse, error)
-	RestoreSize(context.Context, *RestoreOneRequest) (*RestoredResponse, error)
-	RestoreSizes(context.Context, *RestoreManyRequest) (*RestoredResponse, error)
-	RestoreTag(context.Context, *RestoreOneRequest) (*RestoredResponse, error)
-	RestoreTags(context.Context, *RestoreManyRequest) (*RestoredResponse, error)
-	PermanentlyDeleteProduct(context.Context, *PermanentlyDeleteOneRequest) (*DeletedResponse, error)
-	PermanentlyDeleteProducts(context.Context, *PermanentlyDeleteManyRequest) (*DeletedResponse, error)
-	PermanentlyDeleteColor(context.Context, *PermanentlyDeleteOneRequest) (*DeletedResponse, error)
-	PermanentlyDeleteColors(context.Context, *PermanentlyDeleteManyRequest) (*DeletedResponse, error)
-	PermanentlyDeleteSize(context.Context, *PermanentlyDeleteOneRequest) (*DeletedResponse, error)
-	PermanentlyDeleteSizes(context.Context, *PermanentlyDeleteManyRequest) (*DeletedResponse, error)
-	PermanentlyDeleteTag(context.Context, *PermanentlyDeleteOneRequest) (*DeletedResponse, error)
-	PermanentlyDeleteTags(context.Context, *PermanentlyDeleteManyRequest) (*DeletedResponse, error)
-	GetImagesByProductId(context.Context, *GetByProductId) (*ImagesResponse, error)
-	mustEmbedUnimplementedProductServiceServer()
+func (c *productServiceClient) UpdateBundleItems(ctx context.Context, in *UpdateBundleItemsRequest, opts ...grpc.CallOption) (*UpdatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatedResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateBundleItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedProductServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedProductServiceServer struct{}
-
-func (UnimplementedProductServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreatedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+func (c *productServiceClient) GetBundleItems(ctx context.Context, in *GetByProductId, opts ...grpc.CallOption) (*BundleItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BundleItemsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetBundleItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetCategoryTree(context.Context, *GetAllRequest) (*CategoryTreeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryTree not implemented")
+
+func (c *productServiceClient) SellBundle(ctx context.Context, in *SellBundleRequest, opts ...grpc.CallOption) (*UpdatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatedResponse)
+	err := c.cc.Invoke(ctx, ProductService_SellBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetProductBySlug(context.Context, *GetProductBySlugRequest) (*ProductPublicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProductBySlug not implemented")
+
+func (c *productServiceClient) ReserveBundle(ctx context.Context, in *ReserveBundleRequest, opts ...grpc.CallOption) (*UpdatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatedResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReserveBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) CreateColor(context.Context, *CreateColorRequest) (*CreatedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateColor not implemented")
+
+func (c *productServiceClient) ReleaseBundle(ctx context.Context, in *ReleaseBundleRequest, opts ...grpc.CallOption) (*UpdatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatedResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReleaseBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) CreateSize(context.Context, *CreateSizeRequest) (*CreatedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSize not implemented")
+
+func (c *productServiceClient) AddProductRelations(ctx context.Context, in *AddProductRelationsRequest, opts ...grpc.CallOption) (*UpdatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatedResponse)
+	err := c.cc.Invoke(ctx, ProductService_AddProductRelations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetProductsByCategory(context.Context, *GetProductsByCategoryRequest) (*ProductsPublicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProductsByCategory not implemented")
+
+func (c *productServiceClient) RemoveProductRelations(ctx context.Context, in *RemoveProductRelationsRequest, opts ...grpc.CallOption) (*DeletedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletedResponse)
+	err := c.cc.Invoke(ctx, ProductService_RemoveProductRelations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) CreateTag(context.Context, *CreateTagRequest) (*CreatedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+
+func (c *productServiceClient) GetProductRelations(ctx context.Context, in *GetByProductId, opts ...grpc.CallOption) (*ProductRelationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductRelationsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductRelations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetAllCategoriesAdmin(context.Context, *GetAllRequest) (*BaseCategoriesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllCategoriesAdmin not implemented")
+
+func (c *productServiceClient) GetRelatedProducts(ctx context.Context, in *GetRelatedProductsRequest, opts ...grpc.CallOption) (*RelatedProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RelatedProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetRelatedProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetCategoryById(context.Context, *GetOneRequest) (*CategoryAdminDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryById not implemented")
+
+func (c *productServiceClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*CreatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatedResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*CategoryAdminDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+
+func (c *productServiceClient) GetProductReviews(ctx context.Context, in *GetProductReviewsRequest, opts ...grpc.CallOption) (*ReviewsPublicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewsPublicResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetAllColorsAdmin(context.Context, *GetAllRequest) (*ColorsAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllColorsAdmin not implemented")
+
+func (c *productServiceClient) GetAllReviewsAdmin(ctx context.Context, in *GetAllReviewsAdminRequest, opts ...grpc.CallOption) (*ReviewsAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewsAdminResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetAllReviewsAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetAllSizesAdmin(context.Context, *GetAllRequest) (*SizesAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllSizesAdmin not implemented")
+
+func (c *productServiceClient) ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*UpdatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatedResponse)
+	err := c.cc.Invoke(ctx, ProductService_ModerateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetAllTagsAdmin(context.Context, *GetAllRequest) (*TagsAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllTagsAdmin not implemented")
+
+func (c *productServiceClient) CreateQuestion(ctx context.Context, in *CreateQuestionRequest, opts ...grpc.CallOption) (*CreatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatedResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) UpdateTag(context.Context, *UpdateTagRequest) (*UpdatedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
+
+func (c *productServiceClient) CreateAnswer(ctx context.Context, in *CreateAnswerRequest, opts ...grpc.CallOption) (*CreatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatedResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetAllColors(context.Context, *GetAllRequest) (*ColorsPublicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllColors not implemented")
+
+func (c *productServiceClient) UpvoteQuestion(ctx context.Context, in *UpvoteRequest, opts ...grpc.CallOption) (*UpdatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatedResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpvoteQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetAllSizes(context.Context, *GetAllRequest) (*SizesPublicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllSizes not implemented")
+
+func (c *productServiceClient) UpvoteAnswer(ctx context.Context, in *UpvoteRequest, opts ...grpc.CallOption) (*UpdatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatedResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpvoteAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetAllTags(context.Context, *GetAllRequest) (*TagsPublicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllTags not implemented")
+
+func (c *productServiceClient) GetProductQuestions(ctx context.Context, in *GetProductQuestionsRequest, opts ...grpc.CallOption) (*QuestionsPublicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuestionsPublicResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductQuestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*CreatedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+
+func (c *productServiceClient) GetQuestionAnswers(ctx context.Context, in *GetQuestionAnswersRequest, opts ...grpc.CallOption) (*AnswersPublicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnswersPublicResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetQuestionAnswers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetCategoriesNoChild(context.Context, *GetAllRequest) (*BaseCategoriesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCategoriesNoChild not implemented")
+
+func (c *productServiceClient) GetAllQuestionsAdmin(ctx context.Context, in *GetAllQuestionsAdminRequest, opts ...grpc.CallOption) (*QuestionsAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuestionsAdminResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetAllQuestionsAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetProductById(context.Context, *GetOneRequest) (*ProductAdminDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProductById not implemented")
+
+func (c *productServiceClient) GetAllAnswersAdmin(ctx context.Context, in *GetAllAnswersAdminRequest, opts ...grpc.CallOption) (*AnswersAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnswersAdminResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetAllAnswersAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetAllProductsAdmin(context.Context, *GetAllProductsAdminRequest) (*ProductsAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllProductsAdmin not implemented")
+
+func (c *productServiceClient) ModerateQuestion(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*UpdatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatedResponse)
+	err := c.cc.Invoke(ctx, ProductService_ModerateQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*ProductAdminDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+
+func (c *productServiceClient) ModerateAnswer(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*UpdatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatedResponse)
+	err := c.cc.Invoke(ctx, ProductService_ModerateAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) DeleteProduct(context.Context, *DeleteOneRequest) (*DeletedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+
+func (c *productServiceClient) AddToWishlist(ctx context.Context, in *AddToWishlistRequest, opts ...grpc.CallOption) (*CreatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatedResponse)
+	err := c.cc.Invoke(ctx, ProductService_AddToWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) DeleteProducts(context.Context, *DeleteManyRequest) (*DeletedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteProducts not implemented")
+
+func (c *productServiceClient) RemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest, opts ...grpc.CallOption) (*DeletedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletedResponse)
+	err := c.cc.Invoke(ctx, ProductService_RemoveFromWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) PermanentlyDeleteCategory(context.Context, *PermanentlyDeleteOneRequest) (*DeletedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PermanentlyDeleteCategory not implemented")
+
+func (c *productServiceClient) GetWishlist(ctx context.Context, in *GetWishlistRequest, opts ...grpc.CallOption) (*WishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WishlistResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) PermanentlyDeleteCategories(context.Context, *PermanentlyDeleteManyRequest) (*DeletedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PermanentlyDeleteCategories not implemented")
+
+func (c *productServiceClient) DeleteCategory(ctx context.Context, in *DeleteOneRequest, opts ...grpc.CallOption) (*DeletedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletedResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetCategoriesNoProduct(context.Context, *GetAllRequest) (*BaseCategoriesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCategoriesNoProduct not implemented")
+
+func (c *productServiceClient) DeleteCategories(ctx context.Context, in *DeleteManyRequest, opts ...grpc.CallOption) (*DeletedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletedResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) UpdateColor(context.Context, *UpdateColorRequest) (*UpdatedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateColor not implemented")
+
+func (c *productServiceClient) GetDeletedCategories(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*CategoriesAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoriesAdminResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetDeletedCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) UpdateSize(context.Context, *UpdateSizeRequest) (*UpdatedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSize not implemented")
+
+func (c *productServiceClient) RestoreCategory(ctx context.Context, in *RestoreOneRequest, opts ...grpc.CallOption) (*RestoredResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoredResponse)
+	err := c.cc.Invoke(ctx, ProductService_RestoreCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) DeleteColor(context.Context, *DeleteOneRequest) (*DeletedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteColor not implemented")
+
+func (c *productServiceClient) RestoreCategories(ctx context.Context, in *RestoreManyRequest, opts ...grpc.CallOption) (*RestoredResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoredResponse)
+	err := c.cc.Invoke(ctx, ProductService_RestoreCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) DeleteSize(context.Context, *DeleteOneRequest) (*DeletedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSize not implemented")
+
+func (c *productServiceClient) MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*UpdatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatedResponse)
+	err := c.cc.Invoke(ctx, ProductService_MoveCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) DeleteColors(context.Context, *DeleteManyRequest) (*DeletedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteColors not implemented")
+
+func (c *productServiceClient) ReorderCategoryChildren(ctx context.Context, in *ReorderCategoryChildrenRequest, opts ...grpc.CallOption) (*UpdatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatedResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReorderCategoryChildren_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) DeleteSizes(context.Context, *DeleteManyRequest) (*DeletedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSizes not implemented")
+
+func (c *productServiceClient) GetCategoryBySlug(ctx context.Context, in *GetCategoryBySlugRequest, opts ...grpc.CallOption) (*CategoryPublicDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryPublicDetailsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetCategoryBySlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetDeletedProducts(context.Context, *GetAllProductsAdminRequest) (*ProductsAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedProducts not implemented")
+
+func (c *productServiceClient) DuplicateProduct(ctx context.Context, in *DuplicateProductRequest, opts ...grpc.CallOption) (*CreatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatedResponse)
+	err := c.cc.Invoke(ctx, ProductService_DuplicateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetDeletedProductById(context.Context, *GetOneRequest) (*ProductAdminDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedProductById not implemented")
+
+func (c *productServiceClient) GetProductRevisions(ctx context.Context, in *GetProductRevisionsRequest, opts ...grpc.CallOption) (*ProductRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductRevisionsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetDeletedColors(context.Context, *GetAllRequest) (*ColorsAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedColors not implemented")
+
+func (c *productServiceClient) DiffProductRevisions(ctx context.Context, in *DiffProductRevisionsRequest, opts ...grpc.CallOption) (*ProductRevisionDiffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductRevisionDiffResponse)
+	err := c.cc.Invoke(ctx, ProductService_DiffProductRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetDeletedSizes(context.Context, *GetAllRequest) (*SizesAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedSizes not implemented")
+
+func (c *productServiceClient) RollbackProductRevision(ctx context.Context, in *RollbackProductRevisionRequest, opts ...grpc.CallOption) (*ProductAdminDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductAdminDetailsResponse)
+	err := c.cc.Invoke(ctx, ProductService_RollbackProductRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetDeletedTags(context.Context, *GetAllRequest) (*TagsAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedTags not implemented")
+
+func (c *productServiceClient) GetAuditLogs(ctx context.Context, in *GetAuditLogsRequest, opts ...grpc.CallOption) (*AuditLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLogsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetAuditLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) DeleteTag(context.Context, *DeleteOneRequest) (*DeletedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+
+// ProductServiceServer is the server API for ProductService service.
+// All implementations must embed UnimplementedProductServiceServer
+// for forward compatibility.
+type ProductServiceServer interface {
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CreatedResponse, error)
+	GetCategoryTree(context.Context, *GetAllRequest) (*CategoryTreeResponse, error)
+	GetProductBySlug(context.Context, *GetProductBySlugRequest) (*ProductPublicResponse, error)
+	CreateColor(context.Context, *CreateColorRequest) (*CreatedResponse, error)
+	CreateSize(context.Context, *CreateSizeRequest) (*CreatedResponse, error)
+	GetProductsByCategory(context.Context, *GetProductsByCategoryRequest) (*ProductsPublicResponse, error)
+	CreateTag(context.Context, *CreateTagRequest) (*CreatedResponse, error)
+	GetAllCategoriesAdmin(context.Context, *GetAllRequest) (*BaseCategoriesResponse, error)
+	GetCategoryById(context.Context, *GetOneRequest) (*CategoryAdminDetailsResponse, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*CategoryAdminDetailsResponse, error)
+	GetAllColorsAdmin(context.Context, *GetAllRequest) (*ColorsAdminResponse, error)
+	GetAllSizesAdmin(context.Context, *GetAllRequest) (*SizesAdminResponse, error)
+	GetAllTagsAdmin(context.Context, *GetAllRequest) (*TagsAdminResponse, error)
+	UpdateTag(context.Context, *UpdateTagRequest) (*UpdatedResponse, error)
+	GetAllColors(context.Context, *GetAllRequest) (*ColorsPublicResponse, error)
+	GetAllSizes(context.Context, *GetAllRequest) (*SizesPublicResponse, error)
+	GetAllTags(context.Context, *GetAllRequest) (*TagsPublicResponse, error)
+	CreateProduct(context.Context, *CreateProductRequest) (*CreatedResponse, error)
+	GetCategoriesNoChild(context.Context, *GetAllRequest) (*BaseCategoriesResponse, error)
+	GetProductById(context.Context, *GetOneRequest) (*ProductAdminDetailsResponse, error)
+	GetAllProductsAdmin(context.Context, *GetAllProductsAdminRequest) (*ProductsAdminResponse, error)
+	UpdateProduct(context.Context, *UpdateProductRequest) (*ProductAdminDetailsResponse, error)
+	DeleteProduct(context.Context, *DeleteOneRequest) (*DeletedResponse, error)
+	DeleteProducts(context.Context, *DeleteManyRequest) (*DeletedResponse, error)
+	PermanentlyDeleteCategory(context.Context, *PermanentlyDeleteOneRequest) (*DeletedResponse, error)
+	PermanentlyDeleteCategories(context.Context, *PermanentlyDeleteManyRequest) (*DeletedResponse, error)
+	GetCategoriesNoProduct(context.Context, *GetAllRequest) (*BaseCategoriesResponse, error)
+	UpdateColor(context.Context, *UpdateColorRequest) (*UpdatedResponse, error)
+	UpdateSize(context.Context, *UpdateSizeRequest) (*UpdatedResponse, error)
+	DeleteColor(context.Context, *DeleteOneRequest) (*DeletedResponse, error)
+	DeleteSize(context.Context, *DeleteOneRequest) (*DeletedResponse, error)
+	DeleteColors(context.Context, *DeleteManyRequest) (*DeletedResponse, error)
+	DeleteSizes(context.Context, *DeleteManyRequest) (*DeletedResponse, error)
+	GetDeletedProducts(context.Context, *GetAllProductsAdminRequest) (*ProductsAdminResponse, error)
+	GetDeletedProductById(context.Context, *GetOneRequest) (*ProductAdminDetailsResponse, error)
+	GetDeletedColors(context.Context, *GetAllRequest) (*ColorsAdminResponse, error)
+	GetDeletedSizes(context.Context, *GetAllRequest) (*SizesAdminResponse, error)
+	GetDeletedTags(context.Context, *GetAllRequest) (*TagsAdminResponse, error)
+	DeleteTag(context.Context, *DeleteOneRequest) (*DeletedResponse, error)
+	DeleteTags(context.Context, *DeleteManyRequest) (*DeletedResponse, error)
+	RestoreProduct(context.Context, *RestoreOneRequest) (*RestoredResponse, error)
+	RestoreProducts(context.Context, *RestoreManyRequest) (*RestoredResponse, error)
+	RestoreColor(context.Context, *RestoreOneRequest) (*RestoredResponse, error)
+	RestoreColors(context.Context, *RestoreManyRequest) (*RestoredResponse, error)
+	RestoreSize(context.Context, *RestoreOneRequest) (*RestoredResponse, error)
+	RestoreSizes(context.Context, *RestoreManyRequest) (*RestoredResponse, error)
+	RestoreTag(context.Context, *RestoreOneRequest) (*RestoredResponse, error)
+	RestoreTags(context.Context, *RestoreManyRequest) (*RestoredResponse, error)
+	PermanentlyDeleteProduct(context.Context, *PermanentlyDeleteOneRequest) (*DeletedResponse, error)
+	PermanentlyDeleteProducts(context.Context, *PermanentlyDeleteManyRequest) (*DeletedResponse, error)
+	PermanentlyDeleteColor(context.Context, *PermanentlyDeleteOneRequest) (*DeletedResponse, error)
+	PermanentlyDeleteColors(context.Context, *PermanentlyDeleteManyRequest) (*DeletedResponse, error)
+	PermanentlyDeleteSize(context.Context, *PermanentlyDeleteOneRequest) (*DeletedResponse, error)
+	PermanentlyDeleteSizes(context.Context, *PermanentlyDeleteManyRequest) (*DeletedResponse, error)
+	PermanentlyDeleteTag(context.Context, *PermanentlyDeleteOneRequest) (*DeletedResponse, error)
+	PermanentlyDeleteTags(context.Context, *PermanentlyDeleteManyRequest) (*DeletedResponse, error)
+	GetImagesByProductId(context.Context, *GetByProductId) (*ImagesResponse, error)
+	UpdateBundleItems(context.Context, *UpdateBundleItemsRequest) (*UpdatedResponse, error)
+	GetBundleItems(context.Context, *GetByProductId) (*BundleItemsResponse, error)
+	SellBundle(context.Context, *SellBundleRequest) (*UpdatedResponse, error)
+	ReserveBundle(context.Context, *ReserveBundleRequest) (*UpdatedResponse, error)
+	ReleaseBundle(context.Context, *ReleaseBundleRequest) (*UpdatedResponse, error)
+	AddProductRelations(context.Context, *AddProductRelationsRequest) (*UpdatedResponse, error)
+	RemoveProductRelations(context.Context, *RemoveProductRelationsRequest) (*DeletedResponse, error)
+	GetProductRelations(context.Context, *GetByProductId) (*ProductRelationsResponse, error)
+	GetRelatedProducts(context.Context, *GetRelatedProductsRequest) (*RelatedProductsResponse, error)
+	CreateReview(context.Context, *CreateReviewRequest) (*CreatedResponse, error)
+	GetProductReviews(context.Context, *GetProductReviewsRequest) (*ReviewsPublicResponse, error)
+	GetAllReviewsAdmin(context.Context, *GetAllReviewsAdminRequest) (*ReviewsAdminResponse, error)
+	ModerateReview(context.Context, *ModerateReviewRequest) (*UpdatedResponse, error)
+	CreateQuestion(context.Context, *CreateQuestionRequest) (*CreatedResponse, error)
+	CreateAnswer(context.Context, *CreateAnswerRequest) (*CreatedResponse, error)
+	UpvoteQuestion(context.Context, *UpvoteRequest) (*UpdatedResponse, error)
+	UpvoteAnswer(context.Context, *UpvoteRequest) (*UpdatedResponse, error)
+	GetProductQuestions(context.Context, *GetProductQuestionsRequest) (*QuestionsPublicResponse, error)
+	GetQuestionAnswers(context.Context, *GetQuestionAnswersRequest) (*AnswersPublicResponse, error)
+	GetAllQuestionsAdmin(context.Context, *GetAllQuestionsAdminRequest) (*QuestionsAdminResponse, error)
+	GetAllAnswersAdmin(context.Context, *GetAllAnswersAdminRequest) (*AnswersAdminResponse, error)
+	ModerateQuestion(context.Context, *ModerateRequest) (*UpdatedResponse, error)
+	ModerateAnswer(context.Context, *ModerateRequest) (*UpdatedResponse, error)
+	AddToWishlist(context.Context, *AddToWishlistRequest) (*CreatedResponse, error)
+	RemoveFromWishlist(context.Context, *RemoveFromWishlistRequest) (*DeletedResponse, error)
+	GetWishlist(context.Context, *GetWishlistRequest) (*WishlistResponse, error)
+	DeleteCategory(context.Context, *DeleteOneRequest) (*DeletedResponse, error)
+	DeleteCategories(context.Context, *DeleteManyRequest) (*DeletedResponse, error)
+	GetDeletedCategories(context.Context, *GetAllRequest) (*CategoriesAdminResponse, error)
+	RestoreCategory(context.Context, *RestoreOneRequest) (*RestoredResponse, error)
+	RestoreCategories(context.Context, *RestoreManyRequest) (*RestoredResponse, error)
+	MoveCategory(context.Context, *MoveCategoryRequest) (*UpdatedResponse, error)
+	ReorderCategoryChildren(context.Context, *ReorderCategoryChildrenRequest) (*UpdatedResponse, error)
+	GetCategoryBySlug(context.Context, *GetCategoryBySlugRequest) (*CategoryPublicDetailsResponse, error)
+	DuplicateProduct(context.Context, *DuplicateProductRequest) (*CreatedResponse, error)
+	GetProductRevisions(context.Context, *GetProductRevisionsRequest) (*ProductRevisionsResponse, error)
+	DiffProductRevisions(context.Context, *DiffProductRevisionsRequest) (*ProductRevisionDiffResponse, error)
+	RollbackProductRevision(context.Context, *RollbackProductRevisionRequest) (*ProductAdminDetailsResponse, error)
+	GetAuditLogs(context.Context, *GetAuditLogsRequest) (*AuditLogsResponse, error)
+	mustEmbedUnimplementedProductServiceServer()
 }
-func (UnimplementedProductServiceServer) DeleteTags(context.Context, *DeleteManyRequest) (*DeletedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTags not implemented")
+
+// UnimplementedProductServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProductServiceServer struct{}
+
+func (UnimplementedProductServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
 }
-func (UnimplementedProductServiceServer) RestoreProduct(context.Context, *RestoreOneRequest) (*RestoredResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestoreProduct not implemented")
+func (UnimplementedProductServiceServer) GetCategoryTree(context.Context, *GetAllRequest) (*CategoryTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryTree not implemented")
 }
-func (UnimplementedProductServiceServer) RestoreProducts(context.Context, *RestoreManyRequest) (*RestoredResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestoreProducts not implemented")
+func (UnimplementedProductServiceServer) GetProductBySlug(context.Context, *GetProductBySlugRequest) (*ProductPublicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductBySlug not implemented")
 }
-func (UnimplementedProductServiceServer) RestoreColor(context.Context, *RestoreOneRequest) (*RestoredResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestoreColor not implemented")
+func (UnimplementedProductServiceServer) CreateColor(context.Context, *CreateColorRequest) (*CreatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateColor not implemented")
 }
-func (UnimplementedProductServiceServer) RestoreColors(context.Context, *RestoreManyRequest) (*RestoredResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestoreColors not implemented")
+func (UnimplementedProductServiceServer) CreateSize(context.Context, *CreateSizeRequest) (*CreatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSize not implemented")
 }
-func (UnimplementedProductServiceServer) RestoreSize(context.Context, *RestoreOneRequest) (*RestoredResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestoreSize not implemented")
+func (UnimplementedProductServiceServer) GetProductsByCategory(context.Context, *GetProductsByCategoryRequest) (*ProductsPublicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductsByCategory not implemented")
 }
-func (UnimplementedProductServiceServer) RestoreSizes(context.Context, *RestoreManyRequest) (*RestoredResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestoreSizes not implemented")
+func (UnimplementedProductServiceServer) CreateTag(context.Context, *CreateTagRequest) (*CreatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
 }
-func (UnimplementedProductServiceServer) RestoreTag(context.Context, *RestoreOneRequest) (*RestoredResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestoreTag not implemented")
+func (UnimplementedProductServiceServer) GetAllCategoriesAdmin(context.Context, *GetAllRequest) (*BaseCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCategoriesAdmin not implemented")
 }
-func (UnimplementedProductServiceServer) RestoreTags(context.Context, *RestoreManyRequest) (*RestoredResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestoreTags not implemented")
+func (UnimplementedProductServiceServer) GetCategoryById(context.Context, *GetOneRequest) (*CategoryAdminDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryById not implemented")
 }
-func (UnimplementedProductServiceServer) PermanentlyDeleteProduct(context.Context, *PermanentlyDeleteOneRequest) (*DeletedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PermanentlyDeleteProduct not implemented")
+func (UnimplementedProductServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*CategoryAdminDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedProductServiceServer) GetAllColorsAdmin(context.Context, *GetAllRequest) (*ColorsAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllColorsAdmin not implemented")
+}
+func (UnimplementedProductServiceServer) GetAllSizesAdmin(context.Context, *GetAllRequest) (*SizesAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllSizesAdmin not implemented")
+}
+func (UnimplementedProductServiceServer) GetAllTagsAdmin(context.Context, *GetAllRequest) (*TagsAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllTagsAdmin not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateTag(context.Context, *UpdateTagRequest) (*UpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
+}
+func (UnimplementedProductServiceServer) GetAllColors(context.Context, *GetAllRequest) (*ColorsPublicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllColors not implemented")
+}
+func (UnimplementedProductServiceServer) GetAllSizes(context.Context, *GetAllRequest) (*SizesPublicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllSizes not implemented")
+}
+func (UnimplementedProductServiceServer) GetAllTags(context.Context, *GetAllRequest) (*TagsPublicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllTags not implemented")
+}
+func (UnimplementedProductServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*CreatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) GetCategoriesNoChild(context.Context, *GetAllRequest) (*BaseCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoriesNoChild not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductById(context.Context, *GetOneRequest) (*ProductAdminDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductById not implemented")
+}
+func (UnimplementedProductServiceServer) GetAllProductsAdmin(context.Context, *GetAllProductsAdminRequest) (*ProductsAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllProductsAdmin not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*ProductAdminDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProduct(context.Context, *DeleteOneRequest) (*DeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProducts(context.Context, *DeleteManyRequest) (*DeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProducts not implemented")
+}
+func (UnimplementedProductServiceServer) PermanentlyDeleteCategory(context.Context, *PermanentlyDeleteOneRequest) (*DeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PermanentlyDeleteCategory not implemented")
+}
+func (UnimplementedProductServiceServer) PermanentlyDeleteCategories(context.Context, *PermanentlyDeleteManyRequest) (*DeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PermanentlyDeleteCategories not implemented")
+}
+func (UnimplementedProductServiceServer) GetCategoriesNoProduct(context.Context, *GetAllRequest) (*BaseCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoriesNoProduct not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateColor(context.Context, *UpdateColorRequest) (*UpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateColor not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateSize(context.Context, *UpdateSizeRequest) (*UpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSize not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteColor(context.Context, *DeleteOneRequest) (*DeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteColor not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteSize(context.Context, *DeleteOneRequest) (*DeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSize not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteColors(context.Context, *DeleteManyRequest) (*DeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteColors not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteSizes(context.Context, *DeleteManyRequest) (*DeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSizes not implemented")
+}
+func (UnimplementedProductServiceServer) GetDeletedProducts(context.Context, *GetAllProductsAdminRequest) (*ProductsAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedProducts not implemented")
+}
+func (UnimplementedProductServiceServer) GetDeletedProductById(context.Context, *GetOneRequest) (*ProductAdminDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedProductById not implemented")
+}
+func (UnimplementedProductServiceServer) GetDeletedColors(context.Context, *GetAllRequest) (*ColorsAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedColors not implemented")
+}
+func (UnimplementedProductServiceServer) GetDeletedSizes(context.Context, *GetAllRequest) (*SizesAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedSizes not implemented")
+}
+func (UnimplementedProductServiceServer) GetDeletedTags(context.Context, *GetAllRequest) (*TagsAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedTags not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteTag(context.Context, *DeleteOneRequest) (*DeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteTags(context.Context, *DeleteManyRequest) (*DeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTags not implemented")
+}
+func (UnimplementedProductServiceServer) RestoreProduct(context.Context, *RestoreOneRequest) (*RestoredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProduct not implemented")
+}
+func (UnimplementedProductServiceServer) RestoreProducts(context.Context, *RestoreManyRequest) (*RestoredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProducts not implemented")
+}
+func (UnimplementedProductServiceServer) RestoreColor(context.Context, *RestoreOneRequest) (*RestoredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreColor not implemented")
+}
+func (UnimplementedProductServiceServer) RestoreColors(context.Context, *RestoreManyRequest) (*RestoredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreColors not implemented")
+}
+func (UnimplementedProductServiceServer) RestoreSize(context.Context, *RestoreOneRequest) (*RestoredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSize not implemented")
+}
+func (UnimplementedProductServiceServer) RestoreSizes(context.Context, *RestoreManyRequest) (*RestoredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSizes not implemented")
+}
+func (UnimplementedProductServiceServer) RestoreTag(context.Context, *RestoreOneRequest) (*RestoredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTag not implemented")
+}
+func (UnimplementedProductServiceServer) RestoreTags(context.Context, *RestoreManyRequest) (*RestoredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTags not implemented")
+}
+func (UnimplementedProductServiceServer) PermanentlyDeleteProduct(context.Context, *PermanentlyDeleteOneRequest) (*DeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PermanentlyDeleteProduct not implemented")
 }
 func (UnimplementedProductServiceServer) PermanentlyDeleteProducts(context.Context, *PermanentlyDeleteManyRequest) (*DeletedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PermanentlyDeleteProducts not implemented")
@@ -961,1049 +1468,1868 @@ func (UnimplementedProductServiceServer) PermanentlyDeleteTags(context.Context,
 func (UnimplementedProductServiceServer) GetImagesByProductId(context.Context, *GetByProductId) (*ImagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetImagesByProductId not implemented")
 }
-func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
-func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
+func (UnimplementedProductServiceServer) UpdateBundleItems(context.Context, *UpdateBundleItemsRequest) (*UpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBundleItems not implemented")
+}
+func (UnimplementedProductServiceServer) GetBundleItems(context.Context, *GetByProductId) (*BundleItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBundleItems not implemented")
+}
+func (UnimplementedProductServiceServer) SellBundle(context.Context, *SellBundleRequest) (*UpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellBundle not implemented")
+}
+func (UnimplementedProductServiceServer) ReserveBundle(context.Context, *ReserveBundleRequest) (*UpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveBundle not implemented")
+}
+func (UnimplementedProductServiceServer) ReleaseBundle(context.Context, *ReleaseBundleRequest) (*UpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseBundle not implemented")
+}
+func (UnimplementedProductServiceServer) AddProductRelations(context.Context, *AddProductRelationsRequest) (*UpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProductRelations not implemented")
+}
+func (UnimplementedProductServiceServer) RemoveProductRelations(context.Context, *RemoveProductRelationsRequest) (*DeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProductRelations not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductRelations(context.Context, *GetByProductId) (*ProductRelationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductRelations not implemented")
+}
+func (UnimplementedProductServiceServer) GetRelatedProducts(context.Context, *GetRelatedProductsRequest) (*RelatedProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelatedProducts not implemented")
+}
+func (UnimplementedProductServiceServer) CreateReview(context.Context, *CreateReviewRequest) (*CreatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductReviews(context.Context, *GetProductReviewsRequest) (*ReviewsPublicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductReviews not implemented")
+}
+func (UnimplementedProductServiceServer) GetAllReviewsAdmin(context.Context, *GetAllReviewsAdminRequest) (*ReviewsAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllReviewsAdmin not implemented")
+}
+func (UnimplementedProductServiceServer) ModerateReview(context.Context, *ModerateReviewRequest) (*UpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateReview not implemented")
+}
+func (UnimplementedProductServiceServer) CreateQuestion(context.Context, *CreateQuestionRequest) (*CreatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuestion not implemented")
+}
+func (UnimplementedProductServiceServer) CreateAnswer(context.Context, *CreateAnswerRequest) (*CreatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAnswer not implemented")
+}
+func (UnimplementedProductServiceServer) UpvoteQuestion(context.Context, *UpvoteRequest) (*UpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpvoteQuestion not implemented")
+}
+func (UnimplementedProductServiceServer) UpvoteAnswer(context.Context, *UpvoteRequest) (*UpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpvoteAnswer not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductQuestions(context.Context, *GetProductQuestionsRequest) (*QuestionsPublicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductQuestions not implemented")
+}
+func (UnimplementedProductServiceServer) GetQuestionAnswers(context.Context, *GetQuestionAnswersRequest) (*AnswersPublicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuestionAnswers not implemented")
+}
+func (UnimplementedProductServiceServer) GetAllQuestionsAdmin(context.Context, *GetAllQuestionsAdminRequest) (*QuestionsAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllQuestionsAdmin not implemented")
+}
+func (UnimplementedProductServiceServer) GetAllAnswersAdmin(context.Context, *GetAllAnswersAdminRequest) (*AnswersAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllAnswersAdmin not implemented")
+}
+func (UnimplementedProductServiceServer) ModerateQuestion(context.Context, *ModerateRequest) (*UpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateQuestion not implemented")
+}
+func (UnimplementedProductServiceServer) ModerateAnswer(context.Context, *ModerateRequest) (*UpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateAnswer not implemented")
+}
+func (UnimplementedProductServiceServer) AddToWishlist(context.Context, *AddToWishlistRequest) (*CreatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToWishlist not implemented")
+}
+func (UnimplementedProductServiceServer) RemoveFromWishlist(context.Context, *RemoveFromWishlistRequest) (*DeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWishlist not implemented")
+}
+func (UnimplementedProductServiceServer) GetWishlist(context.Context, *GetWishlistRequest) (*WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWishlist not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteCategory(context.Context, *DeleteOneRequest) (*DeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteCategories(context.Context, *DeleteManyRequest) (*DeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategories not implemented")
+}
+func (UnimplementedProductServiceServer) GetDeletedCategories(context.Context, *GetAllRequest) (*CategoriesAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedCategories not implemented")
+}
+func (UnimplementedProductServiceServer) RestoreCategory(context.Context, *RestoreOneRequest) (*RestoredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCategory not implemented")
+}
+func (UnimplementedProductServiceServer) RestoreCategories(context.Context, *RestoreManyRequest) (*RestoredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCategories not implemented")
+}
+func (UnimplementedProductServiceServer) MoveCategory(context.Context, *MoveCategoryRequest) (*UpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCategory not implemented")
+}
+func (UnimplementedProductServiceServer) ReorderCategoryChildren(context.Context, *ReorderCategoryChildrenRequest) (*UpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderCategoryChildren not implemented")
+}
+func (UnimplementedProductServiceServer) GetCategoryBySlug(context.Context, *GetCategoryBySlugRequest) (*CategoryPublicDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryBySlug not implemented")
+}
+func (UnimplementedProductServiceServer) DuplicateProduct(context.Context, *DuplicateProductRequest) (*CreatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DuplicateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductRevisions(context.Context, *GetProductRevisionsRequest) (*ProductRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductRevisions not implemented")
+}
+func (UnimplementedProductServiceServer) DiffProductRevisions(context.Context, *DiffProductRevisionsRequest) (*ProductRevisionDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffProductRevisions not implemented")
+}
+func (UnimplementedProductServiceServer) RollbackProductRevision(context.Context, *RollbackProductRevisionRequest) (*ProductAdminDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackProductRevision not implemented")
+}
+func (UnimplementedProductServiceServer) GetAuditLogs(context.Context, *GetAuditLogsRequest) (*AuditLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogs not implemented")
+}
+func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
+func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProductServiceServer will
+// result in compilation errors.
+type UnsafeProductServiceServer interface {
+	mustEmbedUnimplementedProductServiceServer()
+}
+
+func RegisterProductServiceServer(s grpc.ServiceRegistrar, srv ProductServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProductServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProductService_ServiceDesc, srv)
+}
+
+func _ProductService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCategoryTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCategoryTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCategoryTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCategoryTree(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductBySlug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductBySlug(ctx, req.(*GetProductBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateColorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateColor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateColor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateColor(ctx, req.(*CreateColorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateSize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateSize(ctx, req.(*CreateSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductsByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductsByCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductsByCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductsByCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductsByCategory(ctx, req.(*GetProductsByCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateTag(ctx, req.(*CreateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetAllCategoriesAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetAllCategoriesAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetAllCategoriesAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetAllCategoriesAdmin(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCategoryById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCategoryById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCategoryById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCategoryById(ctx, req.(*GetOneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetAllColorsAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetAllColorsAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetAllColorsAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetAllColorsAdmin(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetAllSizesAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetAllSizesAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetAllSizesAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetAllSizesAdmin(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetAllTagsAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetAllTagsAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetAllTagsAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetAllTagsAdmin(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateTag(ctx, req.(*UpdateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetAllColors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetAllColors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetAllColors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetAllColors(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetAllSizes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetAllSizes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetAllSizes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetAllSizes(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetAllTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetAllTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetAllTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetAllTags(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCategoriesNoChild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCategoriesNoChild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCategoriesNoChild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCategoriesNoChild(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductById(ctx, req.(*GetOneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetAllProductsAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllProductsAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetAllProductsAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetAllProductsAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetAllProductsAdmin(ctx, req.(*GetAllProductsAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProduct(ctx, req.(*DeleteOneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteManyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProducts(ctx, req.(*DeleteManyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_PermanentlyDeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermanentlyDeleteOneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).PermanentlyDeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_PermanentlyDeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).PermanentlyDeleteCategory(ctx, req.(*PermanentlyDeleteOneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_PermanentlyDeleteCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermanentlyDeleteManyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).PermanentlyDeleteCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_PermanentlyDeleteCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).PermanentlyDeleteCategories(ctx, req.(*PermanentlyDeleteManyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCategoriesNoProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCategoriesNoProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCategoriesNoProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCategoriesNoProduct(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateColorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateColor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateColor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateColor(ctx, req.(*UpdateColorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateSize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateSize(ctx, req.(*UpdateSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteColor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteColor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteColor(ctx, req.(*DeleteOneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteSize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteSize(ctx, req.(*DeleteOneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteColors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteManyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteColors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteColors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteColors(ctx, req.(*DeleteManyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteSizes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteManyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteSizes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteSizes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteSizes(ctx, req.(*DeleteManyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetDeletedProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllProductsAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetDeletedProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetDeletedProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetDeletedProducts(ctx, req.(*GetAllProductsAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetDeletedProductById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetDeletedProductById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetDeletedProductById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetDeletedProductById(ctx, req.(*GetOneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetDeletedColors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetDeletedColors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetDeletedColors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetDeletedColors(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetDeletedSizes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetDeletedSizes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetDeletedSizes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetDeletedSizes(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ProductServiceServer will
-// result in compilation errors.
-type UnsafeProductServiceServer interface {
-	mustEmbedUnimplementedProductServiceServer()
+func _ProductService_GetDeletedTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetDeletedTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetDeletedTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetDeletedTags(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterProductServiceServer(s grpc.ServiceRegistrar, srv ProductServiceServer) {
-	// If the following call pancis, it indicates UnimplementedProductServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ProductService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ProductService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteTag(ctx, req.(*DeleteOneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCategoryRequest)
+func _ProductService_DeleteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteManyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).CreateCategory(ctx, in)
+		return srv.(ProductServiceServer).DeleteTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_CreateCategory_FullMethodName,
+		FullMethod: ProductService_DeleteTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+		return srv.(ProductServiceServer).DeleteTags(ctx, req.(*DeleteManyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetCategoryTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+func _ProductService_RestoreProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreOneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetCategoryTree(ctx, in)
+		return srv.(ProductServiceServer).RestoreProduct(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetCategoryTree_FullMethodName,
+		FullMethod: ProductService_RestoreProduct_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetCategoryTree(ctx, req.(*GetAllRequest))
+		return srv.(ProductServiceServer).RestoreProduct(ctx, req.(*RestoreOneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetProductBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductBySlugRequest)
+func _ProductService_RestoreProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreManyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetProductBySlug(ctx, in)
+		return srv.(ProductServiceServer).RestoreProducts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetProductBySlug_FullMethodName,
+		FullMethod: ProductService_RestoreProducts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetProductBySlug(ctx, req.(*GetProductBySlugRequest))
+		return srv.(ProductServiceServer).RestoreProducts(ctx, req.(*RestoreManyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_CreateColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateColorRequest)
+func _ProductService_RestoreColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreOneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).CreateColor(ctx, in)
+		return srv.(ProductServiceServer).RestoreColor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_CreateColor_FullMethodName,
+		FullMethod: ProductService_RestoreColor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).CreateColor(ctx, req.(*CreateColorRequest))
+		return srv.(ProductServiceServer).RestoreColor(ctx, req.(*RestoreOneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_CreateSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSizeRequest)
+func _ProductService_RestoreColors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreManyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).CreateSize(ctx, in)
+		return srv.(ProductServiceServer).RestoreColors(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_CreateSize_FullMethodName,
+		FullMethod: ProductService_RestoreColors_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).CreateSize(ctx, req.(*CreateSizeRequest))
+		return srv.(ProductServiceServer).RestoreColors(ctx, req.(*RestoreManyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetProductsByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductsByCategoryRequest)
+func _ProductService_RestoreSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreOneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetProductsByCategory(ctx, in)
+		return srv.(ProductServiceServer).RestoreSize(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetProductsByCategory_FullMethodName,
+		FullMethod: ProductService_RestoreSize_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetProductsByCategory(ctx, req.(*GetProductsByCategoryRequest))
+		return srv.(ProductServiceServer).RestoreSize(ctx, req.(*RestoreOneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTagRequest)
+func _ProductService_RestoreSizes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreManyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).CreateTag(ctx, in)
+		return srv.(ProductServiceServer).RestoreSizes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_CreateTag_FullMethodName,
+		FullMethod: ProductService_RestoreSizes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).CreateTag(ctx, req.(*CreateTagRequest))
+		return srv.(ProductServiceServer).RestoreSizes(ctx, req.(*RestoreManyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetAllCategoriesAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+func _ProductService_RestoreTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreOneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetAllCategoriesAdmin(ctx, in)
+		return srv.(ProductServiceServer).RestoreTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetAllCategoriesAdmin_FullMethodName,
+		FullMethod: ProductService_RestoreTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetAllCategoriesAdmin(ctx, req.(*GetAllRequest))
+		return srv.(ProductServiceServer).RestoreTag(ctx, req.(*RestoreOneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetCategoryById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOneRequest)
+func _ProductService_RestoreTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreManyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetCategoryById(ctx, in)
+		return srv.(ProductServiceServer).RestoreTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetCategoryById_FullMethodName,
+		FullMethod: ProductService_RestoreTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetCategoryById(ctx, req.(*GetOneRequest))
+		return srv.(ProductServiceServer).RestoreTags(ctx, req.(*RestoreManyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCategoryRequest)
+func _ProductService_PermanentlyDeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermanentlyDeleteOneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).UpdateCategory(ctx, in)
+		return srv.(ProductServiceServer).PermanentlyDeleteProduct(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_UpdateCategory_FullMethodName,
+		FullMethod: ProductService_PermanentlyDeleteProduct_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+		return srv.(ProductServiceServer).PermanentlyDeleteProduct(ctx, req.(*PermanentlyDeleteOneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetAllColorsAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+func _ProductService_PermanentlyDeleteProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermanentlyDeleteManyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetAllColorsAdmin(ctx, in)
+		return srv.(ProductServiceServer).PermanentlyDeleteProducts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetAllColorsAdmin_FullMethodName,
+		FullMethod: ProductService_PermanentlyDeleteProducts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetAllColorsAdmin(ctx, req.(*GetAllRequest))
+		return srv.(ProductServiceServer).PermanentlyDeleteProducts(ctx, req.(*PermanentlyDeleteManyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetAllSizesAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+func _ProductService_PermanentlyDeleteColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermanentlyDeleteOneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetAllSizesAdmin(ctx, in)
+		return srv.(ProductServiceServer).PermanentlyDeleteColor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetAllSizesAdmin_FullMethodName,
+		FullMethod: ProductService_PermanentlyDeleteColor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetAllSizesAdmin(ctx, req.(*GetAllRequest))
+		return srv.(ProductServiceServer).PermanentlyDeleteColor(ctx, req.(*PermanentlyDeleteOneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetAllTagsAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+func _ProductService_PermanentlyDeleteColors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermanentlyDeleteManyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetAllTagsAdmin(ctx, in)
+		return srv.(ProductServiceServer).PermanentlyDeleteColors(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetAllTagsAdmin_FullMethodName,
+		FullMethod: ProductService_PermanentlyDeleteColors_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetAllTagsAdmin(ctx, req.(*GetAllRequest))
+		return srv.(ProductServiceServer).PermanentlyDeleteColors(ctx, req.(*PermanentlyDeleteManyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTagRequest)
+func _ProductService_PermanentlyDeleteSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermanentlyDeleteOneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).UpdateTag(ctx, in)
+		return srv.(ProductServiceServer).PermanentlyDeleteSize(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_UpdateTag_FullMethodName,
+		FullMethod: ProductService_PermanentlyDeleteSize_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).UpdateTag(ctx, req.(*UpdateTagRequest))
+		return srv.(ProductServiceServer).PermanentlyDeleteSize(ctx, req.(*PermanentlyDeleteOneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetAllColors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+func _ProductService_PermanentlyDeleteSizes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermanentlyDeleteManyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetAllColors(ctx, in)
+		return srv.(ProductServiceServer).PermanentlyDeleteSizes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetAllColors_FullMethodName,
+		FullMethod: ProductService_PermanentlyDeleteSizes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetAllColors(ctx, req.(*GetAllRequest))
+		return srv.(ProductServiceServer).PermanentlyDeleteSizes(ctx, req.(*PermanentlyDeleteManyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetAllSizes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+func _ProductService_PermanentlyDeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermanentlyDeleteOneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetAllSizes(ctx, in)
+		return srv.(ProductServiceServer).PermanentlyDeleteTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetAllSizes_FullMethodName,
+		FullMethod: ProductService_PermanentlyDeleteTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetAllSizes(ctx, req.(*GetAllRequest))
+		return srv.(ProductServiceServer).PermanentlyDeleteTag(ctx, req.(*PermanentlyDeleteOneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetAllTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+func _ProductService_PermanentlyDeleteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermanentlyDeleteManyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetAllTags(ctx, in)
+		return srv.(ProductServiceServer).PermanentlyDeleteTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetAllTags_FullMethodName,
+		FullMethod: ProductService_PermanentlyDeleteTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetAllTags(ctx, req.(*GetAllRequest))
+		return srv.(ProductServiceServer).PermanentlyDeleteTags(ctx, req.(*PermanentlyDeleteManyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProductRequest)
+func _ProductService_GetImagesByProductId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByProductId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).CreateProduct(ctx, in)
+		return srv.(ProductServiceServer).GetImagesByProductId(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_CreateProduct_FullMethodName,
+		FullMethod: ProductService_GetImagesByProductId_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).CreateProduct(ctx, req.(*CreateProductRequest))
+		return srv.(ProductServiceServer).GetImagesByProductId(ctx, req.(*GetByProductId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetCategoriesNoChild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+func _ProductService_UpdateBundleItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBundleItemsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetCategoriesNoChild(ctx, in)
+		return srv.(ProductServiceServer).UpdateBundleItems(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetCategoriesNoChild_FullMethodName,
+		FullMethod: ProductService_UpdateBundleItems_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetCategoriesNoChild(ctx, req.(*GetAllRequest))
+		return srv.(ProductServiceServer).UpdateBundleItems(ctx, req.(*UpdateBundleItemsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetProductById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOneRequest)
+func _ProductService_GetBundleItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByProductId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetProductById(ctx, in)
+		return srv.(ProductServiceServer).GetBundleItems(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetProductById_FullMethodName,
+		FullMethod: ProductService_GetBundleItems_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetProductById(ctx, req.(*GetOneRequest))
+		return srv.(ProductServiceServer).GetBundleItems(ctx, req.(*GetByProductId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetAllProductsAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllProductsAdminRequest)
+func _ProductService_SellBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SellBundleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetAllProductsAdmin(ctx, in)
+		return srv.(ProductServiceServer).SellBundle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetAllProductsAdmin_FullMethodName,
+		FullMethod: ProductService_SellBundle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetAllProductsAdmin(ctx, req.(*GetAllProductsAdminRequest))
+		return srv.(ProductServiceServer).SellBundle(ctx, req.(*SellBundleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProductRequest)
+func _ProductService_ReserveBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveBundleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).UpdateProduct(ctx, in)
+		return srv.(ProductServiceServer).ReserveBundle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_UpdateProduct_FullMethodName,
+		FullMethod: ProductService_ReserveBundle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+		return srv.(ProductServiceServer).ReserveBundle(ctx, req.(*ReserveBundleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteOneRequest)
+func _ProductService_ReleaseBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseBundleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).DeleteProduct(ctx, in)
+		return srv.(ProductServiceServer).ReleaseBundle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_DeleteProduct_FullMethodName,
+		FullMethod: ProductService_ReleaseBundle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeleteProduct(ctx, req.(*DeleteOneRequest))
+		return srv.(ProductServiceServer).ReleaseBundle(ctx, req.(*ReleaseBundleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_DeleteProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteManyRequest)
+func _ProductService_AddProductRelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProductRelationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).DeleteProducts(ctx, in)
+		return srv.(ProductServiceServer).AddProductRelations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_DeleteProducts_FullMethodName,
+		FullMethod: ProductService_AddProductRelations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeleteProducts(ctx, req.(*DeleteManyRequest))
+		return srv.(ProductServiceServer).AddProductRelations(ctx, req.(*AddProductRelationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_PermanentlyDeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PermanentlyDeleteOneRequest)
+func _ProductService_RemoveProductRelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveProductRelationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).PermanentlyDeleteCategory(ctx, in)
+		return srv.(ProductServiceServer).RemoveProductRelations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_PermanentlyDeleteCategory_FullMethodName,
+		FullMethod: ProductService_RemoveProductRelations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).PermanentlyDeleteCategory(ctx, req.(*PermanentlyDeleteOneRequest))
+		return srv.(ProductServiceServer).RemoveProductRelations(ctx, req.(*RemoveProductRelationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_PermanentlyDeleteCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PermanentlyDeleteManyRequest)
+func _ProductService_GetProductRelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByProductId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).PermanentlyDeleteCategories(ctx, in)
+		return srv.(ProductServiceServer).GetProductRelations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_PermanentlyDeleteCategories_FullMethodName,
+		FullMethod: ProductService_GetProductRelations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).PermanentlyDeleteCategories(ctx, req.(*PermanentlyDeleteManyRequest))
+		return srv.(ProductServiceServer).GetProductRelations(ctx, req.(*GetByProductId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetCategoriesNoProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+func _ProductService_GetRelatedProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelatedProductsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetCategoriesNoProduct(ctx, in)
+		return srv.(ProductServiceServer).GetRelatedProducts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetCategoriesNoProduct_FullMethodName,
+		FullMethod: ProductService_GetRelatedProducts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetCategoriesNoProduct(ctx, req.(*GetAllRequest))
+		return srv.(ProductServiceServer).GetRelatedProducts(ctx, req.(*GetRelatedProductsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_UpdateColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateColorRequest)
+func _ProductService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).UpdateColor(ctx, in)
+		return srv.(ProductServiceServer).CreateReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_UpdateColor_FullMethodName,
+		FullMethod: ProductService_CreateReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).UpdateColor(ctx, req.(*UpdateColorRequest))
+		return srv.(ProductServiceServer).CreateReview(ctx, req.(*CreateReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_UpdateSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSizeRequest)
+func _ProductService_GetProductReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductReviewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).UpdateSize(ctx, in)
+		return srv.(ProductServiceServer).GetProductReviews(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_UpdateSize_FullMethodName,
+		FullMethod: ProductService_GetProductReviews_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).UpdateSize(ctx, req.(*UpdateSizeRequest))
+		return srv.(ProductServiceServer).GetProductReviews(ctx, req.(*GetProductReviewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_DeleteColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteOneRequest)
+func _ProductService_GetAllReviewsAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllReviewsAdminRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).DeleteColor(ctx, in)
+		return srv.(ProductServiceServer).GetAllReviewsAdmin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_DeleteColor_FullMethodName,
+		FullMethod: ProductService_GetAllReviewsAdmin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeleteColor(ctx, req.(*DeleteOneRequest))
+		return srv.(ProductServiceServer).GetAllReviewsAdmin(ctx, req.(*GetAllReviewsAdminRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_DeleteSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteOneRequest)
+func _ProductService_ModerateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).DeleteSize(ctx, in)
+		return srv.(ProductServiceServer).ModerateReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_DeleteSize_FullMethodName,
+		FullMethod: ProductService_ModerateReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeleteSize(ctx, req.(*DeleteOneRequest))
+		return srv.(ProductServiceServer).ModerateReview(ctx, req.(*ModerateReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_DeleteColors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteManyRequest)
+func _ProductService_CreateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuestionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).DeleteColors(ctx, in)
+		return srv.(ProductServiceServer).CreateQuestion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_DeleteColors_FullMethodName,
+		FullMethod: ProductService_CreateQuestion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeleteColors(ctx, req.(*DeleteManyRequest))
+		return srv.(ProductServiceServer).CreateQuestion(ctx, req.(*CreateQuestionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_DeleteSizes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteManyRequest)
+func _ProductService_CreateAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAnswerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).DeleteSizes(ctx, in)
+		return srv.(ProductServiceServer).CreateAnswer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_DeleteSizes_FullMethodName,
+		FullMethod: ProductService_CreateAnswer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeleteSizes(ctx, req.(*DeleteManyRequest))
+		return srv.(ProductServiceServer).CreateAnswer(ctx, req.(*CreateAnswerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetDeletedProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllProductsAdminRequest)
+func _ProductService_UpvoteQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpvoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetDeletedProducts(ctx, in)
+		return srv.(ProductServiceServer).UpvoteQuestion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetDeletedProducts_FullMethodName,
+		FullMethod: ProductService_UpvoteQuestion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetDeletedProducts(ctx, req.(*GetAllProductsAdminRequest))
+		return srv.(ProductServiceServer).UpvoteQuestion(ctx, req.(*UpvoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetDeletedProductById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOneRequest)
+func _ProductService_UpvoteAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpvoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetDeletedProductById(ctx, in)
+		return srv.(ProductServiceServer).UpvoteAnswer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetDeletedProductById_FullMethodName,
+		FullMethod: ProductService_UpvoteAnswer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetDeletedProductById(ctx, req.(*GetOneRequest))
+		return srv.(ProductServiceServer).UpvoteAnswer(ctx, req.(*UpvoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetDeletedColors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+func _ProductService_GetProductQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductQuestionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetDeletedColors(ctx, in)
+		return srv.(ProductServiceServer).GetProductQuestions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetDeletedColors_FullMethodName,
+		FullMethod: ProductService_GetProductQuestions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetDeletedColors(ctx, req.(*GetAllRequest))
+		return srv.(ProductServiceServer).GetProductQuestions(ctx, req.(*GetProductQuestionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetDeletedSizes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+func _ProductService_GetQuestionAnswers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuestionAnswersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetDeletedSizes(ctx, in)
+		return srv.(ProductServiceServer).GetQuestionAnswers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetDeletedSizes_FullMethodName,
+		FullMethod: ProductService_GetQuestionAnswers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetDeletedSizes(ctx, req.(*GetAllRequest))
+		return srv.(ProductServiceServer).GetQuestionAnswers(ctx, req.(*GetQuestionAnswersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetDeletedTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+func _ProductService_GetAllQuestionsAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllQuestionsAdminRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetDeletedTags(ctx, in)
+		return srv.(ProductServiceServer).GetAllQuestionsAdmin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetDeletedTags_FullMethodName,
+		FullMethod: ProductService_GetAllQuestionsAdmin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetDeletedTags(ctx, req.(*GetAllRequest))
+		return srv.(ProductServiceServer).GetAllQuestionsAdmin(ctx, req.(*GetAllQuestionsAdminRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteOneRequest)
+func _ProductService_GetAllAnswersAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllAnswersAdminRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).DeleteTag(ctx, in)
+		return srv.(ProductServiceServer).GetAllAnswersAdmin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_DeleteTag_FullMethodName,
+		FullMethod: ProductService_GetAllAnswersAdmin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeleteTag(ctx, req.(*DeleteOneRequest))
+		return srv.(ProductServiceServer).GetAllAnswersAdmin(ctx, req.(*GetAllAnswersAdminRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_DeleteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteManyRequest)
+func _ProductService_ModerateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).DeleteTags(ctx, in)
+		return srv.(ProductServiceServer).ModerateQuestion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_DeleteTags_FullMethodName,
+		FullMethod: ProductService_ModerateQuestion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeleteTags(ctx, req.(*DeleteManyRequest))
+		return srv.(ProductServiceServer).ModerateQuestion(ctx, req.(*ModerateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_RestoreProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestoreOneRequest)
+func _ProductService_ModerateAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).RestoreProduct(ctx, in)
+		return srv.(ProductServiceServer).ModerateAnswer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_RestoreProduct_FullMethodName,
+		FullMethod: ProductService_ModerateAnswer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).RestoreProduct(ctx, req.(*RestoreOneRequest))
+		return srv.(ProductServiceServer).ModerateAnswer(ctx, req.(*ModerateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_RestoreProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestoreManyRequest)
+func _ProductService_AddToWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToWishlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).RestoreProducts(ctx, in)
+		return srv.(ProductServiceServer).AddToWishlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_RestoreProducts_FullMethodName,
+		FullMethod: ProductService_AddToWishlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).RestoreProducts(ctx, req.(*RestoreManyRequest))
+		return srv.(ProductServiceServer).AddToWishlist(ctx, req.(*AddToWishlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_RestoreColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestoreOneRequest)
+func _ProductService_RemoveFromWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromWishlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).RestoreColor(ctx, in)
+		return srv.(ProductServiceServer).RemoveFromWishlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_RestoreColor_FullMethodName,
+		FullMethod: ProductService_RemoveFromWishlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).RestoreColor(ctx, req.(*RestoreOneRequest))
+		return srv.(ProductServiceServer).RemoveFromWishlist(ctx, req.(*RemoveFromWishlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_RestoreColors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestoreManyRequest)
+func _ProductService_GetWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWishlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).RestoreColors(ctx, in)
+		return srv.(ProductServiceServer).GetWishlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_RestoreColors_FullMethodName,
+		FullMethod: ProductService_GetWishlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).RestoreColors(ctx, req.(*RestoreManyRequest))
+		return srv.(ProductServiceServer).GetWishlist(ctx, req.(*GetWishlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_RestoreSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestoreOneRequest)
+func _ProductService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).RestoreSize(ctx, in)
+		return srv.(ProductServiceServer).DeleteCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_RestoreSize_FullMethodName,
+		FullMethod: ProductService_DeleteCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).RestoreSize(ctx, req.(*RestoreOneRequest))
+		return srv.(ProductServiceServer).DeleteCategory(ctx, req.(*DeleteOneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_RestoreSizes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestoreManyRequest)
+func _ProductService_DeleteCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteManyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).RestoreSizes(ctx, in)
+		return srv.(ProductServiceServer).DeleteCategories(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_RestoreSizes_FullMethodName,
+		FullMethod: ProductService_DeleteCategories_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).RestoreSizes(ctx, req.(*RestoreManyRequest))
+		return srv.(ProductServiceServer).DeleteCategories(ctx, req.(*DeleteManyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_RestoreTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestoreOneRequest)
+func _ProductService_GetDeletedCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).RestoreTag(ctx, in)
+		return srv.(ProductServiceServer).GetDeletedCategories(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_RestoreTag_FullMethodName,
+		FullMethod: ProductService_GetDeletedCategories_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).RestoreTag(ctx, req.(*RestoreOneRequest))
+		return srv.(ProductServiceServer).GetDeletedCategories(ctx, req.(*GetAllRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_RestoreTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestoreManyRequest)
+func _ProductService_RestoreCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreOneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).RestoreTags(ctx, in)
+		return srv.(ProductServiceServer).RestoreCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_RestoreTags_FullMethodName,
+		FullMethod: ProductService_RestoreCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).RestoreTags(ctx, req.(*RestoreManyRequest))
+		return srv.(ProductServiceServer).RestoreCategory(ctx, req.(*RestoreOneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_PermanentlyDeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PermanentlyDeleteOneRequest)
+func _ProductService_RestoreCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreManyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).PermanentlyDeleteProduct(ctx, in)
+		return srv.(ProductServiceServer).RestoreCategories(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_PermanentlyDeleteProduct_FullMethodName,
+		FullMethod: ProductService_RestoreCategories_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).PermanentlyDeleteProduct(ctx, req.(*PermanentlyDeleteOneRequest))
+		return srv.(ProductServiceServer).RestoreCategories(ctx, req.(*RestoreManyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_PermanentlyDeleteProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PermanentlyDeleteManyRequest)
+func _ProductService_MoveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCategoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).PermanentlyDeleteProducts(ctx, in)
+		return srv.(ProductServiceServer).MoveCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_PermanentlyDeleteProducts_FullMethodName,
+		FullMethod: ProductService_MoveCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).PermanentlyDeleteProducts(ctx, req.(*PermanentlyDeleteManyRequest))
+		return srv.(ProductServiceServer).MoveCategory(ctx, req.(*MoveCategoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_PermanentlyDeleteColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PermanentlyDeleteOneRequest)
+func _ProductService_ReorderCategoryChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCategoryChildrenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).PermanentlyDeleteColor(ctx, in)
+		return srv.(ProductServiceServer).ReorderCategoryChildren(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_PermanentlyDeleteColor_FullMethodName,
+		FullMethod: ProductService_ReorderCategoryChildren_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).PermanentlyDeleteColor(ctx, req.(*PermanentlyDeleteOneRequest))
+		return srv.(ProductServiceServer).ReorderCategoryChildren(ctx, req.(*ReorderCategoryChildrenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_PermanentlyDeleteColors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PermanentlyDeleteManyRequest)
+func _ProductService_GetCategoryBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryBySlugRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).PermanentlyDeleteColors(ctx, in)
+		return srv.(ProductServiceServer).GetCategoryBySlug(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_PermanentlyDeleteColors_FullMethodName,
+		FullMethod: ProductService_GetCategoryBySlug_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).PermanentlyDeleteColors(ctx, req.(*PermanentlyDeleteManyRequest))
+		return srv.(ProductServiceServer).GetCategoryBySlug(ctx, req.(*GetCategoryBySlugRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_PermanentlyDeleteSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PermanentlyDeleteOneRequest)
+func _ProductService_DuplicateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateProductRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).PermanentlyDeleteSize(ctx, in)
+		return srv.(ProductServiceServer).DuplicateProduct(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_PermanentlyDeleteSize_FullMethodName,
+		FullMethod: ProductService_DuplicateProduct_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).PermanentlyDeleteSize(ctx, req.(*PermanentlyDeleteOneRequest))
+		return srv.(ProductServiceServer).DuplicateProduct(ctx, req.(*DuplicateProductRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_PermanentlyDeleteSizes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PermanentlyDeleteManyRequest)
+func _ProductService_GetProductRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).PermanentlyDeleteSizes(ctx, in)
+		return srv.(ProductServiceServer).GetProductRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_PermanentlyDeleteSizes_FullMethodName,
+		FullMethod: ProductService_GetProductRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).PermanentlyDeleteSizes(ctx, req.(*PermanentlyDeleteManyRequest))
+		return srv.(ProductServiceServer).GetProductRevisions(ctx, req.(*GetProductRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_PermanentlyDeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PermanentlyDeleteOneRequest)
+func _ProductService_DiffProductRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffProductRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).PermanentlyDeleteTag(ctx, in)
+		return srv.(ProductServiceServer).DiffProductRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_PermanentlyDeleteTag_FullMethodName,
+		FullMethod: ProductService_DiffProductRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).PermanentlyDeleteTag(ctx, req.(*PermanentlyDeleteOneRequest))
+		return srv.(ProductServiceServer).DiffProductRevisions(ctx, req.(*DiffProductRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_PermanentlyDeleteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PermanentlyDeleteManyRequest)
+func _ProductService_RollbackProductRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackProductRevisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).PermanentlyDeleteTags(ctx, in)
+		return srv.(ProductServiceServer).RollbackProductRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_PermanentlyDeleteTags_FullMethodName,
+		FullMethod: ProductService_RollbackProductRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).PermanentlyDeleteTags(ctx, req.(*PermanentlyDeleteManyRequest))
+		return srv.(ProductServiceServer).RollbackProductRevision(ctx, req.(*RollbackProductRevisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetImagesByProductId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByProductId)
+func _ProductService_GetAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetImagesByProductId(ctx, in)
+		return srv.(ProductServiceServer).GetAuditLogs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetImagesByProductId_FullMethodName,
+		FullMethod: ProductService_GetAuditLogs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetImagesByProductId(ctx, req.(*GetByProductId))
+		return srv.(ProductServiceServer).GetAuditLogs(ctx, req.(*GetAuditLogsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2243,6 +3569,162 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetImagesByProductId",
 			Handler:    _ProductService_GetImagesByProductId_Handler,
 		},
+		{
+			MethodName: "UpdateBundleItems",
+			Handler:    _ProductService_UpdateBundleItems_Handler,
+		},
+		{
+			MethodName: "GetBundleItems",
+			Handler:    _ProductService_GetBundleItems_Handler,
+		},
+		{
+			MethodName: "SellBundle",
+			Handler:    _ProductService_SellBundle_Handler,
+		},
+		{
+			MethodName: "ReserveBundle",
+			Handler:    _ProductService_ReserveBundle_Handler,
+		},
+		{
+			MethodName: "ReleaseBundle",
+			Handler:    _ProductService_ReleaseBundle_Handler,
+		},
+		{
+			MethodName: "AddProductRelations",
+			Handler:    _ProductService_AddProductRelations_Handler,
+		},
+		{
+			MethodName: "RemoveProductRelations",
+			Handler:    _ProductService_RemoveProductRelations_Handler,
+		},
+		{
+			MethodName: "GetProductRelations",
+			Handler:    _ProductService_GetProductRelations_Handler,
+		},
+		{
+			MethodName: "GetRelatedProducts",
+			Handler:    _ProductService_GetRelatedProducts_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _ProductService_CreateReview_Handler,
+		},
+		{
+			MethodName: "GetProductReviews",
+			Handler:    _ProductService_GetProductReviews_Handler,
+		},
+		{
+			MethodName: "GetAllReviewsAdmin",
+			Handler:    _ProductService_GetAllReviewsAdmin_Handler,
+		},
+		{
+			MethodName: "ModerateReview",
+			Handler:    _ProductService_ModerateReview_Handler,
+		},
+		{
+			MethodName: "CreateQuestion",
+			Handler:    _ProductService_CreateQuestion_Handler,
+		},
+		{
+			MethodName: "CreateAnswer",
+			Handler:    _ProductService_CreateAnswer_Handler,
+		},
+		{
+			MethodName: "UpvoteQuestion",
+			Handler:    _ProductService_UpvoteQuestion_Handler,
+		},
+		{
+			MethodName: "UpvoteAnswer",
+			Handler:    _ProductService_UpvoteAnswer_Handler,
+		},
+		{
+			MethodName: "GetProductQuestions",
+			Handler:    _ProductService_GetProductQuestions_Handler,
+		},
+		{
+			MethodName: "GetQuestionAnswers",
+			Handler:    _ProductService_GetQuestionAnswers_Handler,
+		},
+		{
+			MethodName: "GetAllQuestionsAdmin",
+			Handler:    _ProductService_GetAllQuestionsAdmin_Handler,
+		},
+		{
+			MethodName: "GetAllAnswersAdmin",
+			Handler:    _ProductService_GetAllAnswersAdmin_Handler,
+		},
+		{
+			MethodName: "ModerateQuestion",
+			Handler:    _ProductService_ModerateQuestion_Handler,
+		},
+		{
+			MethodName: "ModerateAnswer",
+			Handler:    _ProductService_ModerateAnswer_Handler,
+		},
+		{
+			MethodName: "AddToWishlist",
+			Handler:    _ProductService_AddToWishlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromWishlist",
+			Handler:    _ProductService_RemoveFromWishlist_Handler,
+		},
+		{
+			MethodName: "GetWishlist",
+			Handler:    _ProductService_GetWishlist_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _ProductService_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategories",
+			Handler:    _ProductService_DeleteCategories_Handler,
+		},
+		{
+			MethodName: "GetDeletedCategories",
+			Handler:    _ProductService_GetDeletedCategories_Handler,
+		},
+		{
+			MethodName: "RestoreCategory",
+			Handler:    _ProductService_RestoreCategory_Handler,
+		},
+		{
+			MethodName: "RestoreCategories",
+			Handler:    _ProductService_RestoreCategories_Handler,
+		},
+		{
+			MethodName: "MoveCategory",
+			Handler:    _ProductService_MoveCategory_Handler,
+		},
+		{
+			MethodName: "ReorderCategoryChildren",
+			Handler:    _ProductService_ReorderCategoryChildren_Handler,
+		},
+		{
+			MethodName: "GetCategoryBySlug",
+			Handler:    _ProductService_GetCategoryBySlug_Handler,
+		},
+		{
+			MethodName: "DuplicateProduct",
+			Handler:    _ProductService_DuplicateProduct_Handler,
+		},
+		{
+			MethodName: "GetProductRevisions",
+			Handler:    _ProductService_GetProductRevisions_Handler,
+		},
+		{
+			MethodName: "DiffProductRevisions",
+			Handler:    _ProductService_DiffProductRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackProductRevision",
+			Handler:    _ProductService_RollbackProductRevision_Handler,
+		},
+		{
+			MethodName: "GetAuditLogs",
+			Handler:    _ProductService_GetAuditLogs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/product.proto",
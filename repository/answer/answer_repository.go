@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+)
+
+type AnswerRepository interface {
+	CreateTx(ctx context.Context, tx *gorm.DB, answer *model.Answer) error
+
+	FindByIDTx(ctx context.Context, tx *gorm.DB, id string) (*model.Answer, error)
+
+	UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error
+
+	FindAllPaginated(ctx context.Context, questionID string, status string, query common.PaginationQuery) ([]*model.Answer, int64, error)
+
+	CountApprovedByQuestionIDTx(ctx context.Context, tx *gorm.DB, questionID string) (int64, error)
+
+	CreateVoteTx(ctx context.Context, tx *gorm.DB, vote *model.AnswerVote) error
+
+	IncreaseUpvoteCountTx(ctx context.Context, tx *gorm.DB, id string) error
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type answerRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAnswerRepository(db *gorm.DB) AnswerRepository {
+	return &answerRepositoryImpl{db}
+}
+
+func (r *answerRepositoryImpl) CreateTx(ctx context.Context, tx *gorm.DB, answer *model.Answer) error {
+	return tx.WithContext(ctx).Create(answer).Error
+}
+
+func (r *answerRepositoryImpl) FindByIDTx(ctx context.Context, tx *gorm.DB, id string) (*model.Answer, error) {
+	var answer model.Answer
+	if err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).Where("id = ?", id).First(&answer).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &answer, nil
+}
+
+func (r *answerRepositoryImpl) UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error {
+	result := tx.WithContext(ctx).Model(&model.Answer{}).Where("id = ?", id).Updates(updateData)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return common.ErrAnswerNotFound
+	}
+
+	return nil
+}
+
+func (r *answerRepositoryImpl) FindAllPaginated(ctx context.Context, questionID string, status string, pQuery common.PaginationQuery) ([]*model.Answer, int64, error) {
+	var answers []*model.Answer
+	var total int64
+
+	db := r.db.WithContext(ctx).Model(&model.Answer{})
+	if questionID != "" {
+		db = db.Where("question_id = ?", questionID)
+	}
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+	if pQuery.Search != "" {
+		db = db.Where("LOWER(content) LIKE ?", "%"+strings.ToLower(pQuery.Search)+"%")
+	}
+
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	allowedSorts := map[string]bool{
+		"upvote_count": true,
+		"created_at":   true,
+	}
+	if allowedSorts[pQuery.Sort] {
+		order := "DESC"
+		if strings.ToUpper(pQuery.Order) == "ASC" {
+			order = "ASC"
+		}
+		db = db.Order(pQuery.Sort + " " + order)
+	} else {
+		db = db.Order("is_staff DESC, upvote_count DESC, created_at ASC")
+	}
+
+	offset := (pQuery.Page - 1) * pQuery.Limit
+	if err := db.Offset(offset).Limit(pQuery.Limit).Find(&answers).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return answers, total, nil
+}
+
+func (r *answerRepositoryImpl) CountApprovedByQuestionIDTx(ctx context.Context, tx *gorm.DB, questionID string) (int64, error) {
+	var count int64
+	if err := tx.WithContext(ctx).Model(&model.Answer{}).Where("question_id = ? AND status = ?", questionID, common.ModerationStatusApproved).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *answerRepositoryImpl) CreateVoteTx(ctx context.Context, tx *gorm.DB, vote *model.AnswerVote) error {
+	return tx.WithContext(ctx).Create(vote).Error
+}
+
+func (r *answerRepositoryImpl) IncreaseUpvoteCountTx(ctx context.Context, tx *gorm.DB, id string) error {
+	return tx.WithContext(ctx).Model(&model.Answer{}).Where("id = ?", id).UpdateColumn("upvote_count", gorm.Expr("upvote_count + 1")).Error
+}
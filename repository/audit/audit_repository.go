@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+)
+
+type AuditRepository interface {
+	CreateAll(ctx context.Context, logs []*model.AuditLog) error
+
+	FindAllPaginated(ctx context.Context, filter common.AuditLogFilter, query common.PaginationQuery) ([]*model.AuditLog, int64, error)
+
+	FindRowsByColumn(ctx context.Context, table, column string, values []string) ([]map[string]any, error)
+
+	FindRowsByColumnTx(ctx context.Context, tx *gorm.DB, table, column string, values []string) ([]map[string]any, error)
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type auditRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAuditRepository(db *gorm.DB) AuditRepository {
+	return &auditRepositoryImpl{db}
+}
+
+func (r *auditRepositoryImpl) CreateAll(ctx context.Context, logs []*model.AuditLog) error {
+	return r.db.WithContext(ctx).Create(&logs).Error
+}
+
+func (r *auditRepositoryImpl) FindAllPaginated(ctx context.Context, filter common.AuditLogFilter, pQuery common.PaginationQuery) ([]*model.AuditLog, int64, error) {
+	var logs []*model.AuditLog
+	var total int64
+
+	db := r.db.WithContext(ctx).Model(&model.AuditLog{})
+	if filter.ActorID != "" {
+		db = db.Where("actor_id = ?", filter.ActorID)
+	}
+	if filter.Action != "" {
+		db = db.Where("action = ?", filter.Action)
+	}
+	if filter.EntityType != "" {
+		db = db.Where("entity_type = ?", filter.EntityType)
+	}
+	if filter.EntityID != "" {
+		db = db.Where("entity_id = ?", filter.EntityID)
+	}
+	if filter.RequestID != "" {
+		db = db.Where("request_id = ?", filter.RequestID)
+	}
+	if filter.From != nil {
+		db = db.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		db = db.Where("created_at <= ?", *filter.To)
+	}
+
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := "DESC"
+	if strings.ToUpper(pQuery.Order) == "ASC" {
+		order = "ASC"
+	}
+	db = db.Order("created_at " + order)
+
+	offset := (pQuery.Page - 1) * pQuery.Limit
+	if err := db.Offset(offset).Limit(pQuery.Limit).Find(&logs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return logs, total, nil
+}
+
+func (r *auditRepositoryImpl) FindRowsByColumn(ctx context.Context, table, column string, values []string) ([]map[string]any, error) {
+	return findRowsByColumnBase(ctx, r.db, table, column, values)
+}
+
+func (r *auditRepositoryImpl) FindRowsByColumnTx(ctx context.Context, tx *gorm.DB, table, column string, values []string) ([]map[string]any, error) {
+	return findRowsByColumnBase(ctx, tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}), table, column, values)
+}
+
+func findRowsByColumnBase(ctx context.Context, tx *gorm.DB, table, column string, values []string) ([]map[string]any, error) {
+	var rows []map[string]any
+	if err := tx.WithContext(ctx).Table(table).Where(column+" IN ?", values).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+)
+
+type BundleRepository interface {
+	CreateAllTx(ctx context.Context, tx *gorm.DB, items []*model.BundleItem) error
+
+	FindAllByBundleIDWithDetails(ctx context.Context, bundleID string) ([]*model.BundleItem, error)
+
+	FindAllByBundleIDTx(ctx context.Context, tx *gorm.DB, bundleID string) ([]*model.BundleItem, error)
+
+	DeleteAllByBundleIDTx(ctx context.Context, tx *gorm.DB, bundleID string) error
+}
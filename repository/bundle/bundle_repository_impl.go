@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+)
+
+type bundleRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewBundleRepository(db *gorm.DB) BundleRepository {
+	return &bundleRepositoryImpl{db}
+}
+
+func (r *bundleRepositoryImpl) CreateAllTx(ctx context.Context, tx *gorm.DB, items []*model.BundleItem) error {
+	return tx.WithContext(ctx).Create(&items).Error
+}
+
+func (r *bundleRepositoryImpl) FindAllByBundleIDWithDetails(ctx context.Context, bundleID string) ([]*model.BundleItem, error) {
+	var items []*model.BundleItem
+	if err := r.db.WithContext(ctx).
+		Preload("Variant").
+		Preload("Variant.Product").
+		Preload("Variant.Color").
+		Preload("Variant.Size").
+		Preload("Variant.Inventory").
+		Where("bundle_id = ?", bundleID).
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (r *bundleRepositoryImpl) FindAllByBundleIDTx(ctx context.Context, tx *gorm.DB, bundleID string) ([]*model.BundleItem, error) {
+	var items []*model.BundleItem
+	if err := tx.WithContext(ctx).Where("bundle_id = ?", bundleID).Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (r *bundleRepositoryImpl) DeleteAllByBundleIDTx(ctx context.Context, tx *gorm.DB, bundleID string) error {
+	return tx.WithContext(ctx).Where("bundle_id = ?", bundleID).Delete(&model.BundleItem{}).Error
+}
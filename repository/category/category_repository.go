@@ -40,15 +40,51 @@ type CategoryRepository interface {
 
 	FindAllWithChildren(ctx context.Context) ([]*model.Category, error)
 
-	DeleteAllByID(ctx context.Context, ids []string) error
+	DeleteAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string) error
 
-	Delete(ctx context.Context, id string) error
+	DeleteTx(ctx context.Context, tx *gorm.DB, id string) error
 
 	FindByIDWithParentsTx(ctx context.Context, tx *gorm.DB, id string) (*model.Category, error)
 
 	FindAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string) ([]*model.Category, error)
 
-	GetAllAncestors(ctx context.Context, id string) ([]string, error)
+	GetAllAncestors(ctx context.Context, ids ...string) ([]string, error)
 
 	GetAllDescendants(ctx context.Context, id string) ([]string, error)
-}
\ No newline at end of file
+
+	Update(ctx context.Context, id string, updateData map[string]any) error
+
+	UpdateByIDAndIsDeletedTx(ctx context.Context, tx *gorm.DB, id string, isDeleted bool, updateData map[string]any) error
+
+	UpdateAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string, updateData map[string]any) error
+
+	FindAllDeletedWithParents(ctx context.Context) ([]*model.Category, error)
+
+	FindDeletedByID(ctx context.Context, id string) (*model.Category, error)
+
+	FindAllDeletedByID(ctx context.Context, ids []string) ([]*model.Category, error)
+
+	CreateTx(ctx context.Context, tx *gorm.DB, category *model.Category) error
+
+	FindByIDTx(ctx context.Context, tx *gorm.DB, id string) (*model.Category, error)
+
+	AddParentsTx(ctx context.Context, tx *gorm.DB, childID string, parentIDs []string) error
+
+	DeleteParentTx(ctx context.Context, tx *gorm.DB, childID, parentID string) error
+
+	FindAllParentEdges(ctx context.Context) ([]*model.CategoryParent, error)
+
+	FindAllParentEdgesByChildID(ctx context.Context, childIDs []string) ([]*model.CategoryParent, error)
+
+	FindAllChildEdgesByParentIDTx(ctx context.Context, tx *gorm.DB, parentID string) ([]*model.CategoryParent, error)
+
+	UpdateChildrenSortOrderTx(ctx context.Context, tx *gorm.DB, parentID string, childIDs []string) error
+
+	FindBySlugWithFeaturedProducts(ctx context.Context, slug string) (*model.Category, error)
+
+	FindAllByIDWithParents(ctx context.Context, ids []string) ([]*model.Category, error)
+
+	FindAllVisibleChildrenByParentID(ctx context.Context, parentID string) ([]*model.Category, error)
+
+	UpdateFeaturedProductsTx(ctx context.Context, tx *gorm.DB, categoryID string, productIDs []string) error
+}
@@ -53,7 +53,7 @@ func (r *categoryRepositoryImpl) ExistsByID(ctx context.Context, id string) (boo
 }
 
 func (r *categoryRepositoryImpl) FindByID(ctx context.Context, id string) (*model.Category, error) {
-	return findByIDBase(ctx, r.db, id, nil)
+	return findByIDBase(ctx, r.db, id, false, nil)
 }
 
 func (r *categoryRepositoryImpl) FindAllWithParentsAndChildren(ctx context.Context) ([]*model.Category, error) {
@@ -69,10 +69,13 @@ func (r *categoryRepositoryImpl) FindAll(ctx context.Context) ([]*model.Category
 }
 
 func (r *categoryRepositoryImpl) FindByIDWithParentsAndProducts(ctx context.Context, id string) (*model.Category, error) {
-	return findByIDBase(ctx, r.db, id, nil,
-		common.Preload{Relation: "Parents"},
+	return findByIDBase(ctx, r.db, id, false, nil,
+		common.Preload{Relation: "Parents", Scope: notDeleted},
 		common.Preload{Relation: "Products"},
-		common.Preload{Relation: "Products.Images", Scope: getThumbnail})
+		common.Preload{Relation: "Products.Images", Scope: getThumbnail},
+		common.Preload{Relation: "FeaturedProducts", Scope: orderBySortOrder},
+		common.Preload{Relation: "FeaturedProducts.Product"},
+		common.Preload{Relation: "FeaturedProducts.Product.Images", Scope: getThumbnail})
 }
 
 func (r *categoryRepositoryImpl) UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error {
@@ -80,11 +83,26 @@ func (r *categoryRepositoryImpl) UpdateTx(ctx context.Context, tx *gorm.DB, id s
 }
 
 func (r *categoryRepositoryImpl) FindByIDWithParents(ctx context.Context, id string) (*model.Category, error) {
-	return findByIDBase(ctx, r.db, id, nil, common.Preload{Relation: "Parents"})
+	return findByIDBase(ctx, r.db, id, false, nil, common.Preload{Relation: "Parents"})
 }
 
 func (r *categoryRepositoryImpl) UpdateParentsTx(ctx context.Context, tx *gorm.DB, category *model.Category, parents []*model.Category) error {
-	return tx.WithContext(ctx).Model(category).Association("Parents").Replace(parents)
+	query := tx.WithContext(ctx).Table("category_parents").
+		Where("child_id = ? AND parent_id IN (SELECT id FROM categories WHERE is_deleted = false)", category.ID)
+
+	parentIDs := make([]string, 0, len(parents))
+	for _, parent := range parents {
+		parentIDs = append(parentIDs, parent.ID)
+	}
+	if len(parentIDs) > 0 {
+		query = query.Where("parent_id NOT IN ?", parentIDs)
+	}
+
+	if err := query.Delete(nil).Error; err != nil {
+		return err
+	}
+
+	return r.AddParentsTx(ctx, tx, category.ID, parentIDs)
 }
 
 func (r *categoryRepositoryImpl) FindAllWithChildren(ctx context.Context) ([]*model.Category, error) {
@@ -99,12 +117,12 @@ func (r *categoryRepositoryImpl) FindAllByIDTx(ctx context.Context, tx *gorm.DB,
 	return findAllByIDBase(ctx, tx, ids)
 }
 
-func (r *categoryRepositoryImpl) DeleteAllByID(ctx context.Context, ids []string) error {
-	return r.db.WithContext(ctx).Where("id IN ?", ids).Delete(&model.Category{}).Error
+func (r *categoryRepositoryImpl) DeleteAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string) error {
+	return tx.WithContext(ctx).Where("id IN ?", ids).Delete(&model.Category{}).Error
 }
 
-func (r *categoryRepositoryImpl) Delete(ctx context.Context, id string) error {
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.Category{})
+func (r *categoryRepositoryImpl) DeleteTx(ctx context.Context, tx *gorm.DB, id string) error {
+	result := tx.WithContext(ctx).Where("id = ?", id).Delete(&model.Category{})
 	if result.Error != nil {
 		return result.Error
 	}
@@ -116,18 +134,205 @@ func (r *categoryRepositoryImpl) Delete(ctx context.Context, id string) error {
 }
 
 func (r *categoryRepositoryImpl) FindByIDWithParentsTx(ctx context.Context, tx *gorm.DB, id string) (*model.Category, error) {
-	return findByIDBase(ctx, tx, id,
+	return findByIDBase(ctx, tx, id, false,
 		&common.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsNoWait},
-		common.Preload{Relation: "Parents"})
+		common.Preload{Relation: "Parents", Scope: notDeleted},
+		common.Preload{Relation: "FeaturedProducts", Scope: orderBySortOrder})
+}
+
+func (r *categoryRepositoryImpl) Update(ctx context.Context, id string, updateData map[string]any) error {
+	result := r.db.WithContext(ctx).Model(&model.Category{}).Where("id = ?", id).Updates(updateData)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return common.ErrCategoryNotFound
+	}
+
+	return nil
+}
+
+func (r *categoryRepositoryImpl) UpdateByIDAndIsDeletedTx(ctx context.Context, tx *gorm.DB, id string, isDeleted bool, updateData map[string]any) error {
+	result := tx.WithContext(ctx).Model(&model.Category{}).Where("id = ? AND is_deleted = ?", id, isDeleted).Updates(updateData)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return common.ErrCategoryNotFound
+	}
+
+	return nil
+}
+
+func (r *categoryRepositoryImpl) UpdateAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string, updateData map[string]any) error {
+	return tx.WithContext(ctx).Model(&model.Category{}).Where("id IN ?", ids).Updates(updateData).Error
+}
+
+func (r *categoryRepositoryImpl) FindAllDeletedWithParents(ctx context.Context) ([]*model.Category, error) {
+	var categories []*model.Category
+	if err := r.db.WithContext(ctx).Preload("Parents").Where("is_deleted = true").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *categoryRepositoryImpl) FindDeletedByID(ctx context.Context, id string) (*model.Category, error) {
+	return findByIDBase(ctx, r.db, id, true, nil)
+}
+
+func (r *categoryRepositoryImpl) FindAllDeletedByID(ctx context.Context, ids []string) ([]*model.Category, error) {
+	var categories []*model.Category
+	if err := r.db.WithContext(ctx).Where("id IN ? AND is_deleted = true", ids).Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *categoryRepositoryImpl) CreateTx(ctx context.Context, tx *gorm.DB, category *model.Category) error {
+	return tx.WithContext(ctx).Create(category).Error
+}
+
+func (r *categoryRepositoryImpl) FindByIDTx(ctx context.Context, tx *gorm.DB, id string) (*model.Category, error) {
+	return findByIDBase(ctx, tx, id, false, &common.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsNoWait})
+}
+
+func (r *categoryRepositoryImpl) AddParentsTx(ctx context.Context, tx *gorm.DB, childID string, parentIDs []string) error {
+	query := `
+	INSERT INTO category_parents (child_id, parent_id, sort_order)
+	SELECT ?, ?, COALESCE(MAX(sort_order) + 1, 0)
+	FROM category_parents
+	WHERE parent_id = ?
+	ON CONFLICT (child_id, parent_id) DO NOTHING;
+	`
+	for _, parentID := range parentIDs {
+		if err := tx.WithContext(ctx).Exec(query, childID, parentID, parentID).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *categoryRepositoryImpl) DeleteParentTx(ctx context.Context, tx *gorm.DB, childID, parentID string) error {
+	return tx.WithContext(ctx).Where("child_id = ? AND parent_id = ?", childID, parentID).Delete(&model.CategoryParent{}).Error
+}
+
+func (r *categoryRepositoryImpl) FindAllParentEdges(ctx context.Context) ([]*model.CategoryParent, error) {
+	var edges []*model.CategoryParent
+	if err := r.db.WithContext(ctx).Table("category_parents cp").
+		Select("cp.*").
+		Joins("JOIN categories c ON c.id = cp.child_id").
+		Order("cp.parent_id ASC, cp.sort_order ASC, c.created_at ASC").
+		Find(&edges).Error; err != nil {
+		return nil, err
+	}
+
+	return edges, nil
 }
 
-func (r *categoryRepositoryImpl) GetAllAncestors(ctx context.Context, id string) ([]string, error) {
+func (r *categoryRepositoryImpl) FindAllParentEdgesByChildID(ctx context.Context, childIDs []string) ([]*model.CategoryParent, error) {
+	var edges []*model.CategoryParent
+	if err := r.db.WithContext(ctx).Where("child_id IN ?", childIDs).Find(&edges).Error; err != nil {
+		return nil, err
+	}
+
+	return edges, nil
+}
+
+func (r *categoryRepositoryImpl) FindAllChildEdgesByParentIDTx(ctx context.Context, tx *gorm.DB, parentID string) ([]*model.CategoryParent, error) {
+	var edges []*model.CategoryParent
+	if err := tx.WithContext(ctx).Table("category_parents cp").
+		Select("cp.*").
+		Joins("JOIN categories c ON c.id = cp.child_id").
+		Where("cp.parent_id = ? AND c.is_deleted = false", parentID).
+		Order("cp.sort_order ASC, c.created_at ASC").
+		Find(&edges).Error; err != nil {
+		return nil, err
+	}
+
+	return edges, nil
+}
+
+func (r *categoryRepositoryImpl) UpdateChildrenSortOrderTx(ctx context.Context, tx *gorm.DB, parentID string, childIDs []string) error {
+	for i, childID := range childIDs {
+		if err := tx.WithContext(ctx).Model(&model.CategoryParent{}).
+			Where("parent_id = ? AND child_id = ?", parentID, childID).
+			Update("sort_order", i).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *categoryRepositoryImpl) FindBySlugWithFeaturedProducts(ctx context.Context, slug string) (*model.Category, error) {
+	var category model.Category
+	if err := r.db.WithContext(ctx).
+		Preload("FeaturedProducts", orderBySortOrder).
+		Preload("FeaturedProducts.Product", isPublished).
+		Preload("FeaturedProducts.Product.Images", getThumbnail).
+		Where("slug = ? AND is_deleted = false AND is_visible = true", slug).
+		First(&category).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+func (r *categoryRepositoryImpl) FindAllByIDWithParents(ctx context.Context, ids []string) ([]*model.Category, error) {
+	return findAllByIDBase(ctx, r.db, ids, "Parents")
+}
+
+func (r *categoryRepositoryImpl) FindAllVisibleChildrenByParentID(ctx context.Context, parentID string) ([]*model.Category, error) {
+	var categories []*model.Category
+	if err := r.db.WithContext(ctx).
+		Joins("JOIN category_parents cp ON cp.child_id = categories.id").
+		Where("cp.parent_id = ? AND categories.is_deleted = false AND categories.is_visible = true", parentID).
+		Order("cp.sort_order ASC, categories.created_at ASC").
+		Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *categoryRepositoryImpl) UpdateFeaturedProductsTx(ctx context.Context, tx *gorm.DB, categoryID string, productIDs []string) error {
+	if err := tx.WithContext(ctx).Where("category_id = ?", categoryID).Delete(&model.CategoryFeaturedProduct{}).Error; err != nil {
+		return err
+	}
+
+	if len(productIDs) == 0 {
+		return nil
+	}
+
+	featuredProducts := make([]*model.CategoryFeaturedProduct, 0, len(productIDs))
+	for i, productID := range productIDs {
+		featuredProducts = append(featuredProducts, &model.CategoryFeaturedProduct{
+			CategoryID: categoryID,
+			ProductID:  productID,
+			SortOrder:  i,
+		})
+	}
+
+	return tx.WithContext(ctx).Create(&featuredProducts).Error
+}
+
+func (r *categoryRepositoryImpl) GetAllAncestors(ctx context.Context, ids ...string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
 	var ancestors []string
 	query := `
 	WITH RECURSIVE ancestors AS (
 		SELECT parent_id
 		FROM category_parents
-		WHERE child_id = ?
+		WHERE child_id IN ?
 		UNION
 		SELECT cp.parent_id
 		FROM category_parents cp
@@ -135,7 +340,7 @@ func (r *categoryRepositoryImpl) GetAllAncestors(ctx context.Context, id string)
 	)
 	SELECT DISTINCT parent_id FROM ancestors;
 	`
-	if err := r.db.WithContext(ctx).Raw(query, id).Scan(&ancestors).Error; err != nil {
+	if err := r.db.WithContext(ctx).Raw(query, ids).Scan(&ancestors).Error; err != nil {
 		return nil, err
 	}
 
@@ -163,7 +368,7 @@ func (r *categoryRepositoryImpl) GetAllDescendants(ctx context.Context, id strin
 	return descendants, nil
 }
 
-func findByIDBase(ctx context.Context, tx *gorm.DB, id string, looking *common.Locking, preloads ...common.Preload) (*model.Category, error) {
+func findByIDBase(ctx context.Context, tx *gorm.DB, id string, isDeleted bool, looking *common.Locking, preloads ...common.Preload) (*model.Category, error) {
 	var category model.Category
 	query := tx.WithContext(ctx)
 
@@ -179,7 +384,7 @@ func findByIDBase(ctx context.Context, tx *gorm.DB, id string, looking *common.L
 		}
 	}
 
-	if err := query.Where("id = ?", id).First(&category).Error; err != nil {
+	if err := query.Where("id = ? AND is_deleted = ?", id, isDeleted).First(&category).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -197,7 +402,7 @@ func findAllBase(ctx context.Context, tx *gorm.DB, preloads ...string) ([]*model
 		query = query.Preload(preload)
 	}
 
-	if err := query.Find(&categories).Error; err != nil {
+	if err := query.Where("is_deleted = false").Find(&categories).Error; err != nil {
 		return nil, err
 	}
 
@@ -212,13 +417,25 @@ func findAllByIDBase(ctx context.Context, tx *gorm.DB, ids []string, preloads ..
 		query = query.Preload(preload)
 	}
 
-	if err := query.Where("id IN ?", ids).Find(&categories).Error; err != nil {
+	if err := query.Where("id IN ? AND is_deleted = false", ids).Find(&categories).Error; err != nil {
 		return nil, err
 	}
 
 	return categories, nil
 }
 
+func notDeleted(db *gorm.DB) *gorm.DB {
+	return db.Where("is_deleted = false")
+}
+
+func isPublished(db *gorm.DB) *gorm.DB {
+	return db.Where("status = ? AND is_deleted = false", common.ProductStatusPublished)
+}
+
+func orderBySortOrder(db *gorm.DB) *gorm.DB {
+	return db.Order("sort_order ASC")
+}
+
 func getThumbnail(db *gorm.DB) *gorm.DB {
 	return db.Where("is_thumbnail = true")
 }
@@ -10,6 +10,8 @@ import (
 type ColorRepository interface {
 	Create(ctx context.Context, color *model.Color) error
 
+	CreateTx(ctx context.Context, tx *gorm.DB, color *model.Color) error
+
 	ExistsByID(ctx context.Context, id string) (bool, error)
 
 	FindAll(ctx context.Context) ([]*model.Color, error)
@@ -20,19 +22,17 @@ type ColorRepository interface {
 
 	FindByIDTx(ctx context.Context, tx *gorm.DB, id string) (*model.Color, error)
 
-	Update(ctx context.Context, id string, updateData map[string]any) error
-
 	UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error
 
 	FindAllByID(ctx context.Context, ids []string) ([]*model.Color, error)
 
-	UpdateAllByID(ctx context.Context, ids []string, updateData map[string]any) error
+	UpdateAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string, updateData map[string]any) error
 
 	FindAllDeletedByID(ctx context.Context, ids []string) ([]*model.Color, error)
 
 	FindDeletedByID(ctx context.Context, id string) (*model.Color, error)
 
-	DeleteAllByID(ctx context.Context, ids []string) error
+	DeleteAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string) error
 
-	Delete(ctx context.Context, id string) error
+	DeleteTx(ctx context.Context, tx *gorm.DB, id string) error
 }
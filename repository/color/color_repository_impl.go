@@ -22,6 +22,10 @@ func (r *colorRepositoryImpl) Create(ctx context.Context, color *model.Color) er
 	return r.db.WithContext(ctx).Create(color).Error
 }
 
+func (r *colorRepositoryImpl) CreateTx(ctx context.Context, tx *gorm.DB, color *model.Color) error {
+	return tx.WithContext(ctx).Create(color).Error
+}
+
 func (r *colorRepositoryImpl) ExistsByID(ctx context.Context, id string) (bool, error) {
 	var count int64
 	if err := r.db.WithContext(ctx).Model(&model.Color{}).Where("id = ?", id).Count(&count).Error; err != nil {
@@ -78,12 +82,12 @@ func (r *colorRepositoryImpl) FindByIDTx(ctx context.Context, tx *gorm.DB, id st
 	return findByIDBase(ctx, tx, id, &common.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsNoWait})
 }
 
-func (r *colorRepositoryImpl) DeleteAllByID(ctx context.Context, ids []string) error {
-	return r.db.WithContext(ctx).Where("id IN ?", ids).Delete(&model.Color{}).Error
+func (r *colorRepositoryImpl) DeleteAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string) error {
+	return tx.WithContext(ctx).Where("id IN ?", ids).Delete(&model.Color{}).Error
 }
 
-func (r *colorRepositoryImpl) Delete(ctx context.Context, id string) error {
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.Color{})
+func (r *colorRepositoryImpl) DeleteTx(ctx context.Context, tx *gorm.DB, id string) error {
+	result := tx.WithContext(ctx).Where("id = ?", id).Delete(&model.Color{})
 	if result.Error != nil {
 		return result.Error
 	}
@@ -94,8 +98,8 @@ func (r *colorRepositoryImpl) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (r *colorRepositoryImpl) Update(ctx context.Context, id string, updateData map[string]any) error {
-	result := r.db.WithContext(ctx).Model(&model.Color{}).Where("id = ?", id).Updates(updateData)
+func (r *colorRepositoryImpl) UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error {
+	result := tx.WithContext(ctx).Model(&model.Color{}).Where("id = ?", id).Updates(updateData)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -106,12 +110,8 @@ func (r *colorRepositoryImpl) Update(ctx context.Context, id string, updateData
 	return nil
 }
 
-func (r *colorRepositoryImpl) UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error {
-	return tx.WithContext(ctx).Model(&model.Color{}).Where("id = ?", id).Updates(updateData).Error
-}
-
-func (r *colorRepositoryImpl) UpdateAllByID(ctx context.Context, ids []string, updateData map[string]any) error {
-	return r.db.WithContext(ctx).Model(&model.Color{}).Where("id IN ?", ids).Updates(updateData).Error
+func (r *colorRepositoryImpl) UpdateAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string, updateData map[string]any) error {
+	return tx.WithContext(ctx).Model(&model.Color{}).Where("id IN ?", ids).Updates(updateData).Error
 }
 
 func (r *colorRepositoryImpl) FindAllByID(ctx context.Context, ids []string) ([]*model.Color, error) {
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/SomeHowMicroservice/product/model"
+)
+
+type IdempotencyRepository interface {
+	CreateIfNotExists(ctx context.Context, record *model.IdempotencyKey) (bool, error)
+
+	FindByUserIDAndKeyAndMethod(ctx context.Context, userID, key, method string) (*model.IdempotencyKey, error)
+
+	Complete(ctx context.Context, userID, key, method, responseType string, response []byte) error
+
+	Delete(ctx context.Context, userID, key, method string) error
+
+	ExtendLease(ctx context.Context, userID, key, method string, lockedUntil time.Time) error
+
+	DeleteReclaimableByUserIDAndKeyAndMethod(ctx context.Context, userID, key, method string, now time.Time) error
+
+	DeleteAllExpired(ctx context.Context, now time.Time) (int64, error)
+}
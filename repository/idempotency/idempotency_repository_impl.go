@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type idempotencyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyRepository(db *gorm.DB) IdempotencyRepository {
+	return &idempotencyRepositoryImpl{db}
+}
+
+func (r *idempotencyRepositoryImpl) CreateIfNotExists(ctx context.Context, record *model.IdempotencyKey) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(record)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *idempotencyRepositoryImpl) FindByUserIDAndKeyAndMethod(ctx context.Context, userID, key, method string) (*model.IdempotencyKey, error) {
+	var record model.IdempotencyKey
+	if err := r.db.WithContext(ctx).Where("user_id = ? AND key = ? AND method = ?", userID, key, method).First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+func (r *idempotencyRepositoryImpl) Complete(ctx context.Context, userID, key, method, responseType string, response []byte) error {
+	return r.db.WithContext(ctx).Model(&model.IdempotencyKey{}).Where("user_id = ? AND key = ? AND method = ?", userID, key, method).Updates(map[string]any{
+		"status":        common.IdempotencyStatusCompleted,
+		"response_type": responseType,
+		"response":      response,
+	}).Error
+}
+
+func (r *idempotencyRepositoryImpl) Delete(ctx context.Context, userID, key, method string) error {
+	return r.db.WithContext(ctx).Where("user_id = ? AND key = ? AND method = ?", userID, key, method).Delete(&model.IdempotencyKey{}).Error
+}
+
+func (r *idempotencyRepositoryImpl) ExtendLease(ctx context.Context, userID, key, method string, lockedUntil time.Time) error {
+	return r.db.WithContext(ctx).Model(&model.IdempotencyKey{}).
+		Where("user_id = ? AND key = ? AND method = ? AND status = ?", userID, key, method, common.IdempotencyStatusPending).
+		Update("locked_until", lockedUntil).Error
+}
+
+func (r *idempotencyRepositoryImpl) DeleteReclaimableByUserIDAndKeyAndMethod(ctx context.Context, userID, key, method string, now time.Time) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND key = ? AND method = ?", userID, key, method).
+		Where("expires_at <= ? OR (status = ? AND locked_until <= ?)", now, common.IdempotencyStatusPending, now).
+		Delete(&model.IdempotencyKey{}).Error
+}
+
+func (r *idempotencyRepositoryImpl) DeleteAllExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("expires_at <= ?", now).Delete(&model.IdempotencyKey{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
@@ -3,9 +3,15 @@ package repository
 import (
 	"context"
 
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/model"
 	"gorm.io/gorm"
 )
 
 type InventoryRepository interface {
 	UpdateByVariantIDTx(ctx context.Context, tx *gorm.DB, variantID string, updateData map[string]any) error
-}
\ No newline at end of file
+
+	FindAllByVariantIDTx(ctx context.Context, tx *gorm.DB, variantIDs []string) ([]*model.Inventory, error)
+
+	CountStockLevels(ctx context.Context) (*common.InventoryStockLevels, error)
+}
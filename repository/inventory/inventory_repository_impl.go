@@ -6,6 +6,7 @@ import (
 	"github.com/SomeHowMicroservice/product/common"
 	"github.com/SomeHowMicroservice/product/model"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type inventoryRepositoryImpl struct {
@@ -26,4 +27,27 @@ func (r *inventoryRepositoryImpl) UpdateByVariantIDTx(ctx context.Context, tx *g
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+func (r *inventoryRepositoryImpl) FindAllByVariantIDTx(ctx context.Context, tx *gorm.DB, variantIDs []string) ([]*model.Inventory, error) {
+	var inventories []*model.Inventory
+	if err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).Where("variant_id IN ?", variantIDs).Find(&inventories).Error; err != nil {
+		return nil, err
+	}
+
+	return inventories, nil
+}
+
+func (r *inventoryRepositoryImpl) CountStockLevels(ctx context.Context) (*common.InventoryStockLevels, error) {
+	var levels common.InventoryStockLevels
+	if err := r.db.WithContext(ctx).Table("inventories i").
+		Select("COUNT(*) FILTER (WHERE i.stock <= 0) AS out_of_stock, COUNT(*) FILTER (WHERE i.stock > 0 AND i.is_stock = false) AS low_stock").
+		Joins("JOIN variants v ON v.id = i.variant_id").
+		Joins("JOIN products p ON p.id = v.product_id").
+		Where("p.is_deleted = false").
+		Scan(&levels).Error; err != nil {
+		return nil, err
+	}
+
+	return &levels, nil
+}
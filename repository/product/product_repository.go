@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/SomeHowMicroservice/product/common"
 	"github.com/SomeHowMicroservice/product/model"
@@ -11,7 +12,9 @@ import (
 type ProductRepository interface {
 	Create(ctx context.Context, product *model.Product) error
 
-	Delete(ctx context.Context, id string) error
+	CreateTx(ctx context.Context, tx *gorm.DB, product *model.Product) error
+
+	DeleteTx(ctx context.Context, tx *gorm.DB, id string) error
 
 	ExistsBySlug(ctx context.Context, slug string) (bool, error)
 
@@ -19,10 +22,16 @@ type ProductRepository interface {
 
 	FindByIDWithDetails(ctx context.Context, id string) (*model.Product, error)
 
+	FindByIDWithDetailsTx(ctx context.Context, tx *gorm.DB, id string) (*model.Product, error)
+
 	ExistsByID(ctx context.Context, id string) (bool, error)
 
 	FindByID(ctx context.Context, id string) (*model.Product, error)
 
+	FindByIDTx(ctx context.Context, tx *gorm.DB, id string) (*model.Product, error)
+
+	FindPublishedByID(ctx context.Context, id string) (*model.Product, error)
+
 	FindByIDWithCategoriesAndTags(ctx context.Context, id string) (*model.Product, error)
 
 	FindByIDWithCategoriesAndTagsTx(ctx context.Context, tx *gorm.DB, id string) (*model.Product, error)
@@ -33,13 +42,11 @@ type ProductRepository interface {
 
 	UpdateTagsTx(ctx context.Context, tx *gorm.DB, product *model.Product, tags []*model.Tag) error
 
-	Update(ctx context.Context, id string, updateData map[string]any) error
-
 	UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error
 
 	FindAllByID(ctx context.Context, ids []string) ([]*model.Product, error)
 
-	UpdateAllByID(ctx context.Context, ids []string, updateData map[string]any) error
+	UpdateAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string, updateData map[string]any) error
 
 	FindAllDeletedPaginatedWithCategoriesAndThumbnail(ctx context.Context, query common.PaginationQuery) ([]*model.Product, int64, error)
 
@@ -53,7 +60,19 @@ type ProductRepository interface {
 
 	FindAllDeletedByIDWithImages(ctx context.Context, ids []string) ([]*model.Product, error)
 
-	DeleteAllByID(ctx context.Context, ids []string) error
+	DeleteAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string) error
 
 	FindAllPaginatedWithCategoriesAndThumbnail(ctx context.Context, query common.PaginationQuery) ([]*model.Product, int64, error) 
+
+	FindAllSimilarWithThumbnail(ctx context.Context, productID string, excludeIDs []string, limit int) ([]*model.Product, error)
+
+	UpdateRatingTx(ctx context.Context, tx *gorm.DB, id string, averageRating float32, reviewCount int64) error
+
+	PublishAllScheduled(ctx context.Context, now time.Time) ([]*model.Product, error)
+
+	ArchiveAllExpired(ctx context.Context, now time.Time) ([]*model.Product, error)
+
+	ClaimAllSaleStarted(ctx context.Context, now time.Time) ([]*model.Product, error)
+
+	ClaimSaleStartedTx(ctx context.Context, tx *gorm.DB, id string, now time.Time) error
 }
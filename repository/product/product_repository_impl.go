@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/SomeHowMicroservice/product/common"
 	"github.com/SomeHowMicroservice/product/model"
@@ -23,6 +24,10 @@ func (r *productRepositoryImpl) Create(ctx context.Context, product *model.Produ
 	return r.db.WithContext(ctx).Create(product).Error
 }
 
+func (r *productRepositoryImpl) CreateTx(ctx context.Context, tx *gorm.DB, product *model.Product) error {
+	return tx.WithContext(ctx).Create(product).Error
+}
+
 func (r *productRepositoryImpl) ExistsBySlug(ctx context.Context, slug string) (bool, error) {
 	var count int64
 	if err := r.db.WithContext(ctx).Model(&model.Product{}).Where("slug = ?", slug).Count(&count).Error; err != nil {
@@ -33,31 +38,67 @@ func (r *productRepositoryImpl) ExistsBySlug(ctx context.Context, slug string) (
 }
 
 func (r *productRepositoryImpl) FindBySlugWithDetails(ctx context.Context, slug string) (*model.Product, error) {
-	return findBySlugBase(ctx, r.db, slug, "Categories", "Tags", "Variants", "Variants.Color", "Variants.Size", "Variants.Inventory", "Images", "Images.Color")
+	return findBySlugBase(ctx, r.db, slug,
+		common.Preload{Relation: "Categories", Scope: notDeleted},
+		common.Preload{Relation: "Tags"},
+		common.Preload{Relation: "Variants"},
+		common.Preload{Relation: "Variants.Color"},
+		common.Preload{Relation: "Variants.Size"},
+		common.Preload{Relation: "Variants.Inventory"},
+		common.Preload{Relation: "Images"},
+		common.Preload{Relation: "Images.Color"},
+		common.Preload{Relation: "BundleItems"},
+		common.Preload{Relation: "BundleItems.Variant"},
+		common.Preload{Relation: "BundleItems.Variant.Product"},
+		common.Preload{Relation: "BundleItems.Variant.Color"},
+		common.Preload{Relation: "BundleItems.Variant.Size"},
+		common.Preload{Relation: "BundleItems.Variant.Inventory"})
 }
 
 func (r *productRepositoryImpl) FindByIDWithDetails(ctx context.Context, id string) (*model.Product, error) {
 	return findByIDBase(ctx, r.db, id, false, nil,
-		common.Preload{Relation: "Categories"},
+		common.Preload{Relation: "Categories", Scope: notDeleted},
 		common.Preload{Relation: "Tags", Scope: notDeleted},
 		common.Preload{Relation: "Variants"},
 		common.Preload{Relation: "Variants.Color", Scope: notDeleted},
 		common.Preload{Relation: "Variants.Size", Scope: notDeleted},
 		common.Preload{Relation: "Variants.Inventory"},
 		common.Preload{Relation: "Images"},
-		common.Preload{Relation: "Images.Color", Scope: notDeleted})
+		common.Preload{Relation: "Images.Color", Scope: notDeleted},
+		common.Preload{Relation: "BundleItems"},
+		common.Preload{Relation: "BundleItems.Variant"},
+		common.Preload{Relation: "BundleItems.Variant.Product"},
+		common.Preload{Relation: "BundleItems.Variant.Color"},
+		common.Preload{Relation: "BundleItems.Variant.Size"},
+		common.Preload{Relation: "BundleItems.Variant.Inventory"})
+}
+
+func (r *productRepositoryImpl) FindByIDWithDetailsTx(ctx context.Context, tx *gorm.DB, id string) (*model.Product, error) {
+	return findByIDBase(ctx, tx, id, false, nil,
+		common.Preload{Relation: "Categories", Scope: notDeleted},
+		common.Preload{Relation: "Tags", Scope: notDeleted},
+		common.Preload{Relation: "Variants"},
+		common.Preload{Relation: "Variants.Inventory"},
+		common.Preload{Relation: "Images"},
+		common.Preload{Relation: "BundleItems"})
 }
 
 func (r *productRepositoryImpl) FindDeletedByIDWithDetails(ctx context.Context, id string) (*model.Product, error) {
 	return findByIDBase(ctx, r.db, id, true, nil,
-		common.Preload{Relation: "Categories"},
+		common.Preload{Relation: "Categories", Scope: notDeleted},
 		common.Preload{Relation: "Tags", Scope: notDeleted},
 		common.Preload{Relation: "Variants"},
 		common.Preload{Relation: "Variants.Color", Scope: notDeleted},
 		common.Preload{Relation: "Variants.Size", Scope: notDeleted},
 		common.Preload{Relation: "Variants.Inventory"},
 		common.Preload{Relation: "Images"},
-		common.Preload{Relation: "Images.Color", Scope: notDeleted})
+		common.Preload{Relation: "Images.Color", Scope: notDeleted},
+		common.Preload{Relation: "BundleItems"},
+		common.Preload{Relation: "BundleItems.Variant"},
+		common.Preload{Relation: "BundleItems.Variant.Product"},
+		common.Preload{Relation: "BundleItems.Variant.Color"},
+		common.Preload{Relation: "BundleItems.Variant.Size"},
+		common.Preload{Relation: "BundleItems.Variant.Inventory"})
 }
 
 func (r *productRepositoryImpl) ExistsByID(ctx context.Context, id string) (bool, error) {
@@ -73,9 +114,25 @@ func (r *productRepositoryImpl) FindByID(ctx context.Context, id string) (*model
 	return findByIDBase(ctx, r.db, id, false, nil)
 }
 
+func (r *productRepositoryImpl) FindByIDTx(ctx context.Context, tx *gorm.DB, id string) (*model.Product, error) {
+	return findByIDBase(ctx, tx, id, false, &common.Locking{Strength: clause.LockingStrengthUpdate})
+}
+
+func (r *productRepositoryImpl) FindPublishedByID(ctx context.Context, id string) (*model.Product, error) {
+	var product model.Product
+	if err := r.db.WithContext(ctx).Scopes(notDeleted, published).Where("id = ?", id).First(&product).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &product, nil
+}
+
 func (r *productRepositoryImpl) FindByCategorySlug(ctx context.Context, categorySlug string) ([]*model.Product, error) {
 	var products []*model.Product
-	if err := r.db.WithContext(ctx).Where("id IN (?)", r.db.Table("product_categories pc")).Select("pc.product_id").Joins("JOIN categories c ON c.id = pc.category_id").Where("c.slug = ? AND products.is_deleted = false", categorySlug).Preload("Categories").Preload("Variants").Preload("Variants.Color").Preload("Variants.Size").Preload("Variants.Inventory").Preload("Images").Preload("Images.Color").Find(&products).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("products.id IN (?)", r.db.Table("product_categories pc").Select("pc.product_id").Joins("JOIN categories c ON c.id = pc.category_id").Where("c.slug = ? AND c.is_deleted = false", categorySlug)).Scopes(notDeleted, published).Preload("Categories", notDeleted).Preload("Variants").Preload("Variants.Color").Preload("Variants.Size").Preload("Variants.Inventory").Preload("Images").Preload("Images.Color").Preload("BundleItems").Preload("BundleItems.Variant").Preload("BundleItems.Variant.Product").Preload("BundleItems.Variant.Color").Preload("BundleItems.Variant.Size").Preload("BundleItems.Variant.Inventory").Find(&products).Error; err != nil {
 		return nil, err
 	}
 
@@ -86,8 +143,8 @@ func (r *productRepositoryImpl) FindDeletedByID(ctx context.Context, id string)
 	return findByIDBase(ctx, r.db, id, true, nil)
 }
 
-func (r *productRepositoryImpl) Delete(ctx context.Context, id string) error {
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.Product{})
+func (r *productRepositoryImpl) DeleteTx(ctx context.Context, tx *gorm.DB, id string) error {
+	result := tx.WithContext(ctx).Where("id = ?", id).Delete(&model.Product{})
 	if result.Error != nil {
 		return result.Error
 	}
@@ -106,8 +163,8 @@ func (r *productRepositoryImpl) FindAllDeletedByIDWithImages(ctx context.Context
 	return findAllByIDBase(ctx, r.db, ids, true, "Images")
 }
 
-func (r *productRepositoryImpl) DeleteAllByID(ctx context.Context, ids []string) error {
-	return r.db.WithContext(ctx).Where("id IN ?", ids).Delete(&model.Product{}).Error
+func (r *productRepositoryImpl) DeleteAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string) error {
+	return tx.WithContext(ctx).Where("id IN ?", ids).Delete(&model.Product{}).Error
 }
 
 func (r *productRepositoryImpl) FindAllDeletedByID(ctx context.Context, ids []string) ([]*model.Product, error) {
@@ -115,7 +172,26 @@ func (r *productRepositoryImpl) FindAllDeletedByID(ctx context.Context, ids []st
 }
 
 func (r *productRepositoryImpl) UpdateCategoriesTx(ctx context.Context, tx *gorm.DB, product *model.Product, categories []*model.Category) error {
-	return tx.WithContext(ctx).Model(product).Association("Categories").Replace(categories)
+	query := tx.WithContext(ctx).Table("product_categories").
+		Where("product_id = ? AND category_id IN (SELECT id FROM categories WHERE is_deleted = false)", product.ID)
+
+	if len(categories) > 0 {
+		categoryIDs := make([]string, 0, len(categories))
+		for _, category := range categories {
+			categoryIDs = append(categoryIDs, category.ID)
+		}
+		query = query.Where("category_id NOT IN ?", categoryIDs)
+	}
+
+	if err := query.Delete(nil).Error; err != nil {
+		return err
+	}
+
+	if len(categories) == 0 {
+		return nil
+	}
+
+	return tx.WithContext(ctx).Model(product).Association("Categories").Append(categories)
 }
 
 func (r *productRepositoryImpl) FindByIDWithCategoriesAndTags(ctx context.Context, id string) (*model.Product, error) {
@@ -128,8 +204,8 @@ func (r *productRepositoryImpl) UpdateTagsTx(ctx context.Context, tx *gorm.DB, p
 	return tx.WithContext(ctx).Model(product).Association("Tags").Replace(tags)
 }
 
-func (r *productRepositoryImpl) Update(ctx context.Context, id string, updateData map[string]any) error {
-	result := r.db.WithContext(ctx).Model(&model.Product{}).Where("id = ?", id).Updates(updateData)
+func (r *productRepositoryImpl) UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error {
+	result := tx.WithContext(ctx).Model(&model.Product{}).Where("id = ?", id).Updates(updateData)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -140,37 +216,121 @@ func (r *productRepositoryImpl) Update(ctx context.Context, id string, updateDat
 	return nil
 }
 
-func (r *productRepositoryImpl) UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error {
-	return tx.WithContext(ctx).Model(&model.Product{}).Where("id = ?", id).Updates(updateData).Error
-}
-
 func (r *productRepositoryImpl) FindAllByID(ctx context.Context, ids []string) ([]*model.Product, error) {
 	return findAllByIDBase(ctx, r.db, ids, false)
 }
 
-func (r *productRepositoryImpl) UpdateAllByID(ctx context.Context, ids []string, updateData map[string]any) error {
-	return r.db.WithContext(ctx).Model(&model.Product{}).Where("id IN ?", ids).Updates(updateData).Error
+func (r *productRepositoryImpl) UpdateAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string, updateData map[string]any) error {
+	return tx.WithContext(ctx).Model(&model.Product{}).Where("id IN ?", ids).Updates(updateData).Error
 }
 
 func (r *productRepositoryImpl) FindAllDeletedPaginatedWithCategoriesAndThumbnail(ctx context.Context, query common.PaginationQuery) ([]*model.Product, int64, error) {
 	return findAllPaginatedBase(ctx, r.db, true, query,
-		common.Preload{Relation: "Categories"},
+		common.Preload{Relation: "Categories", Scope: notDeleted},
 		common.Preload{Relation: "Images", Scope: getThumbnail})
 }
 
 func (r *productRepositoryImpl) FindAllPaginatedWithCategoriesAndThumbnail(ctx context.Context, query common.PaginationQuery) ([]*model.Product, int64, error) {
 	return findAllPaginatedBase(ctx, r.db, false, query,
-		common.Preload{Relation: "Categories"},
+		common.Preload{Relation: "Categories", Scope: notDeleted},
 		common.Preload{Relation: "Images", Scope: getThumbnail})
 }
 
 func (r *productRepositoryImpl) FindByIDWithCategoriesAndTagsTx(ctx context.Context, tx *gorm.DB, id string) (*model.Product, error) {
 	return findByIDBase(ctx, tx, id, false,
 		&common.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsNoWait},
-		common.Preload{Relation: "Categories"},
+		common.Preload{Relation: "Categories", Scope: notDeleted},
 		common.Preload{Relation: "Tags"})
 }
 
+func (r *productRepositoryImpl) FindAllSimilarWithThumbnail(ctx context.Context, productID string, excludeIDs []string, limit int) ([]*model.Product, error) {
+	var products []*model.Product
+	similar := r.db.Raw(`
+	SELECT s.product_id, COUNT(*) AS score
+	FROM (
+		SELECT pc.product_id
+		FROM product_categories pc
+		WHERE pc.category_id IN (SELECT category_id FROM product_categories WHERE product_id = ?)
+		UNION ALL
+		SELECT pt.product_id
+		FROM product_tags pt
+		WHERE pt.tag_id IN (SELECT tag_id FROM product_tags WHERE product_id = ?)
+	) s
+	WHERE s.product_id <> ?
+	GROUP BY s.product_id
+	`, productID, productID, productID)
+
+	query := r.db.WithContext(ctx).
+		Joins("JOIN (?) AS similar ON similar.product_id = products.id", similar).
+		Preload("Images", getThumbnail).
+		Where("products.is_deleted = false AND products.status = ?", common.ProductStatusPublished)
+
+	if len(excludeIDs) > 0 {
+		query = query.Where("products.id NOT IN ?", excludeIDs)
+	}
+
+	if err := query.Order("similar.score DESC, products.created_at DESC").Limit(limit).Find(&products).Error; err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+func (r *productRepositoryImpl) UpdateRatingTx(ctx context.Context, tx *gorm.DB, id string, averageRating float32, reviewCount int64) error {
+	return tx.WithContext(ctx).Model(&model.Product{}).Where("id = ?", id).UpdateColumns(map[string]any{
+		"average_rating": averageRating,
+		"review_count":   reviewCount,
+	}).Error
+}
+
+func (r *productRepositoryImpl) PublishAllScheduled(ctx context.Context, now time.Time) ([]*model.Product, error) {
+	return updateStatusReturning(ctx, r.db, common.ProductStatusPublished, true, "status = ? AND publish_at <= ? AND is_deleted = false", common.ProductStatusScheduled, now)
+}
+
+func (r *productRepositoryImpl) ArchiveAllExpired(ctx context.Context, now time.Time) ([]*model.Product, error) {
+	return updateStatusReturning(ctx, r.db, common.ProductStatusArchived, false, "status IN ? AND unpublish_at <= ? AND is_deleted = false", []string{common.ProductStatusScheduled, common.ProductStatusPublished}, now)
+}
+
+func (r *productRepositoryImpl) ClaimAllSaleStarted(ctx context.Context, now time.Time) ([]*model.Product, error) {
+	return claimSaleStartedReturning(ctx, r.db, now)
+}
+
+func (r *productRepositoryImpl) ClaimSaleStartedTx(ctx context.Context, tx *gorm.DB, id string, now time.Time) error {
+	_, err := claimSaleStartedReturning(ctx, tx.Where("id = ?", id), now)
+	return err
+}
+
+func updateStatusReturning(ctx context.Context, tx *gorm.DB, status string, isActive bool, query string, args ...any) ([]*model.Product, error) {
+	var products []*model.Product
+	if err := tx.WithContext(ctx).Model(&products).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}, {Name: "title"}, {Name: "slug"}, {Name: "status"}}}).
+		Where(query, args...).
+		Updates(map[string]any{
+			"status":    status,
+			"is_active": isActive,
+			"version":   gorm.Expr("version + 1"),
+		}).Error; err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+func claimSaleStartedReturning(ctx context.Context, tx *gorm.DB, now time.Time) ([]*model.Product, error) {
+	var products []*model.Product
+	if err := tx.WithContext(ctx).Model(&products).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}, {Name: "title"}, {Name: "slug"}, {Name: "price"}, {Name: "sale_price"}}}).
+		Scopes(notDeleted, published).
+		Where("price_drop_notified_at IS NULL AND is_sale = true AND sale_price IS NOT NULL AND sale_price < price").
+		Where("start_sale IS NULL OR start_sale <= ?", now).
+		Where("end_sale IS NULL OR end_sale >= CAST(? AS date)", now).
+		UpdateColumn("price_drop_notified_at", now).Error; err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
 func findAllPaginatedBase(ctx context.Context, tx *gorm.DB, isDeleted bool, pQuery common.PaginationQuery, preloads ...common.Preload) ([]*model.Product, int64, error) {
 	var products []*model.Product
 	var total int64
@@ -215,6 +375,10 @@ func applyFilters(db *gorm.DB, query common.PaginationQuery) *gorm.DB {
 		db = db.Where("is_active = ?", *query.IsActive)
 	}
 
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+
 	return db
 }
 
@@ -257,15 +421,19 @@ func findAllByIDBase(ctx context.Context, tx *gorm.DB, ids []string, isDeleted b
 	return products, nil
 }
 
-func findBySlugBase(ctx context.Context, tx *gorm.DB, slug string, preloads ...string) (*model.Product, error) {
+func findBySlugBase(ctx context.Context, tx *gorm.DB, slug string, preloads ...common.Preload) (*model.Product, error) {
 	var product model.Product
 	query := tx.WithContext(ctx)
 
 	for _, preload := range preloads {
-		query = query.Preload(preload)
+		if preload.Scope != nil {
+			query = query.Preload(preload.Relation, preload.Scope)
+		} else {
+			query = query.Preload(preload.Relation)
+		}
 	}
 
-	if err := query.Scopes(notDeleted).Where("slug = ?", slug).First(&product).Error; err != nil {
+	if err := query.Scopes(notDeleted, published).Where("slug = ?", slug).First(&product).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -305,6 +473,10 @@ func notDeleted(db *gorm.DB) *gorm.DB {
 	return db.Where("is_deleted = false")
 }
 
+func published(db *gorm.DB) *gorm.DB {
+	return db.Where("status = ?", common.ProductStatusPublished)
+}
+
 func getThumbnail(db *gorm.DB) *gorm.DB {
 	return db.Where("is_thumbnail = true")
 }
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+)
+
+type QuestionRepository interface {
+	Create(ctx context.Context, question *model.Question) error
+
+	FindByID(ctx context.Context, id string) (*model.Question, error)
+
+	FindByIDTx(ctx context.Context, tx *gorm.DB, id string) (*model.Question, error)
+
+	UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error
+
+	FindAllPaginated(ctx context.Context, productID string, status string, query common.PaginationQuery) ([]*model.Question, int64, error)
+
+	CreateVoteTx(ctx context.Context, tx *gorm.DB, vote *model.QuestionVote) error
+
+	IncreaseUpvoteCountTx(ctx context.Context, tx *gorm.DB, id string) error
+}
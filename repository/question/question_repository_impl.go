@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type questionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewQuestionRepository(db *gorm.DB) QuestionRepository {
+	return &questionRepositoryImpl{db}
+}
+
+func (r *questionRepositoryImpl) Create(ctx context.Context, question *model.Question) error {
+	return r.db.WithContext(ctx).Create(question).Error
+}
+
+func (r *questionRepositoryImpl) FindByID(ctx context.Context, id string) (*model.Question, error) {
+	return findByIDBase(ctx, r.db, id, false)
+}
+
+func (r *questionRepositoryImpl) FindByIDTx(ctx context.Context, tx *gorm.DB, id string) (*model.Question, error) {
+	return findByIDBase(ctx, tx, id, true)
+}
+
+func (r *questionRepositoryImpl) UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error {
+	result := tx.WithContext(ctx).Model(&model.Question{}).Where("id = ?", id).Updates(updateData)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return common.ErrQuestionNotFound
+	}
+
+	return nil
+}
+
+func (r *questionRepositoryImpl) FindAllPaginated(ctx context.Context, productID string, status string, pQuery common.PaginationQuery) ([]*model.Question, int64, error) {
+	var questions []*model.Question
+	var total int64
+
+	db := r.db.WithContext(ctx).Model(&model.Question{})
+	if productID != "" {
+		db = db.Where("product_id = ?", productID)
+	}
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+	if pQuery.Search != "" {
+		db = db.Where("LOWER(content) LIKE ?", "%"+strings.ToLower(pQuery.Search)+"%")
+	}
+
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	allowedSorts := map[string]bool{
+		"upvote_count": true,
+		"answer_count": true,
+		"created_at":   true,
+	}
+	if allowedSorts[pQuery.Sort] {
+		order := "DESC"
+		if strings.ToUpper(pQuery.Order) == "ASC" {
+			order = "ASC"
+		}
+		db = db.Order(pQuery.Sort + " " + order)
+	} else {
+		db = db.Order("created_at DESC")
+	}
+
+	offset := (pQuery.Page - 1) * pQuery.Limit
+	if err := db.Offset(offset).Limit(pQuery.Limit).Find(&questions).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return questions, total, nil
+}
+
+func (r *questionRepositoryImpl) CreateVoteTx(ctx context.Context, tx *gorm.DB, vote *model.QuestionVote) error {
+	return tx.WithContext(ctx).Create(vote).Error
+}
+
+func (r *questionRepositoryImpl) IncreaseUpvoteCountTx(ctx context.Context, tx *gorm.DB, id string) error {
+	return tx.WithContext(ctx).Model(&model.Question{}).Where("id = ?", id).UpdateColumn("upvote_count", gorm.Expr("upvote_count + 1")).Error
+}
+
+func findByIDBase(ctx context.Context, tx *gorm.DB, id string, forUpdate bool) (*model.Question, error) {
+	var question model.Question
+	query := tx.WithContext(ctx)
+
+	if forUpdate {
+		query = query.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate})
+	}
+
+	if err := query.Where("id = ?", id).First(&question).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &question, nil
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+)
+
+type RelationRepository interface {
+	CreateAllTx(ctx context.Context, tx *gorm.DB, relations []*model.ProductRelation) error
+
+	FindAllByProductIDWithRelated(ctx context.Context, productID string) ([]*model.ProductRelation, error)
+
+	FindAllActiveByProductIDAndType(ctx context.Context, productID string, relationType string) ([]*model.ProductRelation, error)
+
+	FindAllByIDAndProductID(ctx context.Context, ids []string, productID string) ([]*model.ProductRelation, error)
+
+	DeleteAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string) error
+}
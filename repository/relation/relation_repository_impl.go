@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+)
+
+type relationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewRelationRepository(db *gorm.DB) RelationRepository {
+	return &relationRepositoryImpl{db}
+}
+
+func (r *relationRepositoryImpl) CreateAllTx(ctx context.Context, tx *gorm.DB, relations []*model.ProductRelation) error {
+	return tx.WithContext(ctx).Create(&relations).Error
+}
+
+func (r *relationRepositoryImpl) FindAllByProductIDWithRelated(ctx context.Context, productID string) ([]*model.ProductRelation, error) {
+	var relations []*model.ProductRelation
+	if err := r.db.WithContext(ctx).
+		Preload("RelatedProduct").
+		Preload("RelatedProduct.Images", getThumbnail).
+		Where("product_id = ?", productID).
+		Order("type ASC, sort_order ASC").
+		Find(&relations).Error; err != nil {
+		return nil, err
+	}
+
+	return relations, nil
+}
+
+func (r *relationRepositoryImpl) FindAllActiveByProductIDAndType(ctx context.Context, productID string, relationType string) ([]*model.ProductRelation, error) {
+	var relations []*model.ProductRelation
+	query := r.db.WithContext(ctx).
+		Joins("JOIN products rp ON rp.id = product_relations.related_product_id AND rp.is_deleted = false AND rp.status = ?", common.ProductStatusPublished).
+		Preload("RelatedProduct").
+		Preload("RelatedProduct.Images", getThumbnail).
+		Where("product_relations.product_id = ?", productID)
+
+	if relationType != "" {
+		query = query.Where("product_relations.type = ?", relationType)
+	}
+
+	if err := query.Order("product_relations.sort_order ASC, product_relations.created_at ASC").Find(&relations).Error; err != nil {
+		return nil, err
+	}
+
+	return relations, nil
+}
+
+func (r *relationRepositoryImpl) FindAllByIDAndProductID(ctx context.Context, ids []string, productID string) ([]*model.ProductRelation, error) {
+	var relations []*model.ProductRelation
+	if err := r.db.WithContext(ctx).Where("id IN ? AND product_id = ?", ids, productID).Find(&relations).Error; err != nil {
+		return nil, err
+	}
+
+	return relations, nil
+}
+
+func (r *relationRepositoryImpl) DeleteAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string) error {
+	return tx.WithContext(ctx).Where("id IN ?", ids).Delete(&model.ProductRelation{}).Error
+}
+
+func getThumbnail(db *gorm.DB) *gorm.DB {
+	return db.Where("is_thumbnail = true")
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+)
+
+type ReviewRepository interface {
+	Create(ctx context.Context, review *model.Review) error
+
+	FindByIDTx(ctx context.Context, tx *gorm.DB, id string) (*model.Review, error)
+
+	UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error
+
+	FindAllPaginated(ctx context.Context, productID string, status string, rating *int, query common.PaginationQuery) ([]*model.Review, int64, error)
+
+	GetRatingSummaryByProductIDTx(ctx context.Context, tx *gorm.DB, productID string) (float32, int64, error)
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type reviewRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewReviewRepository(db *gorm.DB) ReviewRepository {
+	return &reviewRepositoryImpl{db}
+}
+
+func (r *reviewRepositoryImpl) Create(ctx context.Context, review *model.Review) error {
+	return r.db.WithContext(ctx).Create(review).Error
+}
+
+func (r *reviewRepositoryImpl) FindByIDTx(ctx context.Context, tx *gorm.DB, id string) (*model.Review, error) {
+	var review model.Review
+	if err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).Where("id = ?", id).First(&review).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &review, nil
+}
+
+func (r *reviewRepositoryImpl) UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error {
+	result := tx.WithContext(ctx).Model(&model.Review{}).Where("id = ?", id).Updates(updateData)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return common.ErrReviewNotFound
+	}
+
+	return nil
+}
+
+func (r *reviewRepositoryImpl) FindAllPaginated(ctx context.Context, productID string, status string, rating *int, pQuery common.PaginationQuery) ([]*model.Review, int64, error) {
+	var reviews []*model.Review
+	var total int64
+
+	db := r.db.WithContext(ctx).Model(&model.Review{})
+	if productID != "" {
+		db = db.Where("product_id = ?", productID)
+	}
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+	if rating != nil {
+		db = db.Where("rating = ?", *rating)
+	}
+	if pQuery.Search != "" {
+		searchTerm := "%" + strings.ToLower(pQuery.Search) + "%"
+		db = db.Where("LOWER(title) LIKE ? OR LOWER(content) LIKE ?", searchTerm, searchTerm)
+	}
+
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	allowedSorts := map[string]bool{
+		"rating":     true,
+		"created_at": true,
+	}
+	if allowedSorts[pQuery.Sort] {
+		order := "DESC"
+		if strings.ToUpper(pQuery.Order) == "ASC" {
+			order = "ASC"
+		}
+		db = db.Order(pQuery.Sort + " " + order)
+	} else {
+		db = db.Order("created_at DESC")
+	}
+
+	offset := (pQuery.Page - 1) * pQuery.Limit
+	if err := db.Offset(offset).Limit(pQuery.Limit).Find(&reviews).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return reviews, total, nil
+}
+
+func (r *reviewRepositoryImpl) GetRatingSummaryByProductIDTx(ctx context.Context, tx *gorm.DB, productID string) (float32, int64, error) {
+	var summary struct {
+		Average float32
+		Count   int64
+	}
+	if err := tx.WithContext(ctx).Model(&model.Review{}).
+		Select("COALESCE(ROUND(AVG(rating), 2), 0) AS average, COUNT(*) AS count").
+		Where("product_id = ? AND status = ?", productID, common.ReviewStatusApproved).
+		Scan(&summary).Error; err != nil {
+		return 0, 0, err
+	}
+
+	return summary.Average, summary.Count, nil
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+)
+
+type RevisionRepository interface {
+	CreateTx(ctx context.Context, tx *gorm.DB, revision *model.ProductRevision) error
+
+	FindLatestRevisionTx(ctx context.Context, tx *gorm.DB, productID string) (int, error)
+
+	FindAllPaginatedByProductID(ctx context.Context, productID string, query common.PaginationQuery) ([]*model.ProductRevision, int64, error)
+
+	FindByIDAndProductID(ctx context.Context, id, productID string) (*model.ProductRevision, error)
+}
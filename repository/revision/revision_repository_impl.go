@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+)
+
+type revisionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewRevisionRepository(db *gorm.DB) RevisionRepository {
+	return &revisionRepositoryImpl{db}
+}
+
+func (r *revisionRepositoryImpl) CreateTx(ctx context.Context, tx *gorm.DB, revision *model.ProductRevision) error {
+	return tx.WithContext(ctx).Create(revision).Error
+}
+
+func (r *revisionRepositoryImpl) FindLatestRevisionTx(ctx context.Context, tx *gorm.DB, productID string) (int, error) {
+	var revision int
+	if err := tx.WithContext(ctx).Model(&model.ProductRevision{}).
+		Select("COALESCE(MAX(revision), 0)").
+		Where("product_id = ?", productID).
+		Scan(&revision).Error; err != nil {
+		return 0, err
+	}
+
+	return revision, nil
+}
+
+func (r *revisionRepositoryImpl) FindAllPaginatedByProductID(ctx context.Context, productID string, pQuery common.PaginationQuery) ([]*model.ProductRevision, int64, error) {
+	var revisions []*model.ProductRevision
+	var total int64
+
+	db := r.db.WithContext(ctx).Model(&model.ProductRevision{}).Where("product_id = ?", productID)
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (pQuery.Page - 1) * pQuery.Limit
+	if err := db.Order("revision DESC").Offset(offset).Limit(pQuery.Limit).Find(&revisions).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return revisions, total, nil
+}
+
+func (r *revisionRepositoryImpl) FindByIDAndProductID(ctx context.Context, id, productID string) (*model.ProductRevision, error) {
+	var revision model.ProductRevision
+	if err := r.db.WithContext(ctx).Where("id = ? AND product_id = ?", id, productID).First(&revision).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &revision, nil
+}
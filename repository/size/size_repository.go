@@ -10,6 +10,8 @@ import (
 type SizeRepository interface {
 	Create(ctx context.Context, size *model.Size) error
 
+	CreateTx(ctx context.Context, tx *gorm.DB, size *model.Size) error
+
 	FindAll(ctx context.Context) ([]*model.Size, error)
 
 	ExistsByID(ctx context.Context, id string) (bool, error)
@@ -18,13 +20,11 @@ type SizeRepository interface {
 
 	FindByIDTx(ctx context.Context, tx *gorm.DB, id string) (*model.Size, error)
 
-	Update(ctx context.Context, id string, updateData map[string]any) error
-
 	UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error
 
 	FindAllByID(ctx context.Context, ids []string) ([]*model.Size, error)
 
-	UpdateAllByID(ctx context.Context, ids []string, updateData map[string]any) error
+	UpdateAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string, updateData map[string]any) error
 
 	FindAllDeleted(ctx context.Context) ([]*model.Size, error)
 
@@ -32,7 +32,7 @@ type SizeRepository interface {
 
 	FindAllDeletedByID(ctx context.Context, ids []string) ([]*model.Size, error)
 
-	DeleteAllByID(ctx context.Context, ids []string) error
+	DeleteAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string) error
 
-	Delete(ctx context.Context, id string) error
+	DeleteTx(ctx context.Context, tx *gorm.DB, id string) error
 }
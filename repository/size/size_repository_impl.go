@@ -22,6 +22,10 @@ func (r *sizeRepositoryImpl) Create(ctx context.Context, size *model.Size) error
 	return r.db.WithContext(ctx).Create(size).Error
 }
 
+func (r *sizeRepositoryImpl) CreateTx(ctx context.Context, tx *gorm.DB, size *model.Size) error {
+	return tx.WithContext(ctx).Create(size).Error
+}
+
 func (r *sizeRepositoryImpl) FindAll(ctx context.Context) ([]*model.Size, error) {
 	var sizes []*model.Size
 	if err := r.db.WithContext(ctx).Where("is_deleted = false").Find(&sizes).Error; err != nil {
@@ -44,8 +48,8 @@ func (r *sizeRepositoryImpl) ExistsByID(ctx context.Context, id string) (bool, e
 	return count > 0, nil
 }
 
-func (r *sizeRepositoryImpl) Update(ctx context.Context, id string, updateData map[string]any) error {
-	result := r.db.WithContext(ctx).Model(&model.Size{}).Where("id = ?", id).Updates(updateData)
+func (r *sizeRepositoryImpl) UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error {
+	result := tx.WithContext(ctx).Model(&model.Size{}).Where("id = ?", id).Updates(updateData)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -56,10 +60,6 @@ func (r *sizeRepositoryImpl) Update(ctx context.Context, id string, updateData m
 	return nil
 }
 
-func (r *sizeRepositoryImpl) UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error {
-	return tx.WithContext(ctx).Model(&model.Size{}).Where("id = ?", id).Updates(updateData).Error
-}
-
 func (r *sizeRepositoryImpl) FindAllByID(ctx context.Context, ids []string) ([]*model.Size, error) {
 	var size []*model.Size
 	if err := r.db.WithContext(ctx).Where("id IN ? AND is_deleted = false", ids).Find(&size).Error; err != nil {
@@ -78,12 +78,12 @@ func (r *sizeRepositoryImpl) FindAllDeletedByID(ctx context.Context, ids []strin
 	return size, nil
 }
 
-func (r *sizeRepositoryImpl) DeleteAllByID(ctx context.Context, ids []string) error {
-	return r.db.WithContext(ctx).Where("id IN ?", ids).Delete(&model.Size{}).Error
+func (r *sizeRepositoryImpl) DeleteAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string) error {
+	return tx.WithContext(ctx).Where("id IN ?", ids).Delete(&model.Size{}).Error
 }
 
-func (r *sizeRepositoryImpl) Delete(ctx context.Context, id string) error {
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.Size{})
+func (r *sizeRepositoryImpl) DeleteTx(ctx context.Context, tx *gorm.DB, id string) error {
+	result := tx.WithContext(ctx).Where("id = ?", id).Delete(&model.Size{})
 	if result.Error != nil {
 		return result.Error
 	}
@@ -94,8 +94,8 @@ func (r *sizeRepositoryImpl) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (r *sizeRepositoryImpl) UpdateAllByID(ctx context.Context, ids []string, updateData map[string]any) error {
-	return r.db.WithContext(ctx).Model(&model.Size{}).Where("id IN ?", ids).Updates(updateData).Error
+func (r *sizeRepositoryImpl) UpdateAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string, updateData map[string]any) error {
+	return tx.WithContext(ctx).Model(&model.Size{}).Where("id IN ?", ids).Updates(updateData).Error
 }
 
 func (r *sizeRepositoryImpl) FindDeletedByID(ctx context.Context, id string) (*model.Size, error) {
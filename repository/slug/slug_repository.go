@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"gorm.io/gorm"
+)
+
+type SlugRepository interface {
+	LockTx(ctx context.Context, tx *gorm.DB, target common.SlugTarget, stem string) error
+
+	FindAllByPrefixTx(ctx context.Context, tx *gorm.DB, target common.SlugTarget, prefix, excludeID string) ([]string, error)
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"gorm.io/gorm"
+)
+
+type slugRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSlugRepository(db *gorm.DB) SlugRepository {
+	return &slugRepositoryImpl{db}
+}
+
+func (r *slugRepositoryImpl) LockTx(ctx context.Context, tx *gorm.DB, target common.SlugTarget, stem string) error {
+	return tx.WithContext(ctx).Exec("SELECT pg_advisory_xact_lock(hashtext(?))", target.Table+":"+stem).Error
+}
+
+func (r *slugRepositoryImpl) FindAllByPrefixTx(ctx context.Context, tx *gorm.DB, target common.SlugTarget, prefix, excludeID string) ([]string, error) {
+	query := fmt.Sprintf("SELECT slug FROM %s WHERE slug LIKE ? AND id <> ?", target.Table)
+	args := []any{prefix + "%", excludeID}
+	if target.HistoryTable != "" {
+		query += fmt.Sprintf(" UNION SELECT slug FROM %s WHERE slug LIKE ? AND %s <> ?", target.HistoryTable, target.OwnerColumn)
+		args = append(args, prefix+"%", excludeID)
+	}
+
+	var slugs []string
+	if err := tx.WithContext(ctx).Raw(query, args...).Scan(&slugs).Error; err != nil {
+		return nil, err
+	}
+
+	return slugs, nil
+}
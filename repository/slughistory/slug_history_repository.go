@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+)
+
+type SlugHistoryRepository interface {
+	CreateProductSlugTx(ctx context.Context, tx *gorm.DB, history *model.ProductSlugHistory) error
+
+	FindProductSlugWithProduct(ctx context.Context, slug string) (*model.ProductSlugHistory, error)
+
+	DeleteProductSlugTx(ctx context.Context, tx *gorm.DB, productID, slug string) error
+
+	CreateCategorySlugTx(ctx context.Context, tx *gorm.DB, history *model.CategorySlugHistory) error
+
+	FindCategorySlugWithCategory(ctx context.Context, slug string) (*model.CategorySlugHistory, error)
+
+	DeleteCategorySlugTx(ctx context.Context, tx *gorm.DB, categoryID, slug string) error
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+)
+
+type slugHistoryRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSlugHistoryRepository(db *gorm.DB) SlugHistoryRepository {
+	return &slugHistoryRepositoryImpl{db}
+}
+
+func (r *slugHistoryRepositoryImpl) CreateProductSlugTx(ctx context.Context, tx *gorm.DB, history *model.ProductSlugHistory) error {
+	return tx.WithContext(ctx).Create(history).Error
+}
+
+func (r *slugHistoryRepositoryImpl) FindProductSlugWithProduct(ctx context.Context, slug string) (*model.ProductSlugHistory, error) {
+	var history model.ProductSlugHistory
+	if err := r.db.WithContext(ctx).
+		Joins("Product").
+		Where("product_slug_history.slug = ? AND \"Product\".is_deleted = false", slug).
+		First(&history).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &history, nil
+}
+
+func (r *slugHistoryRepositoryImpl) DeleteProductSlugTx(ctx context.Context, tx *gorm.DB, productID, slug string) error {
+	return tx.WithContext(ctx).Where("product_id = ? AND slug = ?", productID, slug).Delete(&model.ProductSlugHistory{}).Error
+}
+
+func (r *slugHistoryRepositoryImpl) CreateCategorySlugTx(ctx context.Context, tx *gorm.DB, history *model.CategorySlugHistory) error {
+	return tx.WithContext(ctx).Create(history).Error
+}
+
+func (r *slugHistoryRepositoryImpl) FindCategorySlugWithCategory(ctx context.Context, slug string) (*model.CategorySlugHistory, error) {
+	var history model.CategorySlugHistory
+	if err := r.db.WithContext(ctx).
+		Joins("Category").
+		Where("category_slug_history.slug = ? AND \"Category\".is_deleted = false", slug).
+		First(&history).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &history, nil
+}
+
+func (r *slugHistoryRepositoryImpl) DeleteCategorySlugTx(ctx context.Context, tx *gorm.DB, categoryID, slug string) error {
+	return tx.WithContext(ctx).Where("category_id = ? AND slug = ?", categoryID, slug).Delete(&model.CategorySlugHistory{}).Error
+}
@@ -10,17 +10,17 @@ import (
 type TagRepository interface {
 	Create(ctx context.Context, tag *model.Tag) error
 
+	CreateTx(ctx context.Context, tx *gorm.DB, tag *model.Tag) error
+
 	FindAll(ctx context.Context) ([]*model.Tag, error)
 
 	FindByID(ctx context.Context, id string) (*model.Tag, error)
 
 	FindByIDTx(ctx context.Context, tx *gorm.DB, id string) (*model.Tag, error)
 
-	Update(ctx context.Context, id string, updateData map[string]any) error
-
 	UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error
 
-	UpdateAllByID(ctx context.Context, ids []string, updateData map[string]any) error
+	UpdateAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string, updateData map[string]any) error
 
 	FindAllByID(ctx context.Context, ids []string) ([]*model.Tag, error)
 
@@ -32,7 +32,7 @@ type TagRepository interface {
 
 	FindAllDeletedByID(ctx context.Context, ids []string) ([]*model.Tag, error)
 
-	DeleteAllByID(ctx context.Context, ids []string) error
+	DeleteAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string) error
 
-	Delete(ctx context.Context, id string) error
+	DeleteTx(ctx context.Context, tx *gorm.DB, id string) error
 }
@@ -31,6 +31,10 @@ func (r *tagRepositoryImpl) Create(ctx context.Context, tag *model.Tag) error {
 	return r.db.WithContext(ctx).Create(tag).Error
 }
 
+func (r *tagRepositoryImpl) CreateTx(ctx context.Context, tx *gorm.DB, tag *model.Tag) error {
+	return tx.WithContext(ctx).Create(tag).Error
+}
+
 func (r *tagRepositoryImpl) FindAll(ctx context.Context) ([]*model.Tag, error) {
 	var tags []*model.Tag
 	if err := r.db.WithContext(ctx).Where("is_deleted = false").Find(&tags).Error; err != nil {
@@ -48,8 +52,8 @@ func (r *tagRepositoryImpl) FindByIDTx(ctx context.Context, tx *gorm.DB, id stri
 	return findByIDBase(ctx, tx, id, &common.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsNoWait})
 }
 
-func (r *tagRepositoryImpl) Update(ctx context.Context, id string, updateData map[string]any) error {
-	result := r.db.WithContext(ctx).Model(&model.Tag{}).Where("id = ?", id).Updates(updateData)
+func (r *tagRepositoryImpl) UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error {
+	result := tx.WithContext(ctx).Model(&model.Tag{}).Where("id = ?", id).Updates(updateData)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -60,12 +64,8 @@ func (r *tagRepositoryImpl) Update(ctx context.Context, id string, updateData ma
 	return nil
 }
 
-func (r *tagRepositoryImpl) UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error {
-	return tx.WithContext(ctx).Model(&model.Tag{}).Where("id = ?", id).Updates(updateData).Error
-}
-
-func (r *tagRepositoryImpl) UpdateAllByID(ctx context.Context, ids []string, updateData map[string]any) error {
-	return r.db.WithContext(ctx).Model(&model.Tag{}).Where("id IN ?", ids).Updates(updateData).Error
+func (r *tagRepositoryImpl) UpdateAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string, updateData map[string]any) error {
+	return tx.WithContext(ctx).Model(&model.Tag{}).Where("id IN ?", ids).Updates(updateData).Error
 }
 
 func (r *tagRepositoryImpl) FindAllByID(ctx context.Context, ids []string) ([]*model.Tag, error) {
@@ -111,12 +111,12 @@ func (r *tagRepositoryImpl) FindAllDeletedByID(ctx context.Context, ids []string
 	return tags, nil
 }
 
-func (r *tagRepositoryImpl) DeleteAllByID(ctx context.Context, ids []string) error {
-	return r.db.WithContext(ctx).Where("id IN ?", ids).Delete(&model.Tag{}).Error
+func (r *tagRepositoryImpl) DeleteAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string) error {
+	return tx.WithContext(ctx).Where("id IN ?", ids).Delete(&model.Tag{}).Error
 }
 
-func (r *tagRepositoryImpl) Delete(ctx context.Context, id string) error {
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.Tag{})
+func (r *tagRepositoryImpl) DeleteTx(ctx context.Context, tx *gorm.DB, id string) error {
+	result := tx.WithContext(ctx).Where("id = ?", id).Delete(&model.Tag{})
 	if result.Error != nil {
 		return result.Error
 	}
@@ -21,4 +21,8 @@ type VariantRepository interface {
 	UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error
 
 	DeleteAllByIDTx(ctx context.Context, tx *gorm.DB, ids []string) error
+
+	FindAllByIDWithProductTx(ctx context.Context, tx *gorm.DB, ids []string) ([]*model.Variant, error)
+
+	FindByID(ctx context.Context, id string) (*model.Variant, error)
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/SomeHowMicroservice/product/common"
 	"github.com/SomeHowMicroservice/product/model"
@@ -49,6 +50,10 @@ func (r *variantRepositoryImpl) DeleteAllByIDTx(ctx context.Context, tx *gorm.DB
 	return tx.WithContext(ctx).Where("id IN ?", ids).Delete(&model.Variant{}).Error
 }
 
+func (r *variantRepositoryImpl) FindAllByIDWithProductTx(ctx context.Context, tx *gorm.DB, ids []string) ([]*model.Variant, error) {
+	return findAllByIDBase(ctx, tx, ids, common.Preload{Relation: "Product"})
+}
+
 func findAllByIDBase(ctx context.Context, tx *gorm.DB, ids []string, preloads ...common.Preload) ([]*model.Variant, error) {
 	var variants []*model.Variant
 	query := tx.WithContext(ctx)
@@ -67,3 +72,15 @@ func findAllByIDBase(ctx context.Context, tx *gorm.DB, ids []string, preloads ..
 
 	return variants, nil
 }
+
+func (r *variantRepositoryImpl) FindByID(ctx context.Context, id string) (*model.Variant, error) {
+	var variant model.Variant
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&variant).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &variant, nil
+}
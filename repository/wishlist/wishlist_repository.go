@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+)
+
+type WishlistRepository interface {
+	Create(ctx context.Context, wishlist *model.Wishlist) error
+
+	FindAllByUserIDWithDetails(ctx context.Context, userID string) ([]*model.Wishlist, error)
+
+	DeleteByIDAndUserID(ctx context.Context, id, userID string) error
+
+	DeleteAllByProductIDTx(ctx context.Context, tx *gorm.DB, productIDs []string) error
+
+	FindAllUserIDsByProductID(ctx context.Context, productID string) ([]string, error)
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/model"
+	"gorm.io/gorm"
+)
+
+type wishlistRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewWishlistRepository(db *gorm.DB) WishlistRepository {
+	return &wishlistRepositoryImpl{db}
+}
+
+func (r *wishlistRepositoryImpl) Create(ctx context.Context, wishlist *model.Wishlist) error {
+	return r.db.WithContext(ctx).Create(wishlist).Error
+}
+
+func (r *wishlistRepositoryImpl) FindAllByUserIDWithDetails(ctx context.Context, userID string) ([]*model.Wishlist, error) {
+	var wishlists []*model.Wishlist
+	if err := r.db.WithContext(ctx).
+		Joins("JOIN products p ON p.id = wishlists.product_id AND p.is_deleted = false").
+		Preload("Product").
+		Preload("Product.Images", getThumbnail).
+		Preload("Product.Variants").
+		Preload("Product.Variants.Inventory").
+		Preload("Product.BundleItems").
+		Preload("Product.BundleItems.Variant").
+		Preload("Product.BundleItems.Variant.Inventory").
+		Preload("Variant").
+		Preload("Variant.Color").
+		Preload("Variant.Size").
+		Preload("Variant.Inventory").
+		Where("wishlists.user_id = ?", userID).
+		Order("wishlists.created_at DESC").
+		Find(&wishlists).Error; err != nil {
+		return nil, err
+	}
+
+	return wishlists, nil
+}
+
+func (r *wishlistRepositoryImpl) DeleteByIDAndUserID(ctx context.Context, id, userID string) error {
+	result := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).Delete(&model.Wishlist{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return common.ErrWishlistItemNotFound
+	}
+
+	return nil
+}
+
+func (r *wishlistRepositoryImpl) DeleteAllByProductIDTx(ctx context.Context, tx *gorm.DB, productIDs []string) error {
+	return tx.WithContext(ctx).Where("product_id IN ?", productIDs).Delete(&model.Wishlist{}).Error
+}
+
+func (r *wishlistRepositoryImpl) FindAllUserIDsByProductID(ctx context.Context, productID string) ([]string, error) {
+	var userIDs []string
+	if err := r.db.WithContext(ctx).Model(&model.Wishlist{}).Where("product_id = ?", productID).Distinct().Pluck("user_id", &userIDs).Error; err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+func getThumbnail(db *gorm.DB) *gorm.DB {
+	return db.Where("is_thumbnail = true")
+}
@@ -0,0 +1,71 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	idempotencyRepo "github.com/SomeHowMicroservice/product/repository/idempotency"
+	"go.opentelemetry.io/otel"
+)
+
+type IdempotencyCleaner struct {
+	idempotencyRepo idempotencyRepo.IdempotencyRepository
+	interval        time.Duration
+	stop            chan struct{}
+	done            chan struct{}
+}
+
+func NewIdempotencyCleaner(idempotencyRepo idempotencyRepo.IdempotencyRepository, interval time.Duration) *IdempotencyCleaner {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+
+	return &IdempotencyCleaner{
+		idempotencyRepo,
+		interval,
+		make(chan struct{}),
+		make(chan struct{}),
+	}
+}
+
+func (c *IdempotencyCleaner) Start() {
+	go func() {
+		defer close(c.done)
+
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		c.run()
+		for {
+			select {
+			case <-c.stop:
+				return
+			case <-ticker.C:
+				c.run()
+			}
+		}
+	}()
+}
+
+func (c *IdempotencyCleaner) Stop() {
+	close(c.stop)
+	<-c.done
+}
+
+func (c *IdempotencyCleaner) run() {
+	ctx, cancel := context.WithTimeout(context.Background(), c.interval)
+	defer cancel()
+
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "IdempotencyCleaner.run")
+	defer span.End()
+
+	deleted, err := c.idempotencyRepo.DeleteAllExpired(ctx, time.Now())
+	if err != nil {
+		log.Printf("xóa idempotency key hết hạn thất bại: %v", err)
+		return
+	}
+	if deleted > 0 {
+		log.Printf("đã xóa %d idempotency key hết hạn", deleted)
+	}
+}
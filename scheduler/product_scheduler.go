@@ -0,0 +1,131 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/model"
+	"github.com/SomeHowMicroservice/product/mq"
+	productRepo "github.com/SomeHowMicroservice/product/repository/product"
+	wishlistRepo "github.com/SomeHowMicroservice/product/repository/wishlist"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"go.opentelemetry.io/otel"
+)
+
+const tracerName = "github.com/SomeHowMicroservice/product/scheduler"
+
+type ProductScheduler struct {
+	productRepo  productRepo.ProductRepository
+	wishlistRepo wishlistRepo.WishlistRepository
+	publisher    message.Publisher
+	interval     time.Duration
+	stop         chan struct{}
+	done         chan struct{}
+}
+
+func NewProductScheduler(productRepo productRepo.ProductRepository, wishlistRepo wishlistRepo.WishlistRepository, publisher message.Publisher, interval time.Duration) *ProductScheduler {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	return &ProductScheduler{
+		productRepo,
+		wishlistRepo,
+		publisher,
+		interval,
+		make(chan struct{}),
+		make(chan struct{}),
+	}
+}
+
+func (s *ProductScheduler) Start() {
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.run()
+		for {
+			select {
+			case <-s.stop:
+				return
+			case <-ticker.C:
+				s.run()
+			}
+		}
+	}()
+}
+
+func (s *ProductScheduler) Stop() {
+	close(s.stop)
+	<-s.done
+}
+
+func (s *ProductScheduler) run() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.interval)
+	defer cancel()
+
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "ProductScheduler.run")
+	defer span.End()
+
+	now := time.Now()
+
+	published, err := s.productRepo.PublishAllScheduled(ctx, now)
+	if err != nil {
+		log.Printf("đăng bán sản phẩm theo lịch thất bại: %v", err)
+	}
+	s.publishEvents(ctx, published, now)
+
+	archived, err := s.productRepo.ArchiveAllExpired(ctx, now)
+	if err != nil {
+		log.Printf("ngừng bán sản phẩm theo lịch thất bại: %v", err)
+	}
+	s.publishEvents(ctx, archived, now)
+
+	saleStarted, err := s.productRepo.ClaimAllSaleStarted(ctx, now)
+	if err != nil {
+		log.Printf("lấy danh sách sản phẩm bắt đầu giảm giá thất bại: %v", err)
+	}
+	s.publishPriceDrops(ctx, saleStarted)
+}
+
+func (s *ProductScheduler) publishEvents(ctx context.Context, products []*model.Product, changedAt time.Time) {
+	for _, product := range products {
+		if err := mq.PublishProductStatusChanged(ctx, s.publisher, &common.ProductStatusChangedEvent{
+			ProductID: product.ID,
+			Title:     product.Title,
+			Slug:      product.Slug,
+			Status:    product.Status,
+			ChangedAt: changedAt,
+		}); err != nil {
+			log.Printf("publish sự kiện thay đổi trạng thái sản phẩm %s thất bại: %v", product.ID, err)
+		}
+	}
+}
+
+func (s *ProductScheduler) publishPriceDrops(ctx context.Context, products []*model.Product) {
+	for _, product := range products {
+		userIDs, err := s.wishlistRepo.FindAllUserIDsByProductID(ctx, product.ID)
+		if err != nil {
+			log.Printf("lấy danh sách người dùng yêu thích sản phẩm %s thất bại: %v", product.ID, err)
+			continue
+		}
+		if len(userIDs) == 0 {
+			continue
+		}
+
+		if err = mq.PublishPriceDrop(ctx, s.publisher, &common.PriceDropEvent{
+			ProductID: product.ID,
+			Title:     product.Title,
+			Slug:      product.Slug,
+			OldPrice:  product.Price,
+			NewPrice:  *product.SalePrice,
+			UserIDs:   userIDs,
+		}); err != nil {
+			log.Printf("publish price drop msg cho sản phẩm %s thất bại: %v", product.ID, err)
+		}
+	}
+}
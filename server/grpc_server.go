@@ -3,25 +3,40 @@ package server
 import (
 	"time"
 
+	"buf.build/go/protovalidate"
+	"github.com/SomeHowMicroservice/product/cache"
 	"github.com/SomeHowMicroservice/product/config"
 	"github.com/SomeHowMicroservice/product/container"
 	"github.com/SomeHowMicroservice/product/imagekit"
+	"github.com/SomeHowMicroservice/product/interceptor"
 	productpb "github.com/SomeHowMicroservice/product/protobuf/product"
 	userpb "github.com/SomeHowMicroservice/product/protobuf/user"
+	auditRepo "github.com/SomeHowMicroservice/product/repository/audit"
+	categoryRepo "github.com/SomeHowMicroservice/product/repository/category"
+	idempotencyRepo "github.com/SomeHowMicroservice/product/repository/idempotency"
 	imageRepo "github.com/SomeHowMicroservice/product/repository/image"
+	productRepo "github.com/SomeHowMicroservice/product/repository/product"
 	"github.com/ThreeDotsLabs/watermill/message"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
 	"gorm.io/gorm"
 )
 
 type GRPCServer struct {
-	Server    *grpc.Server
-	ImageKit  imagekit.ImageKitService
-	ImageRepo imageRepo.ImageRepository
+	Server          *grpc.Server
+	ImageKit        imagekit.ImageKitService
+	ImageRepo       imageRepo.ImageRepository
+	CategoryRepo    categoryRepo.CategoryRepository
+	ProductRepo     productRepo.ProductRepository
+	IdempotencyRepo idempotencyRepo.IdempotencyRepository
+	Health          *health.Server
 }
 
-func NewGRPCServer(cfg *config.Config, db *gorm.DB, publisher message.Publisher, userClient userpb.UserServiceClient) *GRPCServer {
+func NewGRPCServer(cfg *config.Config, db *gorm.DB, publisher message.Publisher, userClient userpb.UserServiceClient, cache cache.CacheService) *GRPCServer {
 	kaParams := keepalive.ServerParameters{
 		Time:                  5 * time.Minute,
 		Timeout:               20 * time.Second,
@@ -35,18 +50,47 @@ func NewGRPCServer(cfg *config.Config, db *gorm.DB, publisher message.Publisher,
 		PermitWithoutStream: true,
 	}
 
+	auditRepo := auditRepo.NewAuditRepository(db)
+	idempotencyRepo := idempotencyRepo.NewIdempotencyRepository(db)
+	authInterceptor := interceptor.NewAuthInterceptor(cfg, userClient, cache)
+
 	grpcServer := grpc.NewServer(
 		grpc.KeepaliveParams(kaParams),
 		grpc.KeepaliveEnforcementPolicy(kaPolicy),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			interceptor.MetricsUnaryInterceptor(),
+			interceptor.LocaleUnaryInterceptor(cfg.Locale.Fallback),
+			authInterceptor.Unary(),
+			interceptor.ValidationUnaryInterceptor(protovalidate.GlobalValidator),
+			interceptor.IdempotencyUnaryInterceptor(idempotencyRepo, cfg.Idempotency.TTL, cfg.Idempotency.Lease),
+			interceptor.AuditUnaryInterceptor(auditRepo),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptor.MetricsStreamInterceptor(),
+			interceptor.LocaleStreamInterceptor(cfg.Locale.Fallback),
+			authInterceptor.Stream(),
+		),
 	)
 
-	productContainer := container.NewContainer(cfg, db, publisher, grpcServer, userClient)
+	productContainer := container.NewContainer(cfg, db, publisher, grpcServer, userClient, cache, auditRepo)
 
 	productpb.RegisterProductServiceServer(grpcServer, productContainer.GRPCHandler)
 
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	if cfg.App.EnableReflection {
+		reflection.Register(grpcServer)
+	}
+
 	return &GRPCServer{
 		grpcServer,
 		productContainer.ImageKit,
 		productContainer.ImageRepo,
+		productContainer.CategoryRepo,
+		productContainer.ProductRepo,
+		idempotencyRepo,
+		healthServer,
 	}
 }
@@ -10,8 +10,15 @@ import (
 	"time"
 
 	"github.com/SomeHowMicroservice/product/config"
+	"github.com/SomeHowMicroservice/product/healthcheck"
 	"github.com/SomeHowMicroservice/product/initialization"
+	"github.com/SomeHowMicroservice/product/metrics"
 	"github.com/SomeHowMicroservice/product/mq"
+	productpb "github.com/SomeHowMicroservice/product/protobuf/product"
+	inventoryRepo "github.com/SomeHowMicroservice/product/repository/inventory"
+	wishlistRepo "github.com/SomeHowMicroservice/product/repository/wishlist"
+	"github.com/SomeHowMicroservice/product/scheduler"
+	"github.com/SomeHowMicroservice/product/tracing"
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
@@ -28,14 +35,30 @@ type Server struct {
 	clients    *initialization.GRPCClients
 	router     *message.Router
 	watermill  *initialization.WatermillConnection
+	cache      *initialization.Cache
+	scheduler  *scheduler.ProductScheduler
+	cleaner    *scheduler.IdempotencyCleaner
+	health     *healthcheck.HealthChecker
+	metrics    *metrics.Server
+	tracer     *initialization.Tracer
 }
 
 func NewServer(cfg *config.Config) (*Server, error) {
+	tracer, err := initialization.InitTracer(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	db, err := initialization.InitDB(cfg)
 	if err != nil {
 		return nil, err
 	}
 
+	cache, err := initialization.InitCache(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	userAddr = fmt.Sprintf("%s:%d", cfg.App.ServerHost, cfg.Services.UserPort)
 	clients, err := initialization.InitClients(userAddr)
 	if err != nil {
@@ -55,6 +78,8 @@ func NewServer(cfg *config.Config) (*Server, error) {
 
 	router.AddMiddleware(
 		middleware.CorrelationID,
+		tracing.HandlerMiddleware,
+		metrics.HandlerMiddleware,
 		middleware.Retry{
 			MaxRetries:      5,
 			InitialInterval: time.Microsecond,
@@ -62,6 +87,7 @@ func NewServer(cfg *config.Config) (*Server, error) {
 			MaxInterval:     5 * time.Microsecond,
 			Logger:          logger,
 		}.Middleware,
+		metrics.RetryMiddleware,
 		middleware.Recoverer,
 	)
 
@@ -70,9 +96,9 @@ func NewServer(cfg *config.Config) (*Server, error) {
 		return nil, err
 	}
 
-	grpcServer := NewGRPCServer(cfg, db.Gorm, wm.Publisher, clients.UserClient)
+	grpcServer := NewGRPCServer(cfg, db.Gorm, wm.Publisher, clients.UserClient, cache.Service)
 
-	mq.RegisterUploadImageConsumer(router, wm.Publisher, wm.Subscriber, grpcServer.ImageKit, grpcServer.ImageRepo)
+	mq.RegisterUploadImageConsumer(router, wm.Publisher, wm.Subscriber, grpcServer.ImageKit, grpcServer.ImageRepo, grpcServer.CategoryRepo, cache.Service)
 	mq.RegisterDeleteImageConsumer(router, wm.Subscriber, grpcServer.ImageKit)
 
 	go func() {
@@ -81,6 +107,29 @@ func NewServer(cfg *config.Config) (*Server, error) {
 		}
 	}()
 
+	productScheduler := scheduler.NewProductScheduler(grpcServer.ProductRepo, wishlistRepo.NewWishlistRepository(db.Gorm), wm.Publisher, cfg.Scheduler.Interval)
+	productScheduler.Start()
+
+	idempotencyCleaner := scheduler.NewIdempotencyCleaner(grpcServer.IdempotencyRepo, cfg.Idempotency.CleanupInterval)
+	idempotencyCleaner.Start()
+
+	healthChecker := healthcheck.NewHealthChecker(grpcServer.Health, []string{productpb.ProductService_ServiceDesc.ServiceName}, cfg.Health.Interval, cfg.Health.Timeout)
+	healthChecker.AddCheck("postgres", db.Ping, true)
+	healthChecker.AddCheck("rabbitmq", wm.Ping, true)
+	healthChecker.AddCheck("user-service", clients.PingUserService, false)
+	healthChecker.AddCheck("imagekit", grpcServer.ImageKit.Ping, false)
+	healthChecker.Start()
+
+	var metricsServer *metrics.Server
+	if cfg.Metrics.Port > 0 {
+		if err := metrics.RegisterInventoryCollector(inventoryRepo.NewInventoryRepository(db.Gorm)); err != nil {
+			return nil, fmt.Errorf("đăng ký metrics tồn kho thất bại: %w", err)
+		}
+
+		metricsServer = metrics.NewServer(cfg.Metrics.Port)
+		metricsServer.Start()
+	}
+
 	return &Server{
 		grpcServer,
 		lis,
@@ -88,6 +137,12 @@ func NewServer(cfg *config.Config) (*Server, error) {
 		clients,
 		router,
 		wm,
+		cache,
+		productScheduler,
+		idempotencyCleaner,
+		healthChecker,
+		metricsServer,
+		tracer,
 	}, nil
 }
 
@@ -98,6 +153,16 @@ func (s *Server) Start() error {
 func (s *Server) Shutdown(ctx context.Context) {
 	log.Println("Đang shutdown service...")
 
+	if s.health != nil {
+		s.health.Shutdown()
+	}
+
+	if s.scheduler != nil {
+		s.scheduler.Stop()
+	}
+	if s.cleaner != nil {
+		s.cleaner.Stop()
+	}
 	if s.router != nil {
 		s.router.Close()
 	}
@@ -119,6 +184,9 @@ func (s *Server) Shutdown(ctx context.Context) {
 			log.Println("Đã shutdown gRPC server")
 		}
 	}
+	if s.metrics != nil {
+		s.metrics.Shutdown(ctx)
+	}
 	if s.lis != nil {
 		s.lis.Close()
 	}
@@ -128,6 +196,12 @@ func (s *Server) Shutdown(ctx context.Context) {
 	if s.clients != nil {
 		s.clients.Close()
 	}
+	if s.cache != nil {
+		s.cache.Close()
+	}
+	if s.tracer != nil {
+		s.tracer.Shutdown(ctx)
+	}
 
 	log.Println("Shutdown service thành công")
 }
@@ -17,7 +17,7 @@ type ProductService interface {
 
 	GetCategoriesNoProduct(ctx context.Context) ([]*model.Category, error)
 
-	GetProductBySlug(ctx context.Context, productSlug string) (*model.Product, error)
+	GetProductBySlug(ctx context.Context, productSlug string) (*model.Product, string, error)
 
 	CreateColor(ctx context.Context, req *productpb.CreateColorRequest) (string, error)
 
@@ -122,4 +122,82 @@ type ProductService interface {
 	PermanentlyDeleteTags(ctx context.Context, req *productpb.PermanentlyDeleteManyRequest) error
 
 	GetImagesByProductID(ctx context.Context, productID string) ([]*model.Image, error)
+
+	UpdateBundleItems(ctx context.Context, req *productpb.UpdateBundleItemsRequest) error
+
+	GetBundleItems(ctx context.Context, bundleID string) (*model.Product, error)
+
+	SellBundle(ctx context.Context, req *productpb.SellBundleRequest) error
+
+	ReserveBundle(ctx context.Context, req *productpb.ReserveBundleRequest) error
+
+	ReleaseBundle(ctx context.Context, req *productpb.ReleaseBundleRequest) error
+
+	AddProductRelations(ctx context.Context, req *productpb.AddProductRelationsRequest) error
+
+	RemoveProductRelations(ctx context.Context, req *productpb.RemoveProductRelationsRequest) error
+
+	GetProductRelations(ctx context.Context, productID string) ([]*model.ProductRelation, error)
+
+	GetRelatedProducts(ctx context.Context, req *productpb.GetRelatedProductsRequest) ([]*model.ProductRelation, error)
+
+	CreateReview(ctx context.Context, req *productpb.CreateReviewRequest) (string, error)
+
+	GetProductReviews(ctx context.Context, req *productpb.GetProductReviewsRequest) (*productpb.ReviewsPublicResponse, error)
+
+	GetAllReviewsAdmin(ctx context.Context, req *productpb.GetAllReviewsAdminRequest) (*productpb.ReviewsAdminResponse, error)
+
+	ModerateReview(ctx context.Context, req *productpb.ModerateReviewRequest) error
+
+	CreateQuestion(ctx context.Context, req *productpb.CreateQuestionRequest) (string, error)
+
+	CreateAnswer(ctx context.Context, req *productpb.CreateAnswerRequest) (string, error)
+
+	UpvoteQuestion(ctx context.Context, req *productpb.UpvoteRequest) error
+
+	UpvoteAnswer(ctx context.Context, req *productpb.UpvoteRequest) error
+
+	GetProductQuestions(ctx context.Context, req *productpb.GetProductQuestionsRequest) (*productpb.QuestionsPublicResponse, error)
+
+	GetQuestionAnswers(ctx context.Context, req *productpb.GetQuestionAnswersRequest) (*productpb.AnswersPublicResponse, error)
+
+	GetAllQuestionsAdmin(ctx context.Context, req *productpb.GetAllQuestionsAdminRequest) (*productpb.QuestionsAdminResponse, error)
+
+	GetAllAnswersAdmin(ctx context.Context, req *productpb.GetAllAnswersAdminRequest) (*productpb.AnswersAdminResponse, error)
+
+	ModerateQuestion(ctx context.Context, req *productpb.ModerateRequest) error
+
+	ModerateAnswer(ctx context.Context, req *productpb.ModerateRequest) error
+
+	AddToWishlist(ctx context.Context, req *productpb.AddToWishlistRequest) (string, error)
+
+	RemoveFromWishlist(ctx context.Context, req *productpb.RemoveFromWishlistRequest) error
+
+	GetWishlist(ctx context.Context, userID string) ([]*model.Wishlist, error)
+
+	DeleteCategory(ctx context.Context, req *productpb.DeleteOneRequest) error
+
+	DeleteCategories(ctx context.Context, req *productpb.DeleteManyRequest) error
+
+	GetDeletedCategories(ctx context.Context) (*productpb.CategoriesAdminResponse, error)
+
+	RestoreCategory(ctx context.Context, req *productpb.RestoreOneRequest) error
+
+	RestoreCategories(ctx context.Context, req *productpb.RestoreManyRequest) error
+
+	MoveCategory(ctx context.Context, req *productpb.MoveCategoryRequest) error
+
+	ReorderCategoryChildren(ctx context.Context, req *productpb.ReorderCategoryChildrenRequest) error
+
+	GetCategoryBySlug(ctx context.Context, slug string) (*productpb.CategoryPublicDetailsResponse, error)
+
+	DuplicateProduct(ctx context.Context, req *productpb.DuplicateProductRequest) (string, error)
+
+	GetProductRevisions(ctx context.Context, req *productpb.GetProductRevisionsRequest) (*productpb.ProductRevisionsResponse, error)
+
+	DiffProductRevisions(ctx context.Context, req *productpb.DiffProductRevisionsRequest) (*productpb.ProductRevisionDiffResponse, error)
+
+	RollbackProductRevision(ctx context.Context, req *productpb.RollbackProductRevisionRequest) (*productpb.ProductAdminDetailsResponse, error)
+
+	GetAuditLogs(ctx context.Context, req *productpb.GetAuditLogsRequest) (*productpb.AuditLogsResponse, error)
 }
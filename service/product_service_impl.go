@@ -2,28 +2,44 @@ package service
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log"
+	"maps"
 	"path/filepath"
+	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/SomeHowMicroservice/product/cache"
 	"github.com/SomeHowMicroservice/product/common"
 	"github.com/SomeHowMicroservice/product/config"
 	"github.com/SomeHowMicroservice/product/model"
 	"github.com/SomeHowMicroservice/product/mq"
 	productpb "github.com/SomeHowMicroservice/product/protobuf/product"
 	userpb "github.com/SomeHowMicroservice/product/protobuf/user"
+	answerRepo "github.com/SomeHowMicroservice/product/repository/answer"
+	auditRepo "github.com/SomeHowMicroservice/product/repository/audit"
+	bundleRepo "github.com/SomeHowMicroservice/product/repository/bundle"
 	categoryRepo "github.com/SomeHowMicroservice/product/repository/category"
 	colorRepo "github.com/SomeHowMicroservice/product/repository/color"
 	imageRepo "github.com/SomeHowMicroservice/product/repository/image"
 	inventoryRepo "github.com/SomeHowMicroservice/product/repository/inventory"
 	productRepo "github.com/SomeHowMicroservice/product/repository/product"
+	questionRepo "github.com/SomeHowMicroservice/product/repository/question"
+	relationRepo "github.com/SomeHowMicroservice/product/repository/relation"
+	reviewRepo "github.com/SomeHowMicroservice/product/repository/review"
+	revisionRepo "github.com/SomeHowMicroservice/product/repository/revision"
 	sizeRepo "github.com/SomeHowMicroservice/product/repository/size"
+	slugRepo "github.com/SomeHowMicroservice/product/repository/slug"
+	slugHistoryRepo "github.com/SomeHowMicroservice/product/repository/slughistory"
 	tagRepo "github.com/SomeHowMicroservice/product/repository/tag"
 	variantRepo "github.com/SomeHowMicroservice/product/repository/variant"
+	wishlistRepo "github.com/SomeHowMicroservice/product/repository/wishlist"
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/bytedance/sonic"
 	"github.com/google/uuid"
@@ -34,22 +50,35 @@ import (
 	"gorm.io/gorm"
 )
 
+var uniqueViolationDetailPattern = regexp.MustCompile(`^Key \((.+)\)=\((.*)\) already exists\.?$`)
+
 type productServiceImpl struct {
-	cfg           *config.Config
-	db            *gorm.DB
-	userClient    userpb.UserServiceClient
-	publisher     message.Publisher
-	categoryRepo  categoryRepo.CategoryRepository
-	productRepo   productRepo.ProductRepository
-	tagRepo       tagRepo.TagRepository
-	colorRepo     colorRepo.ColorRepository
-	sizeRepo      sizeRepo.SizeRepository
-	variantRepo   variantRepo.VariantRepository
-	inventoryRepo inventoryRepo.InventoryRepository
-	imageRepo     imageRepo.ImageRepository
-}
-
-func NewProductService(cfg *config.Config, db *gorm.DB, userClient userpb.UserServiceClient, publisher message.Publisher, categoryRepo categoryRepo.CategoryRepository, productRepo productRepo.ProductRepository, tagRepo tagRepo.TagRepository, colorRepo colorRepo.ColorRepository, sizeRepo sizeRepo.SizeRepository, variantRepo variantRepo.VariantRepository, inventoryRepo inventoryRepo.InventoryRepository, imageRepo imageRepo.ImageRepository) ProductService {
+	cfg             *config.Config
+	db              *gorm.DB
+	userClient      userpb.UserServiceClient
+	publisher       message.Publisher
+	categoryRepo    categoryRepo.CategoryRepository
+	productRepo     productRepo.ProductRepository
+	tagRepo         tagRepo.TagRepository
+	colorRepo       colorRepo.ColorRepository
+	sizeRepo        sizeRepo.SizeRepository
+	variantRepo     variantRepo.VariantRepository
+	inventoryRepo   inventoryRepo.InventoryRepository
+	imageRepo       imageRepo.ImageRepository
+	bundleRepo      bundleRepo.BundleRepository
+	relationRepo    relationRepo.RelationRepository
+	reviewRepo      reviewRepo.ReviewRepository
+	questionRepo    questionRepo.QuestionRepository
+	answerRepo      answerRepo.AnswerRepository
+	wishlistRepo    wishlistRepo.WishlistRepository
+	slugHistoryRepo slugHistoryRepo.SlugHistoryRepository
+	slugRepo        slugRepo.SlugRepository
+	revisionRepo    revisionRepo.RevisionRepository
+	auditRepo       auditRepo.AuditRepository
+	cache           cache.CacheService
+}
+
+func NewProductService(cfg *config.Config, db *gorm.DB, userClient userpb.UserServiceClient, publisher message.Publisher, categoryRepo categoryRepo.CategoryRepository, productRepo productRepo.ProductRepository, tagRepo tagRepo.TagRepository, colorRepo colorRepo.ColorRepository, sizeRepo sizeRepo.SizeRepository, variantRepo variantRepo.VariantRepository, inventoryRepo inventoryRepo.InventoryRepository, imageRepo imageRepo.ImageRepository, bundleRepo bundleRepo.BundleRepository, relationRepo relationRepo.RelationRepository, reviewRepo reviewRepo.ReviewRepository, questionRepo questionRepo.QuestionRepository, answerRepo answerRepo.AnswerRepository, wishlistRepo wishlistRepo.WishlistRepository, slugHistoryRepo slugHistoryRepo.SlugHistoryRepository, slugRepo slugRepo.SlugRepository, revisionRepo revisionRepo.RevisionRepository, auditRepo auditRepo.AuditRepository, cache cache.CacheService) ProductService {
 	return &productServiceImpl{
 		cfg,
 		db,
@@ -63,17 +92,32 @@ func NewProductService(cfg *config.Config, db *gorm.DB, userClient userpb.UserSe
 		variantRepo,
 		inventoryRepo,
 		imageRepo,
+		bundleRepo,
+		relationRepo,
+		reviewRepo,
+		questionRepo,
+		answerRepo,
+		wishlistRepo,
+		slugHistoryRepo,
+		slugRepo,
+		revisionRepo,
+		auditRepo,
+		cache,
 	}
 }
 
 func (s *productServiceImpl) CreateCategory(ctx context.Context, req *productpb.CreateCategoryRequest) (string, error) {
-	if req.Slug == nil {
-		slug := common.GenerateSlug(req.Name)
-		req.Slug = &slug
+	slugSource := req.Name
+	if req.Slug != nil {
+		slugSource = *req.Slug
+	}
+
+	slugMode, err := resolveSlugMode(req.SlugMode, req.Slug != nil)
+	if err != nil {
+		return "", err
 	}
 
 	var parents []*model.Category
-	var err error
 	if len(req.ParentIds) > 0 {
 		if err = s.validateParentRelations(ctx, req.ParentIds); err != nil {
 			return "", err
@@ -84,53 +128,129 @@ func (s *productServiceImpl) CreateCategory(ctx context.Context, req *productpb.
 			return "", fmt.Errorf("tìm kiếm danh mục sản phẩm cha thất bại: %w", err)
 		}
 		if len(parents) != len(req.ParentIds) {
-			return "", common.ErrHasCategoryNotFound
+			return "", missingIDsError(common.ErrHasCategoryNotFound, req.ParentIds, getIDsFromCategories(parents))
 		}
 	}
 
-	category := &model.Category{
-		ID:          uuid.NewString(),
-		Name:        req.Name,
-		Slug:        *req.Slug,
-		Parents:     parents,
-		CreatedByID: req.UserId,
-		UpdatedByID: req.UserId,
+	if err = s.validateFeaturedProducts(ctx, req.FeaturedProductIds); err != nil {
+		return "", err
 	}
-	if err = s.categoryRepo.Create(ctx, category); err != nil {
-		if isUniqueViolation(err) {
-			return "", common.ErrSlugAlreadyExists
+
+	isVisible := true
+	if req.IsVisible != nil {
+		isVisible = *req.IsVisible
+	}
+
+	translations, err := toTranslations(common.TranslationEntityCategory, req.Translations)
+	if err != nil {
+		return "", err
+	}
+
+	category := &model.Category{
+		ID:              uuid.NewString(),
+		Name:            req.Name,
+		Description:     req.Description,
+		MetaTitle:       req.MetaTitle,
+		MetaDescription: req.MetaDescription,
+		Translations:    model.Translations{}.Merge(translations),
+		IsVisible:       isVisible,
+		CreatedByID:     req.UserId,
+		UpdatedByID:     req.UserId,
+	}
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		slug, err := s.allocateSlugTx(ctx, tx, common.CategorySlugTarget, slugSource, "", slugMode)
+		if err != nil {
+			return err
+		}
+		category.Slug = slug
+
+		if err := s.categoryRepo.CreateTx(ctx, tx, category); err != nil {
+			if isUniqueViolation(err) {
+				return uniqueViolationError(err, common.ErrSlugAlreadyExists)
+			}
+			return fmt.Errorf("tạo danh mục sản phẩm thất bại: %w", err)
+		}
+
+		if !isVisible {
+			if err := s.categoryRepo.UpdateTx(ctx, tx, category.ID, map[string]any{"is_visible": false}); err != nil {
+				return fmt.Errorf("cập nhật danh mục sản phẩm thất bại: %w", err)
+			}
+		}
+
+		if err := s.categoryRepo.AddParentsTx(ctx, tx, category.ID, getIDsFromCategories(parents)); err != nil {
+			return fmt.Errorf("gán danh mục cha thất bại: %w", err)
+		}
+
+		if err := s.categoryRepo.UpdateFeaturedProductsTx(ctx, tx, category.ID, req.FeaturedProductIds); err != nil {
+			return fmt.Errorf("cập nhật sản phẩm nổi bật thất bại: %w", err)
 		}
-		return "", fmt.Errorf("tạo danh mục sản phẩm thất bại: %w", err)
+
+		return nil
+	}); err != nil {
+		return "", err
 	}
 
+	if req.Banner != nil {
+		s.publishCategoryBannerUpload(ctx, category, req.Banner, req.UserId, "")
+	}
+
+	s.invalidateCategoryCache(ctx)
+
 	return category.ID, nil
 }
 
 func (s *productServiceImpl) GetCategoryTree(ctx context.Context) ([]*model.Category, error) {
-	categories, err := s.categoryRepo.FindAllWithParentsAndChildren(ctx)
+	var roots []*model.Category
+	if s.getCache(ctx, common.CategoryTreeCacheKey, &roots) {
+		localizeCategories(ctx, roots)
+		return roots, nil
+	}
+
+	categories, err := s.categoryRepo.FindAll(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("lấy tất cả danh mục sản phẩm thất bại: %w", err)
 	}
 
+	edges, err := s.categoryRepo.FindAllParentEdges(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("lấy quan hệ danh mục sản phẩm thất bại: %w", err)
+	}
+
 	catMap := make(map[string]*model.Category)
 	for _, c := range categories {
+		if !c.IsVisible {
+			continue
+		}
 		c.Children = nil
 		catMap[c.ID] = c
 	}
 
-	var roots []*model.Category
+	hasParent := make(map[string]struct{})
+	for _, e := range edges {
+		hasParent[e.ChildID] = struct{}{}
+
+		parent, ok := catMap[e.ParentID]
+		if !ok {
+			continue
+		}
+		if child, ok := catMap[e.ChildID]; ok {
+			parent.Children = append(parent.Children, child)
+		}
+	}
+
 	for _, c := range categories {
-		if len(c.Parents) == 0 {
+		if _, ok := hasParent[c.ID]; !ok && c.IsVisible {
 			roots = append(roots, c)
-		} else {
-			for _, p := range c.Parents {
-				if parent, ok := catMap[p.ID]; ok {
-					parent.Children = append(parent.Children, c)
-				}
-			}
 		}
 	}
 
+	slices.SortStableFunc(roots, func(a, b *model.Category) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	s.setCache(ctx, common.CategoryTreeCacheKey, roots)
+	localizeCategories(ctx, roots)
+
 	return roots, nil
 }
 
@@ -150,55 +270,127 @@ func (s *productServiceImpl) GetCategoriesNoProduct(ctx context.Context) ([]*mod
 	return noProductCategories, nil
 }
 
-func (s *productServiceImpl) GetProductBySlug(ctx context.Context, productSlug string) (*model.Product, error) {
+func (s *productServiceImpl) GetProductBySlug(ctx context.Context, productSlug string) (*model.Product, string, error) {
 	product, err := s.productRepo.FindBySlugWithDetails(ctx, productSlug)
 	if err != nil {
-		return nil, fmt.Errorf("lấy sản phẩm thất bại: %w", err)
+		return nil, "", fmt.Errorf("lấy sản phẩm thất bại: %w", err)
 	}
+
+	var redirectTo string
 	if product == nil {
-		return nil, common.ErrProductNotFound
+		history, err := s.slugHistoryRepo.FindProductSlugWithProduct(ctx, productSlug)
+		if err != nil {
+			return nil, "", fmt.Errorf("tìm kiếm lịch sử slug sản phẩm thất bại: %w", err)
+		}
+		if history == nil {
+			return nil, "", common.ErrProductNotFound
+		}
+
+		product, err = s.productRepo.FindBySlugWithDetails(ctx, history.Product.Slug)
+		if err != nil {
+			return nil, "", fmt.Errorf("lấy sản phẩm thất bại: %w", err)
+		}
+		if product == nil {
+			return nil, "", common.ErrProductNotFound
+		}
+		redirectTo = product.Slug
 	}
 
-	return product, nil
+	if err = s.buildProductBreadcrumbs(ctx, product); err != nil {
+		return nil, "", err
+	}
+	product.Localize(common.LocalesFromContext(ctx))
+
+	return product, redirectTo, nil
 }
 
 func (s *productServiceImpl) CreateColor(ctx context.Context, req *productpb.CreateColorRequest) (string, error) {
-	slug := common.GenerateSlug(req.Name)
+	slugMode, err := resolveSlugMode(req.SlugMode, false)
+	if err != nil {
+		return "", err
+	}
 
-	color := &model.Color{
-		ID:          uuid.NewString(),
-		Name:        req.Name,
-		Slug:        slug,
-		CreatedByID: req.UserId,
-		UpdatedByID: req.UserId,
+	translations, err := toTranslations(common.TranslationEntityColor, req.Translations)
+	if err != nil {
+		return "", err
 	}
-	if err := s.colorRepo.Create(ctx, color); err != nil {
-		if isUniqueViolation(err) {
-			return "", common.ErrColorAlreadyExists
+
+	color := &model.Color{
+		ID:           uuid.NewString(),
+		Name:         req.Name,
+		Translations: model.Translations{}.Merge(translations),
+		CreatedByID:  req.UserId,
+		UpdatedByID:  req.UserId,
+	}
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		slug, err := s.allocateSlugTx(ctx, tx, common.ColorSlugTarget, req.Name, "", slugMode)
+		if err != nil {
+			if errors.Is(err, common.ErrSlugAlreadyExists) {
+				return common.ErrColorAlreadyExists
+			}
+			return err
+		}
+		color.Slug = slug
+
+		if err = s.colorRepo.CreateTx(ctx, tx, color); err != nil {
+			if isUniqueViolation(err) {
+				return uniqueViolationError(err, common.ErrColorAlreadyExists)
+			}
+			return fmt.Errorf("tạo màu sắc thất bại: %w", err)
 		}
-		return "", fmt.Errorf("tạo màu sắc thất bại: %w", err)
+
+		return nil
+	}); err != nil {
+		return "", err
 	}
 
+	s.invalidateCache(ctx, common.ColorsCacheKey)
+
 	return color.ID, nil
 }
 
 func (s *productServiceImpl) CreateSize(ctx context.Context, req *productpb.CreateSizeRequest) (string, error) {
-	slug := common.GenerateSlug(req.Name)
+	slugMode, err := resolveSlugMode(req.SlugMode, false)
+	if err != nil {
+		return "", err
+	}
 
-	size := &model.Size{
-		ID:          uuid.NewString(),
-		Name:        req.Name,
-		Slug:        slug,
-		CreatedByID: req.UserId,
-		UpdatedByID: req.UserId,
+	translations, err := toTranslations(common.TranslationEntitySize, req.Translations)
+	if err != nil {
+		return "", err
 	}
-	if err := s.sizeRepo.Create(ctx, size); err != nil {
-		if isUniqueViolation(err) {
-			return "", common.ErrSizeAlreadyExists
+
+	size := &model.Size{
+		ID:           uuid.NewString(),
+		Name:         req.Name,
+		Translations: model.Translations{}.Merge(translations),
+		CreatedByID:  req.UserId,
+		UpdatedByID:  req.UserId,
+	}
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		slug, err := s.allocateSlugTx(ctx, tx, common.SizeSlugTarget, req.Name, "", slugMode)
+		if err != nil {
+			if errors.Is(err, common.ErrSlugAlreadyExists) {
+				return common.ErrSizeAlreadyExists
+			}
+			return err
 		}
-		return "", fmt.Errorf("tạo size thất bại: %w", err)
+		size.Slug = slug
+
+		if err = s.sizeRepo.CreateTx(ctx, tx, size); err != nil {
+			if isUniqueViolation(err) {
+				return uniqueViolationError(err, common.ErrSizeAlreadyExists)
+			}
+			return fmt.Errorf("tạo size thất bại: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return "", err
 	}
 
+	s.invalidateCache(ctx, common.SizesCacheKey)
+
 	return size.ID, nil
 }
 
@@ -215,27 +407,56 @@ func (s *productServiceImpl) GetProductsByCategory(ctx context.Context, category
 	if err != nil {
 		return nil, fmt.Errorf("lấy danh sách sản phẩm theo danh mục thất bại: %w", err)
 	}
+	if err = s.buildProductBreadcrumbs(ctx, products...); err != nil {
+		return nil, err
+	}
+	localizeProducts(ctx, products)
 
 	return products, nil
 }
 
 func (s *productServiceImpl) CreateTag(ctx context.Context, req *productpb.CreateTagRequest) (string, error) {
-	slug := common.GenerateSlug(req.Name)
+	slugMode, err := resolveSlugMode(req.SlugMode, false)
+	if err != nil {
+		return "", err
+	}
 
-	tag := &model.Tag{
-		ID:          uuid.NewString(),
-		Name:        req.Name,
-		Slug:        slug,
-		CreatedByID: req.UserId,
-		UpdatedByID: req.UserId,
+	translations, err := toTranslations(common.TranslationEntityTag, req.Translations)
+	if err != nil {
+		return "", err
 	}
-	if err := s.tagRepo.Create(ctx, tag); err != nil {
-		if isUniqueViolation(err) {
-			return "", common.ErrTagAlreadyExists
+
+	tag := &model.Tag{
+		ID:           uuid.NewString(),
+		Name:         req.Name,
+		Translations: model.Translations{}.Merge(translations),
+		CreatedByID:  req.UserId,
+		UpdatedByID:  req.UserId,
+	}
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		slug, err := s.allocateSlugTx(ctx, tx, common.TagSlugTarget, req.Name, "", slugMode)
+		if err != nil {
+			if errors.Is(err, common.ErrSlugAlreadyExists) {
+				return common.ErrTagAlreadyExists
+			}
+			return err
 		}
-		return "", fmt.Errorf("tạo nhãn sản phẩm thất bại: %w", err)
+		tag.Slug = slug
+
+		if err = s.tagRepo.CreateTx(ctx, tx, tag); err != nil {
+			if isUniqueViolation(err) {
+				return uniqueViolationError(err, common.ErrTagAlreadyExists)
+			}
+			return fmt.Errorf("tạo nhãn sản phẩm thất bại: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return "", err
 	}
 
+	s.invalidateCache(ctx, common.TagsCacheKey)
+
 	return tag.ID, nil
 }
 
@@ -295,6 +516,17 @@ func (s *productServiceImpl) GetCategoryByID(ctx context.Context, categoryID str
 }
 
 func (s *productServiceImpl) UpdateCategory(ctx context.Context, req *productpb.UpdateCategoryRequest) (*productpb.CategoryAdminDetailsResponse, error) {
+	slugMode, err := resolveSlugMode(req.SlugMode, req.Slug != "")
+	if err != nil {
+		return nil, err
+	}
+
+	translations, err := toTranslations(common.TranslationEntityCategory, req.Translations)
+	if err != nil {
+		return nil, err
+	}
+
+	var oldBannerFileID string
 	if err := s.db.Transaction(func(tx *gorm.DB) error {
 		category, err := s.categoryRepo.FindByIDWithParentsTx(ctx, tx, req.Id)
 		if err != nil {
@@ -306,13 +538,50 @@ func (s *productServiceImpl) UpdateCategory(ctx context.Context, req *productpb.
 		if category == nil {
 			return common.ErrCategoryNotFound
 		}
+		if err = common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+		if req.ExpectedVersion != nil && int(*req.ExpectedVersion) != category.Version {
+			return versionConflictError(common.ErrCategoryVersionConflict, *req.ExpectedVersion, category.Version)
+		}
 
-		updateData := map[string]any{}
+		updateData := map[string]any{"version": category.Version + 1}
 		if category.Name != req.Name {
 			updateData["name"] = req.Name
 		}
 		if category.Slug != req.Slug {
-			updateData["slug"] = req.Slug
+			slug, err := s.allocateSlugTx(ctx, tx, common.CategorySlugTarget, req.Slug, category.ID, slugMode)
+			if err != nil {
+				return err
+			}
+			if slug != category.Slug {
+				if err = s.changeCategorySlugTx(ctx, tx, category.ID, category.Slug, slug); err != nil {
+					return err
+				}
+				updateData["slug"] = slug
+			}
+		}
+		if category.Description != req.Description {
+			updateData["description"] = req.Description
+		}
+		if category.MetaTitle != req.MetaTitle {
+			updateData["meta_title"] = req.MetaTitle
+		}
+		if category.MetaDescription != req.MetaDescription {
+			updateData["meta_description"] = req.MetaDescription
+		}
+		if req.IsVisible != nil && category.IsVisible != *req.IsVisible {
+			updateData["is_visible"] = *req.IsVisible
+		}
+		if (req.DeleteBanner || req.Banner != nil) && category.BannerUrl != "" {
+			oldBannerFileID = category.BannerFileID
+			if req.Banner == nil {
+				updateData["banner_url"] = ""
+				updateData["banner_file_id"] = ""
+			}
+		}
+		if len(translations) > 0 {
+			updateData["translations"] = category.Translations.Merge(translations)
 		}
 		if category.UpdatedByID != req.UserId {
 			updateData["updated_by_id"] = req.UserId
@@ -321,7 +590,7 @@ func (s *productServiceImpl) UpdateCategory(ctx context.Context, req *productpb.
 		if len(updateData) > 0 {
 			if err = s.categoryRepo.UpdateTx(ctx, tx, category.ID, updateData); err != nil {
 				if isUniqueViolation(err) {
-					return common.ErrSlugAlreadyExists
+					return uniqueViolationError(err, common.ErrSlugAlreadyExists)
 				}
 				return fmt.Errorf("cập nhật danh mục sản phẩm thất bại: %w", err)
 			}
@@ -342,7 +611,7 @@ func (s *productServiceImpl) UpdateCategory(ctx context.Context, req *productpb.
 			}
 
 			if len(parents) != len(req.ParentIds) {
-				return common.ErrHasCategoryNotFound
+				return missingIDsError(common.ErrHasCategoryNotFound, req.ParentIds, getIDsFromCategories(parents))
 			}
 
 			if err = s.categoryRepo.UpdateParentsTx(ctx, tx, category, parents); err != nil {
@@ -350,6 +619,20 @@ func (s *productServiceImpl) UpdateCategory(ctx context.Context, req *productpb.
 			}
 		}
 
+		featuredProductIDs := make([]string, 0, len(category.FeaturedProducts))
+		for _, fp := range category.FeaturedProducts {
+			featuredProductIDs = append(featuredProductIDs, fp.ProductID)
+		}
+		if !slices.Equal(featuredProductIDs, req.FeaturedProductIds) {
+			if err = s.validateFeaturedProducts(ctx, req.FeaturedProductIds); err != nil {
+				return err
+			}
+
+			if err = s.categoryRepo.UpdateFeaturedProductsTx(ctx, tx, category.ID, req.FeaturedProductIds); err != nil {
+				return fmt.Errorf("cập nhật sản phẩm nổi bật thất bại: %w", err)
+			}
+		}
+
 		return nil
 	}); err != nil {
 		return nil, err
@@ -363,6 +646,16 @@ func (s *productServiceImpl) UpdateCategory(ctx context.Context, req *productpb.
 		return nil, common.ErrCategoryNotFound
 	}
 
+	if req.Banner != nil {
+		s.publishCategoryBannerUpload(ctx, category, req.Banner, req.UserId, oldBannerFileID)
+	} else if strings.TrimSpace(oldBannerFileID) != "" {
+		go func() {
+			if err := mq.PublishMessage(ctx, s.publisher, common.DeleteTopic, []byte(oldBannerFileID)); err != nil {
+				log.Printf("publish delete image msg thất bại: %v", err)
+			}
+		}()
+	}
+
 	userIDMap := map[string]struct{}{}
 	userIDMap[category.CreatedByID] = struct{}{}
 	userIDMap[category.UpdatedByID] = struct{}{}
@@ -398,6 +691,8 @@ func (s *productServiceImpl) UpdateCategory(ctx context.Context, req *productpb.
 
 	productResponses := toBaseProductResponse(category)
 
+	s.invalidateCategoryCache(ctx)
+
 	return toCategoryAdminDetailsResponse(category, productResponses, cRes, uRes), nil
 }
 
@@ -442,9 +737,10 @@ func (s *productServiceImpl) GetAllColorsAdmin(ctx context.Context) (*productpb.
 	var colorResponses []*productpb.ColorAdminResponse
 	for _, color := range colors {
 		colorResponses = append(colorResponses, &productpb.ColorAdminResponse{
-			Id:        color.ID,
-			Name:      color.Name,
-			CreatedAt: color.CreatedAt.Format(time.RFC3339),
+			Id:           color.ID,
+			Name:         color.Name,
+			Translations: toTranslationsResponse(color.Translations),
+			CreatedAt:    color.CreatedAt.Format(time.RFC3339),
 			CreatedBy: &productpb.BaseUserResponse{
 				Id:       color.CreatedByID,
 				Username: userMap[color.CreatedByID].Username,
@@ -513,9 +809,10 @@ func (s *productServiceImpl) GetAllSizesAdmin(ctx context.Context) (*productpb.S
 	var sizeResponses []*productpb.SizeAdminResponse
 	for _, size := range sizes {
 		sizeResponses = append(sizeResponses, &productpb.SizeAdminResponse{
-			Id:        size.ID,
-			Name:      size.Name,
-			CreatedAt: size.CreatedAt.Format(time.RFC3339),
+			Id:           size.ID,
+			Name:         size.Name,
+			Translations: toTranslationsResponse(size.Translations),
+			CreatedAt:    size.CreatedAt.Format(time.RFC3339),
 			CreatedBy: &productpb.BaseUserResponse{
 				Id:       size.CreatedByID,
 				Username: userMap[size.CreatedByID].Username,
@@ -584,9 +881,10 @@ func (s *productServiceImpl) GetAllTagsAdmin(ctx context.Context) (*productpb.Ta
 	var tagResponses []*productpb.TagAdminResponse
 	for _, tag := range tags {
 		tagResponses = append(tagResponses, &productpb.TagAdminResponse{
-			Id:        tag.ID,
-			Name:      tag.Name,
-			CreatedAt: tag.CreatedAt.Format(time.RFC3339),
+			Id:           tag.ID,
+			Name:         tag.Name,
+			Translations: toTranslationsResponse(tag.Translations),
+			CreatedAt:    tag.CreatedAt.Format(time.RFC3339),
 			CreatedBy: &productpb.BaseUserResponse{
 				Id:       tag.CreatedByID,
 				Username: userMap[tag.CreatedByID].Username,
@@ -615,7 +913,17 @@ func (s *productServiceImpl) GetAllTagsAdmin(ctx context.Context) (*productpb.Ta
 }
 
 func (s *productServiceImpl) UpdateTag(ctx context.Context, req *productpb.UpdateTagRequest) error {
-	return s.db.Transaction(func(tx *gorm.DB) error {
+	slugMode, err := resolveSlugMode(req.SlugMode, false)
+	if err != nil {
+		return err
+	}
+
+	translations, err := toTranslations(common.TranslationEntityTag, req.Translations)
+	if err != nil {
+		return err
+	}
+
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
 		tag, err := s.tagRepo.FindByIDTx(ctx, tx, req.Id)
 		if err != nil {
 			return fmt.Errorf("tìm kiếm tag sản phẩm thất bại: %w", err)
@@ -623,11 +931,27 @@ func (s *productServiceImpl) UpdateTag(ctx context.Context, req *productpb.Updat
 		if tag == nil {
 			return common.ErrTagNotFound
 		}
+		if err = common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
 
 		updateData := map[string]any{}
 		if tag.Name != req.Name {
 			updateData["name"] = req.Name
-			updateData["slug"] = common.GenerateSlug(req.Name)
+
+			slug, err := s.allocateSlugTx(ctx, tx, common.TagSlugTarget, req.Name, tag.ID, slugMode)
+			if err != nil {
+				if errors.Is(err, common.ErrSlugAlreadyExists) {
+					return common.ErrTagAlreadyExists
+				}
+				return err
+			}
+			if slug != tag.Slug {
+				updateData["slug"] = slug
+			}
+		}
+		if len(translations) > 0 {
+			updateData["translations"] = tag.Translations.Merge(translations)
 		}
 		if tag.UpdatedByID != req.UserId {
 			updateData["updated_by_id"] = req.UserId
@@ -636,18 +960,34 @@ func (s *productServiceImpl) UpdateTag(ctx context.Context, req *productpb.Updat
 		if len(updateData) > 0 {
 			if err = s.tagRepo.UpdateTx(ctx, tx, tag.ID, updateData); err != nil {
 				if isUniqueViolation(err) {
-					return common.ErrTagAlreadyExists
+					return uniqueViolationError(err, common.ErrTagAlreadyExists)
 				}
 				return fmt.Errorf("cập nhật tag sản phẩm thất bại: %w", err)
 			}
 		}
 
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+
+	s.invalidateCache(ctx, common.TagsCacheKey)
+
+	return nil
 }
 
 func (s *productServiceImpl) UpdateColor(ctx context.Context, req *productpb.UpdateColorRequest) error {
-	return s.db.Transaction(func(tx *gorm.DB) error {
+	slugMode, err := resolveSlugMode(req.SlugMode, false)
+	if err != nil {
+		return err
+	}
+
+	translations, err := toTranslations(common.TranslationEntityColor, req.Translations)
+	if err != nil {
+		return err
+	}
+
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
 		color, err := s.colorRepo.FindByIDTx(ctx, tx, req.Id)
 		if err != nil {
 			if strings.Contains(err.Error(), "lock") {
@@ -658,11 +998,27 @@ func (s *productServiceImpl) UpdateColor(ctx context.Context, req *productpb.Upd
 		if color == nil {
 			return common.ErrColorNotFound
 		}
+		if err = common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
 
 		updateData := map[string]any{}
 		if color.Name != req.Name {
 			updateData["name"] = req.Name
-			updateData["slug"] = common.GenerateSlug(req.Name)
+
+			slug, err := s.allocateSlugTx(ctx, tx, common.ColorSlugTarget, req.Name, color.ID, slugMode)
+			if err != nil {
+				if errors.Is(err, common.ErrSlugAlreadyExists) {
+					return common.ErrColorAlreadyExists
+				}
+				return err
+			}
+			if slug != color.Slug {
+				updateData["slug"] = slug
+			}
+		}
+		if len(translations) > 0 {
+			updateData["translations"] = color.Translations.Merge(translations)
 		}
 		if color.UpdatedByID != req.UserId {
 			updateData["updated_by_id"] = req.UserId
@@ -671,18 +1027,34 @@ func (s *productServiceImpl) UpdateColor(ctx context.Context, req *productpb.Upd
 		if len(updateData) > 0 {
 			if err = s.colorRepo.UpdateTx(ctx, tx, color.ID, updateData); err != nil {
 				if isUniqueViolation(err) {
-					return common.ErrColorAlreadyExists
+					return uniqueViolationError(err, common.ErrColorAlreadyExists)
 				}
 				return fmt.Errorf("cập nhật màu sắc sản phẩm thất bại: %w", err)
 			}
 		}
 
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+
+	s.invalidateCache(ctx, common.ColorsCacheKey)
+
+	return nil
 }
 
 func (s *productServiceImpl) UpdateSize(ctx context.Context, req *productpb.UpdateSizeRequest) error {
-	return s.db.Transaction(func(tx *gorm.DB) error {
+	slugMode, err := resolveSlugMode(req.SlugMode, false)
+	if err != nil {
+		return err
+	}
+
+	translations, err := toTranslations(common.TranslationEntitySize, req.Translations)
+	if err != nil {
+		return err
+	}
+
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
 		size, err := s.sizeRepo.FindByIDTx(ctx, tx, req.Id)
 		if err != nil {
 			if strings.Contains(err.Error(), "lock") {
@@ -693,11 +1065,27 @@ func (s *productServiceImpl) UpdateSize(ctx context.Context, req *productpb.Upda
 		if size == nil {
 			return common.ErrSizeNotFound
 		}
+		if err = common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
 
 		updateData := map[string]any{}
 		if size.Name != req.Name {
 			updateData["name"] = req.Name
-			updateData["slug"] = common.GenerateSlug(req.Name)
+
+			slug, err := s.allocateSlugTx(ctx, tx, common.SizeSlugTarget, req.Name, size.ID, slugMode)
+			if err != nil {
+				if errors.Is(err, common.ErrSlugAlreadyExists) {
+					return common.ErrSizeAlreadyExists
+				}
+				return err
+			}
+			if slug != size.Slug {
+				updateData["slug"] = slug
+			}
+		}
+		if len(translations) > 0 {
+			updateData["translations"] = size.Translations.Merge(translations)
 		}
 		if size.UpdatedByID != req.UserId {
 			updateData["updated_by_id"] = req.UserId
@@ -706,40 +1094,73 @@ func (s *productServiceImpl) UpdateSize(ctx context.Context, req *productpb.Upda
 		if len(updateData) > 0 {
 			if err = s.sizeRepo.UpdateTx(ctx, tx, size.ID, updateData); err != nil {
 				if isUniqueViolation(err) {
-					return common.ErrSizeAlreadyExists
+					return uniqueViolationError(err, common.ErrSizeAlreadyExists)
 				}
 				return fmt.Errorf("cập nhật kích cỡ sản phẩm thất bại: %w", err)
 			}
 		}
 
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+
+	s.invalidateCache(ctx, common.SizesCacheKey)
+
+	return nil
 }
 
 func (s *productServiceImpl) GetAllColors(ctx context.Context) ([]*model.Color, error) {
+	var colors []*model.Color
+	if s.getCache(ctx, common.ColorsCacheKey, &colors) {
+		localizeColors(ctx, colors)
+		return colors, nil
+	}
+
 	colors, err := s.colorRepo.FindAll(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("lấy tất cả màu sắc sản phẩm thất bại: %w", err)
 	}
 
+	s.setCache(ctx, common.ColorsCacheKey, colors)
+	localizeColors(ctx, colors)
+
 	return colors, nil
 }
 
 func (s *productServiceImpl) GetAllSizes(ctx context.Context) ([]*model.Size, error) {
+	var sizes []*model.Size
+	if s.getCache(ctx, common.SizesCacheKey, &sizes) {
+		localizeSizes(ctx, sizes)
+		return sizes, nil
+	}
+
 	sizes, err := s.sizeRepo.FindAll(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("lấy tất cả size sản phẩm thất bại: %w", err)
 	}
 
+	s.setCache(ctx, common.SizesCacheKey, sizes)
+	localizeSizes(ctx, sizes)
+
 	return sizes, nil
 }
 
 func (s *productServiceImpl) GetAllTags(ctx context.Context) ([]*model.Tag, error) {
+	var tags []*model.Tag
+	if s.getCache(ctx, common.TagsCacheKey, &tags) {
+		localizeTags(ctx, tags)
+		return tags, nil
+	}
+
 	tags, err := s.tagRepo.FindAll(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("lấy tất cả tag sản phẩm thất bại: %w", err)
 	}
 
+	s.setCache(ctx, common.TagsCacheKey, tags)
+	localizeTags(ctx, tags)
+
 	return tags, nil
 }
 
@@ -760,17 +1181,24 @@ func (s *productServiceImpl) GetCategoriesNoChild(ctx context.Context) ([]*model
 }
 
 func (s *productServiceImpl) CreateProduct(ctx context.Context, req *productpb.CreateProductRequest) (string, error) {
-	slug := common.GenerateSlug(req.Title)
+	slugSource := req.Title
+	if req.Slug != nil {
+		slugSource = *req.Slug
+	}
+
+	slugMode, err := resolveSlugMode(req.SlugMode, req.Slug != nil)
+	if err != nil {
+		return "", err
+	}
 
 	var categories []*model.Category
-	var err error
 	if len(req.CategoryIds) > 0 {
 		categories, err = s.categoryRepo.FindAllByIDWithChildren(ctx, req.CategoryIds)
 		if err != nil {
 			return "", fmt.Errorf("tìm kiếm danh mục sản phẩm thất bại: %w", err)
 		}
 		if len(categories) != len(req.CategoryIds) {
-			return "", common.ErrHasCategoryNotFound
+			return "", missingIDsError(common.ErrHasCategoryNotFound, req.CategoryIds, getIDsFromCategories(categories))
 		}
 
 		for _, c := range categories {
@@ -780,6 +1208,11 @@ func (s *productServiceImpl) CreateProduct(ctx context.Context, req *productpb.C
 		}
 	}
 
+	primaryCategoryID, err := resolvePrimaryCategoryID(req.PrimaryCategoryId, nil, req.CategoryIds)
+	if err != nil {
+		return "", err
+	}
+
 	var tags []*model.Tag
 	if len(req.TagIds) > 0 {
 		tags, err = s.tagRepo.FindAllByID(ctx, req.TagIds)
@@ -787,7 +1220,7 @@ func (s *productServiceImpl) CreateProduct(ctx context.Context, req *productpb.C
 			return "", fmt.Errorf("tìm kiếm tag sản phẩm thất bại: %w", err)
 		}
 		if len(tags) != len(req.TagIds) {
-			return "", common.ErrHasTagNotFound
+			return "", missingIDsError(common.ErrHasTagNotFound, req.TagIds, getIDsFromTags(tags))
 		}
 	}
 
@@ -806,31 +1239,85 @@ func (s *productServiceImpl) CreateProduct(ctx context.Context, req *productpb.C
 		endSale = &parsedEndSale
 	}
 
-	product := &model.Product{
-		ID:          uuid.NewString(),
-		Title:       req.Title,
-		Slug:        slug,
-		Description: req.Description,
-		Price:       req.Price,
-		IsActive:    req.IsActive,
-		IsSale:      req.IsSale,
-		SalePrice:   req.SalePrice,
-		StartSale:   startSale,
-		EndSale:     endSale,
-		Categories:  categories,
-		Tags:        tags,
-		CreatedByID: req.UserId,
-		UpdatedByID: req.UserId,
+	publishAt, err := parseOptionalDateTime(req.PublishAt)
+	if err != nil {
+		return "", fmt.Errorf("chuyển đổi kiểu dữ liệu thời gian đăng bán thất bại: %w", err)
+	}
+	unpublishAt, err := parseOptionalDateTime(req.UnpublishAt)
+	if err != nil {
+		return "", fmt.Errorf("chuyển đổi kiểu dữ liệu thời gian ngừng bán thất bại: %w", err)
 	}
 
-	variants := make([]*model.Variant, 0, len(req.Variants))
-	for _, v := range req.Variants {
+	now := time.Now()
+	status := common.ProductStatusDraft
+	switch {
+	case req.Status != nil:
+		status = *req.Status
+	case publishAt != nil && publishAt.After(now):
+		status = common.ProductStatusScheduled
+	case req.IsActive:
+		status = common.ProductStatusPublished
+	}
+	if status == common.ProductStatusPublished {
+		publishAt = &now
+	}
+	if err = validateProductSchedule(status, publishAt, unpublishAt, now); err != nil {
+		return "", err
+	}
+
+	productType := common.ProductTypeSimple
+	if req.IsBundle {
+		if len(req.Variants) > 0 {
+			return "", common.ErrBundleHasVariants
+		}
+		productType = common.ProductTypeBundle
+	} else if len(req.BundleItems) > 0 {
+		return "", common.ErrBundleItemsNotAllowed
+	}
+
+	variantRefs := make([]*variantReference, 0, len(req.Variants))
+	for i, v := range req.Variants {
+		variantRefs = append(variantRefs, &variantReference{fmt.Sprintf("variants[%d]", i), &v.Sku, &v.ColorId, &v.SizeId})
+	}
+	if err = s.validateVariantReferences(ctx, variantRefs); err != nil {
+		return "", err
+	}
+
+	translations, err := toTranslations(common.TranslationEntityProduct, req.Translations)
+	if err != nil {
+		return "", err
+	}
+
+	product := &model.Product{
+		ID:                uuid.NewString(),
+		Title:             req.Title,
+		Type:              productType,
+		Description:       req.Description,
+		Translations:      model.Translations{}.Merge(translations),
+		Price:             req.Price,
+		IsActive:          status == common.ProductStatusPublished,
+		Status:            status,
+		PublishAt:         publishAt,
+		UnpublishAt:       unpublishAt,
+		IsSale:            req.IsSale,
+		SalePrice:         req.SalePrice,
+		StartSale:         startSale,
+		EndSale:           endSale,
+		PrimaryCategoryID: primaryCategoryID,
+		Categories:        categories,
+		Tags:              tags,
+		CreatedByID:       req.UserId,
+		UpdatedByID:       req.UserId,
+	}
+
+	variants := make([]*model.Variant, 0, len(req.Variants))
+	for _, v := range req.Variants {
 		exists, err := s.variantRepo.ExistsBySKU(ctx, v.Sku)
 		if err != nil {
 			return "", fmt.Errorf("kiểm tra mã SKU biến thể thất bại: %w", err)
 		}
 		if exists {
-			return "", common.ErrSKUAlreadyExists
+			return "", common.NewDetailedError(common.ErrSKUAlreadyExists, map[string]string{"sku": v.Sku})
 		}
 
 		variant := &model.Variant{
@@ -851,52 +1338,71 @@ func (s *productServiceImpl) CreateProduct(ctx context.Context, req *productpb.C
 	product.Variants = variants
 
 	imgQuan := len(req.Images)
-	publishChan := make(chan *common.Base64UploadRequest, imgQuan)
 	images := make([]*model.Image, 0, imgQuan)
 	for _, img := range req.Images {
-		ext := strings.ToLower(filepath.Ext(img.FileName))
-		if ext == "" {
-			ext = ".jpg"
-		}
-
-		fileName := fmt.Sprintf("%s-%s_%d%s", product.Slug, img.ColorId, img.SortOrder, ext)
-
-		image := &model.Image{
+		images = append(images, &model.Image{
 			ID:          uuid.NewString(),
 			ProductID:   product.ID,
 			ColorID:     img.ColorId,
 			IsThumbnail: img.IsThumbnail,
 			SortOrder:   int(img.SortOrder),
+		})
+	}
+	product.Images = images
+
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		slug, err := s.allocateSlugTx(ctx, tx, common.ProductSlugTarget, slugSource, "", slugMode)
+		if err != nil {
+			return err
 		}
+		product.Slug = slug
 
-		uploadFileRequest := &common.Base64UploadRequest{
+		if product.IsBundle() {
+			bundleItems, err := s.buildBundleItemsTx(ctx, tx, product.ID, req.BundleItems)
+			if err != nil {
+				return err
+			}
+			product.BundleItems = bundleItems
+		}
+
+		if err = s.productRepo.CreateTx(ctx, tx, product); err != nil {
+			if isUniqueViolation(err) {
+				return uniqueViolationError(err, common.ErrSlugAlreadyExists)
+			}
+			return fmt.Errorf("tạo sản phẩm thất bại: %w", err)
+		}
+
+		_, err = s.createProductRevisionTx(ctx, tx, product.ID, req.UserId)
+		return err
+	}); err != nil {
+		return "", err
+	}
+
+	publishChan := make(chan *common.Base64UploadRequest, imgQuan)
+	for i, img := range req.Images {
+		ext := strings.ToLower(filepath.Ext(img.FileName))
+		if ext == "" {
+			ext = ".jpg"
+		}
+
+		fileName := fmt.Sprintf("%s-%s_%d%s", product.Slug, img.ColorId, img.SortOrder, ext)
+
+		publishChan <- &common.Base64UploadRequest{
 			ProductID:   product.ID,
-			ImageID:     image.ID,
+			ImageID:     images[i].ID,
 			Base64Data:  img.Base64Data,
 			FileName:    fileName,
 			Folder:      s.cfg.ImageKit.Folder,
 			UserID:      req.UserId,
 			TotalImages: uint16(imgQuan),
 		}
-
-		publishChan <- uploadFileRequest
-
-		images = append(images, image)
 	}
 	close(publishChan)
-	product.Images = images
-
-	if err = s.productRepo.Create(ctx, product); err != nil {
-		if isUniqueViolation(err) {
-			return "", common.ErrSlugAlreadyExists
-		}
-		return "", fmt.Errorf("tạo sản phẩm thất bại: %w", err)
-	}
 
 	go func() {
 		for uploadReq := range publishChan {
 			body, _ := sonic.Marshal(uploadReq)
-			if err := mq.PublishMessage(s.publisher, common.UploadTopic, body); err != nil {
+			if err := mq.PublishMessage(ctx, s.publisher, common.UploadTopic, body); err != nil {
 				log.Printf("publish delete image msg thất bại: %v", err)
 			}
 		}
@@ -969,6 +1475,7 @@ func (s *productServiceImpl) GetAllProductsAdmin(ctx context.Context, req *produ
 		Search:     req.Search,
 		Order:      req.Order,
 		IsActive:   req.IsActive,
+		Status:     req.GetStatus(),
 		CategoryID: req.CategoryId,
 	}
 
@@ -995,7 +1502,43 @@ func (s *productServiceImpl) GetAllProductsAdmin(ctx context.Context, req *produ
 }
 
 func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *productpb.UpdateProductRequest) (*productpb.ProductAdminDetailsResponse, error) {
-	if err := s.db.Transaction(func(tx *gorm.DB) error {
+	return s.updateProduct(ctx, req, nil)
+}
+
+func (s *productServiceImpl) updateProduct(ctx context.Context, req *productpb.UpdateProductRequest, afterUpdateTx func(tx *gorm.DB, product *model.Product) error) (*productpb.ProductAdminDetailsResponse, error) {
+	slugMode, err := resolveSlugMode(req.SlugMode, req.Slug != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	publishAt, err := parseOptionalDateTime(req.PublishAt)
+	if err != nil {
+		return nil, fmt.Errorf("chuyển đổi kiểu dữ liệu thời gian đăng bán thất bại: %w", err)
+	}
+	unpublishAt, err := parseOptionalDateTime(req.UnpublishAt)
+	if err != nil {
+		return nil, fmt.Errorf("chuyển đổi kiểu dữ liệu thời gian ngừng bán thất bại: %w", err)
+	}
+
+	variantRefs := make([]*variantReference, 0, len(req.UpdateVariants)+len(req.NewVariants))
+	for i, v := range req.UpdateVariants {
+		variantRefs = append(variantRefs, &variantReference{fmt.Sprintf("update_variants[%d]", i), v.Sku, v.ColorId, v.SizeId})
+	}
+	for i, v := range req.NewVariants {
+		variantRefs = append(variantRefs, &variantReference{fmt.Sprintf("new_variants[%d]", i), &v.Sku, &v.ColorId, &v.SizeId})
+	}
+	if err = s.validateVariantReferences(ctx, variantRefs); err != nil {
+		return nil, err
+	}
+
+	translations, err := toTranslations(common.TranslationEntityProduct, req.Translations)
+	if err != nil {
+		return nil, err
+	}
+
+	var oldPrice float32
+	var oldStatus string
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
 		product, err := s.productRepo.FindByIDWithCategoriesAndTagsTx(ctx, tx, req.Id)
 		if err != nil {
 			if strings.Contains(err.Error(), "lock") {
@@ -1006,20 +1549,90 @@ func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *productpb.U
 		if product == nil {
 			return common.ErrProductNotFound
 		}
+		if req.ExpectedVersion != nil && int(*req.ExpectedVersion) != product.Version {
+			return versionConflictError(common.ErrProductVersionConflict, *req.ExpectedVersion, product.Version)
+		}
 
-		updateData := map[string]any{}
+		auditSnapshots := common.AuditSnapshotsFromContext(ctx)
+		var beforeSnapshot *model.ProductSnapshot
+		if auditSnapshots != nil {
+			details, err := s.productRepo.FindByIDWithDetailsTx(ctx, tx, product.ID)
+			if err != nil {
+				return fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
+			}
+			beforeSnapshot = model.NewProductSnapshot(details)
+		}
+
+		now := time.Now()
+		oldPrice = product.GetEffectivePrice(now)
+		oldStatus = product.Status
+
+		updateData := map[string]any{"version": product.Version + 1}
+		var slugSource string
 		if req.Title != nil && *req.Title != product.Title {
 			updateData["title"] = req.Title
-			updateData["slug"] = common.GenerateSlug(*req.Title)
+			slugSource = *req.Title
+		}
+		if req.Slug != nil {
+			slugSource = *req.Slug
+		}
+		if slugSource != "" {
+			slug, err := s.allocateSlugTx(ctx, tx, common.ProductSlugTarget, slugSource, product.ID, slugMode)
+			if err != nil {
+				return err
+			}
+			if slug != product.Slug {
+				if err = s.changeProductSlugTx(ctx, tx, product.ID, product.Slug, slug); err != nil {
+					return err
+				}
+				updateData["slug"] = slug
+			}
 		}
 		if req.Description != nil && *req.Description != product.Description {
 			updateData["description"] = req.Description
 		}
+		if len(translations) > 0 {
+			updateData["translations"] = product.Translations.Merge(translations)
+		}
 		if req.Price != nil && *req.Price != product.Price {
 			updateData["price"] = req.Price
 		}
-		if req.IsActive != nil && *req.IsActive != product.IsActive {
-			updateData["is_active"] = req.IsActive
+
+		status := product.Status
+		if req.PublishAt == nil {
+			publishAt = product.PublishAt
+		}
+		if req.UnpublishAt == nil {
+			unpublishAt = product.UnpublishAt
+		}
+		switch {
+		case req.Status != nil:
+			status = *req.Status
+		case req.IsActive != nil && *req.IsActive != product.IsActive:
+			status = common.ProductStatusDraft
+			if *req.IsActive {
+				status = common.ProductStatusPublished
+			}
+		case req.PublishAt != nil && publishAt != nil && publishAt.After(now) && product.Status == common.ProductStatusDraft:
+			status = common.ProductStatusScheduled
+		}
+		if status == common.ProductStatusPublished && (product.Status != common.ProductStatusPublished || publishAt == nil) {
+			publishAt = &now
+		}
+		if err = validateProductSchedule(status, publishAt, unpublishAt, now); err != nil {
+			return err
+		}
+		if status != product.Status {
+			updateData["status"] = status
+		}
+		if isActive := status == common.ProductStatusPublished; isActive != product.IsActive {
+			updateData["is_active"] = isActive
+		}
+		if !equalTimePtr(publishAt, product.PublishAt) {
+			updateData["publish_at"] = publishAt
+		}
+		if !equalTimePtr(unpublishAt, product.UnpublishAt) {
+			updateData["unpublish_at"] = unpublishAt
 		}
 		if req.IsSale != nil && *req.IsSale != product.IsSale {
 			if !*req.IsSale {
@@ -1050,6 +1663,21 @@ func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *productpb.U
 				updateData["end_sale"] = parsedEndSale
 			}
 		}
+		saleChanged := hasAnyKey(updateData, "is_sale", "sale_price", "start_sale", "end_sale")
+		if saleChanged {
+			updateData["price_drop_notified_at"] = nil
+		}
+		categoryIDs := req.CategoryIds
+		if len(categoryIDs) == 0 {
+			categoryIDs = getIDsFromCategories(product.Categories)
+		}
+		primaryCategoryID, err := resolvePrimaryCategoryID(req.PrimaryCategoryId, product.PrimaryCategoryID, categoryIDs)
+		if err != nil {
+			return err
+		}
+		if !equalStringPtr(primaryCategoryID, product.PrimaryCategoryID) {
+			updateData["primary_category_id"] = primaryCategoryID
+		}
 		if req.UserId != product.UpdatedByID {
 			updateData["updated_by_id"] = req.UserId
 		}
@@ -1057,11 +1685,16 @@ func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *productpb.U
 		if len(updateData) > 0 {
 			if err = s.productRepo.UpdateTx(ctx, tx, product.ID, updateData); err != nil {
 				if isUniqueViolation(err) {
-					return common.ErrSlugAlreadyExists
+					return uniqueViolationError(err, common.ErrSlugAlreadyExists)
 				}
 				return fmt.Errorf("cập nhật sản phẩm thất bại: %w", err)
 			}
 		}
+		if saleChanged {
+			if err = s.productRepo.ClaimSaleStartedTx(ctx, tx, product.ID, now); err != nil {
+				return fmt.Errorf("đánh dấu thông báo giảm giá sản phẩm thất bại: %w", err)
+			}
+		}
 
 		if len(req.CategoryIds) > 0 {
 			categoryIDs := getIDsFromCategories(product.Categories)
@@ -1071,7 +1704,7 @@ func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *productpb.U
 					return fmt.Errorf("tìm kiếm danh mục sản phẩm thất bại: %w", err)
 				}
 				if len(categories) != len(req.CategoryIds) {
-					return common.ErrHasCategoryNotFound
+					return missingIDsError(common.ErrHasCategoryNotFound, req.CategoryIds, getIDsFromCategories(categories))
 				}
 
 				for _, c := range categories {
@@ -1095,7 +1728,7 @@ func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *productpb.U
 				}
 
 				if len(tags) != len(req.TagIds) {
-					return common.ErrHasTagNotFound
+					return missingIDsError(common.ErrHasTagNotFound, req.TagIds, getIDsFromTags(tags))
 				}
 
 				if err = s.productRepo.UpdateTagsTx(ctx, tx, product, tags); err != nil {
@@ -1110,7 +1743,7 @@ func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *productpb.U
 				return fmt.Errorf("lấy danh sách danh mục sản phẩm thất bại: %w", err)
 			}
 			if len(variants) != len(req.DeleteVariantIds) {
-				return common.ErrHasVariantNotFound
+				return missingIDsError(common.ErrHasVariantNotFound, req.DeleteVariantIds, getIDsFromVariants(variants))
 			}
 
 			if err = s.variantRepo.DeleteAllByIDTx(ctx, tx, req.DeleteVariantIds); err != nil {
@@ -1129,7 +1762,7 @@ func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *productpb.U
 				return fmt.Errorf("lấy danh sách thuộc tính sản phẩm chỉnh sửa thất bại: %w", err)
 			}
 			if len(variantIDs) != len(variants) {
-				return common.ErrHasVariantNotFound
+				return missingIDsError(common.ErrHasVariantNotFound, variantIDs, getIDsFromVariants(variants))
 			}
 
 			varMap := make(map[string]*model.Variant)
@@ -1161,7 +1794,7 @@ func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *productpb.U
 				if len(updateData) > 0 {
 					if err = s.variantRepo.UpdateTx(ctx, tx, variant.Id, updateData); err != nil {
 						if isUniqueViolation(err) {
-							return common.ErrSKUAlreadyExists
+							return uniqueViolationError(err, common.ErrSKUAlreadyExists)
 						}
 						return fmt.Errorf("cập nhật biến thể sản phẩm %s thất bại: %w", variant.Id, err)
 					}
@@ -1203,7 +1836,7 @@ func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *productpb.U
 
 			if err := s.variantRepo.CreateAllTx(ctx, tx, newVariants); err != nil {
 				if isUniqueViolation(err) {
-					return common.ErrHasSKUAlreadyExists
+					return uniqueViolationError(err, common.ErrHasSKUAlreadyExists)
 				}
 				return fmt.Errorf("tạo biến thể thất bại: %w", err)
 			}
@@ -1215,7 +1848,7 @@ func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *productpb.U
 				return fmt.Errorf("tìm kiếm danh sách hình ảnh thất bại: %w", err)
 			}
 			if len(images) != len(req.DeleteImageIds) {
-				return common.ErrHasImageNotFound
+				return missingIDsError(common.ErrHasImageNotFound, req.DeleteImageIds, getIDsFromImages(images))
 			}
 
 			if err = s.imageRepo.DeleteAllByIDTx(ctx, tx, req.DeleteImageIds); err != nil {
@@ -1234,7 +1867,7 @@ func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *productpb.U
 			go func() {
 				for deleteReq := range publishChan {
 					body := []byte(deleteReq)
-					if err := mq.PublishMessage(s.publisher, common.DeleteTopic, body); err != nil {
+					if err := mq.PublishMessage(ctx, s.publisher, common.DeleteTopic, body); err != nil {
 						log.Printf("publish delete image msg thất bại: %v", err)
 					}
 				}
@@ -1247,12 +1880,12 @@ func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *productpb.U
 				imgIDs = append(imgIDs, img.Id)
 			}
 
-			imgs, err := s.imageRepo.FindAllByIDTx(ctx, tx, imgIDs) 
+			imgs, err := s.imageRepo.FindAllByIDTx(ctx, tx, imgIDs)
 			if err != nil {
 				return fmt.Errorf("lấy danh sách hình ảnh sản phẩm chỉnh sửa thất bại: %w", err)
 			}
 			if len(imgs) != len(imgIDs) {
-				return common.ErrHasImageNotFound
+				return missingIDsError(common.ErrHasImageNotFound, imgIDs, getIDsFromImages(imgs))
 			}
 
 			for _, image := range req.UpdateImages {
@@ -1315,13 +1948,27 @@ func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *productpb.U
 			go func() {
 				for uploadReq := range publishChan {
 					body, _ := sonic.Marshal(uploadReq)
-					if err := mq.PublishMessage(s.publisher, common.UploadTopic, body); err != nil {
+					if err := mq.PublishMessage(ctx, s.publisher, common.UploadTopic, body); err != nil {
 						log.Printf("publish delete image msg thất bại: %v", err)
 					}
 				}
 			}()
 		}
 
+		if afterUpdateTx != nil {
+			if err = afterUpdateTx(tx, product); err != nil {
+				return err
+			}
+		}
+
+		afterSnapshot, err := s.createProductRevisionTx(ctx, tx, product.ID, req.UserId)
+		if err != nil {
+			return err
+		}
+		if auditSnapshots != nil {
+			auditSnapshots.Record(product.ID, beforeSnapshot.Flatten(), afterSnapshot.Flatten())
+		}
+
 		return nil
 	}); err != nil {
 		return nil, err
@@ -1335,6 +1982,14 @@ func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *productpb.U
 		return nil, common.ErrProductNotFound
 	}
 
+	if newPrice := product.GetEffectivePrice(time.Now()); newPrice < oldPrice {
+		go s.publishPriceDropEvent(context.WithoutCancel(ctx), product, oldPrice, newPrice)
+	}
+
+	if product.Status != oldStatus && (product.Status == common.ProductStatusPublished || oldStatus == common.ProductStatusPublished) {
+		go s.publishProductStatusChangedEvent(context.WithoutCancel(ctx), product)
+	}
+
 	userIDMap := map[string]struct{}{}
 	userIDMap[product.CreatedByID] = struct{}{}
 	userIDMap[product.UpdatedByID] = struct{}{}
@@ -1374,9 +2029,16 @@ func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *productpb.U
 func (s *productServiceImpl) DeleteProduct(ctx context.Context, req *productpb.DeleteOneRequest) error {
 	updateData := map[string]any{
 		"is_deleted":    true,
+		"version":       gorm.Expr("version + 1"),
 		"updated_by_id": req.UserId,
 	}
-	if err := s.productRepo.Update(ctx, req.Id, updateData); err != nil {
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.productRepo.UpdateTx(ctx, tx, req.Id, updateData)
+	}); err != nil {
 		if errors.Is(err, common.ErrProductNotFound) {
 			return err
 		}
@@ -1392,14 +2054,21 @@ func (s *productServiceImpl) DeleteProducts(ctx context.Context, req *productpb.
 		return fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
 	}
 	if len(products) != len(req.Ids) {
-		return common.ErrHasProductNotFound
+		return missingIDsError(common.ErrHasProductNotFound, req.Ids, getIDsFromProducts(products))
 	}
 
 	updateData := map[string]any{
 		"is_deleted":    true,
+		"version":       gorm.Expr("version + 1"),
 		"updated_by_id": req.UserId,
 	}
-	if err = s.productRepo.UpdateAllByID(ctx, req.Ids, updateData); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.productRepo.UpdateAllByIDTx(ctx, tx, req.Ids, updateData)
+	}); err != nil {
 		return fmt.Errorf("chuyển danh sách sản phẩm vào thùng rác thất bại: %w", err)
 	}
 
@@ -1407,29 +2076,53 @@ func (s *productServiceImpl) DeleteProducts(ctx context.Context, req *productpb.
 }
 
 func (s *productServiceImpl) PermanentlyDeleteCategory(ctx context.Context, req *productpb.PermanentlyDeleteOneRequest) error {
-	if err := s.categoryRepo.Delete(ctx, req.Id); err != nil {
+	category, err := s.categoryRepo.FindDeletedByID(ctx, req.Id)
+	if err != nil {
+		return fmt.Errorf("tìm kiếm danh mục sản phẩm thất bại: %w", err)
+	}
+	if category == nil {
+		return common.ErrCategoryNotFound
+	}
+
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.categoryRepo.DeleteTx(ctx, tx, req.Id)
+	}); err != nil {
 		if errors.Is(err, common.ErrCategoryNotFound) {
 			return err
 		}
 		return fmt.Errorf("xóa danh mục sản phẩm thất bại: %w", err)
 	}
 
+	s.invalidateCategoryCache(ctx)
+
 	return nil
 }
 
 func (s *productServiceImpl) PermanentlyDeleteCategories(ctx context.Context, req *productpb.PermanentlyDeleteManyRequest) error {
-	categories, err := s.categoryRepo.FindAllByID(ctx, req.Ids)
+	categories, err := s.categoryRepo.FindAllDeletedByID(ctx, req.Ids)
 	if err != nil {
 		return fmt.Errorf("tìm kiếm danh mục sản phẩm thất bại: %w", err)
 	}
 	if len(categories) != len(req.Ids) {
-		return common.ErrHasCategoryNotFound
+		return missingIDsError(common.ErrHasCategoryNotFound, req.Ids, getIDsFromCategories(categories))
 	}
 
-	if err = s.categoryRepo.DeleteAllByID(ctx, req.Ids); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.categoryRepo.DeleteAllByIDTx(ctx, tx, req.Ids)
+	}); err != nil {
 		return fmt.Errorf("xóa danh sách danh mục sản phẩm thất bại: %w", err)
 	}
 
+	s.invalidateCategoryCache(ctx)
+
 	return nil
 }
 
@@ -1438,13 +2131,21 @@ func (s *productServiceImpl) DeleteColor(ctx context.Context, req *productpb.Del
 		"is_deleted":    true,
 		"updated_by_id": req.UserId,
 	}
-	if err := s.colorRepo.Update(ctx, req.Id, updateData); err != nil {
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.colorRepo.UpdateTx(ctx, tx, req.Id, updateData)
+	}); err != nil {
 		if errors.Is(err, common.ErrColorNotFound) {
 			return err
 		}
 		return fmt.Errorf("chuyển màu sắc vào thùng rác thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.ColorsCacheKey)
+
 	return nil
 }
 
@@ -1453,13 +2154,21 @@ func (s *productServiceImpl) DeleteSize(ctx context.Context, req *productpb.Dele
 		"is_deleted":    true,
 		"updated_by_id": req.UserId,
 	}
-	if err := s.sizeRepo.Update(ctx, req.Id, updateData); err != nil {
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.sizeRepo.UpdateTx(ctx, tx, req.Id, updateData)
+	}); err != nil {
 		if errors.Is(err, common.ErrSizeNotFound) {
 			return err
 		}
 		return fmt.Errorf("chuyển kích cỡ vào thùng rác thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.SizesCacheKey)
+
 	return nil
 }
 
@@ -1469,17 +2178,25 @@ func (s *productServiceImpl) DeleteColors(ctx context.Context, req *productpb.De
 		return fmt.Errorf("tìm kiếm màu sắc thất bại: %w", err)
 	}
 	if len(colors) != len(req.Ids) {
-		return common.ErrHasColorNotFound
+		return missingIDsError(common.ErrHasColorNotFound, req.Ids, getIDsFromColors(colors))
 	}
 
 	updateData := map[string]any{
 		"is_deleted":    true,
 		"updated_by_id": req.UserId,
 	}
-	if err = s.colorRepo.UpdateAllByID(ctx, req.Ids, updateData); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.colorRepo.UpdateAllByIDTx(ctx, tx, req.Ids, updateData)
+	}); err != nil {
 		return fmt.Errorf("chuyển danh sách màu sắc vào thùng rác thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.ColorsCacheKey)
+
 	return nil
 }
 
@@ -1489,17 +2206,25 @@ func (s *productServiceImpl) DeleteSizes(ctx context.Context, req *productpb.Del
 		return fmt.Errorf("tìm kiếm màu sắc thất bại: %w", err)
 	}
 	if len(sizes) != len(req.Ids) {
-		return common.ErrHasSizeNotFound
+		return missingIDsError(common.ErrHasSizeNotFound, req.Ids, getIDsFromSizes(sizes))
 	}
 
 	updateData := map[string]any{
 		"is_deleted":    true,
 		"updated_by_id": req.UserId,
 	}
-	if err = s.sizeRepo.UpdateAllByID(ctx, req.Ids, updateData); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.sizeRepo.UpdateAllByIDTx(ctx, tx, req.Ids, updateData)
+	}); err != nil {
 		return fmt.Errorf("chuyển danh sách kích cỡ vào thùng rác thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.SizesCacheKey)
+
 	return nil
 }
 
@@ -1522,6 +2247,7 @@ func (s *productServiceImpl) GetDeletedProducts(ctx context.Context, req *produc
 		Search:     req.Search,
 		Order:      req.Order,
 		IsActive:   req.IsActive,
+		Status:     req.GetStatus(),
 		CategoryID: req.CategoryId,
 	}
 
@@ -1633,9 +2359,10 @@ func (s *productServiceImpl) GetDeletedColors(ctx context.Context) (*productpb.C
 	var colorResponses []*productpb.ColorAdminResponse
 	for _, color := range colors {
 		colorResponses = append(colorResponses, &productpb.ColorAdminResponse{
-			Id:        color.ID,
-			Name:      color.Name,
-			CreatedAt: color.CreatedAt.Format(time.RFC3339),
+			Id:           color.ID,
+			Name:         color.Name,
+			Translations: toTranslationsResponse(color.Translations),
+			CreatedAt:    color.CreatedAt.Format(time.RFC3339),
 			CreatedBy: &productpb.BaseUserResponse{
 				Id:       color.CreatedByID,
 				Username: userMap[color.CreatedByID].Username,
@@ -1704,9 +2431,10 @@ func (s *productServiceImpl) GetDeletedSizes(ctx context.Context) (*productpb.Si
 	var sizeResponses []*productpb.SizeAdminResponse
 	for _, size := range sizes {
 		sizeResponses = append(sizeResponses, &productpb.SizeAdminResponse{
-			Id:        size.ID,
-			Name:      size.Name,
-			CreatedAt: size.CreatedAt.Format(time.RFC3339),
+			Id:           size.ID,
+			Name:         size.Name,
+			Translations: toTranslationsResponse(size.Translations),
+			CreatedAt:    size.CreatedAt.Format(time.RFC3339),
 			CreatedBy: &productpb.BaseUserResponse{
 				Id:       size.CreatedByID,
 				Username: userMap[size.CreatedByID].Username,
@@ -1775,9 +2503,10 @@ func (s *productServiceImpl) GetDeletedTags(ctx context.Context) (*productpb.Tag
 	var tagResponses []*productpb.TagAdminResponse
 	for _, tag := range tags {
 		tagResponses = append(tagResponses, &productpb.TagAdminResponse{
-			Id:        tag.ID,
-			Name:      tag.Name,
-			CreatedAt: tag.CreatedAt.Format(time.RFC3339),
+			Id:           tag.ID,
+			Name:         tag.Name,
+			Translations: toTranslationsResponse(tag.Translations),
+			CreatedAt:    tag.CreatedAt.Format(time.RFC3339),
 			CreatedBy: &productpb.BaseUserResponse{
 				Id:       tag.CreatedByID,
 				Username: userMap[tag.CreatedByID].Username,
@@ -1810,13 +2539,21 @@ func (s *productServiceImpl) DeleteTag(ctx context.Context, req *productpb.Delet
 		"is_deleted":    true,
 		"updated_by_id": req.UserId,
 	}
-	if err := s.tagRepo.Update(ctx, req.Id, updateData); err != nil {
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.tagRepo.UpdateTx(ctx, tx, req.Id, updateData)
+	}); err != nil {
 		if errors.Is(err, common.ErrTagNotFound) {
 			return err
 		}
 		return fmt.Errorf("chuyển tag vào thùng rác thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.TagsCacheKey)
+
 	return nil
 }
 
@@ -1826,17 +2563,25 @@ func (s *productServiceImpl) DeleteTags(ctx context.Context, req *productpb.Dele
 		return fmt.Errorf("tìm kiếm tag thất bại: %w", err)
 	}
 	if len(tags) != len(req.Ids) {
-		return common.ErrHasSizeNotFound
+		return missingIDsError(common.ErrHasSizeNotFound, req.Ids, getIDsFromTags(tags))
 	}
 
 	updateData := map[string]any{
 		"is_deleted":    true,
 		"updated_by_id": req.UserId,
 	}
-	if err = s.tagRepo.UpdateAllByID(ctx, req.Ids, updateData); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.tagRepo.UpdateAllByIDTx(ctx, tx, req.Ids, updateData)
+	}); err != nil {
 		return fmt.Errorf("chuyển danh sách tag vào thùng rác thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.TagsCacheKey)
+
 	return nil
 }
 
@@ -1851,9 +2596,16 @@ func (s *productServiceImpl) RestoreProduct(ctx context.Context, req *productpb.
 
 	updateData := map[string]any{
 		"is_deleted":    false,
+		"version":       gorm.Expr("version + 1"),
 		"updated_by_id": req.UserId,
 	}
-	if err = s.productRepo.Update(ctx, req.Id, updateData); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.productRepo.UpdateTx(ctx, tx, req.Id, updateData)
+	}); err != nil {
 		if errors.Is(err, common.ErrProductNotFound) {
 			return err
 		}
@@ -1869,14 +2621,21 @@ func (s *productServiceImpl) RestoreProducts(ctx context.Context, req *productpb
 		return fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
 	}
 	if len(products) != len(req.Ids) {
-		return common.ErrHasProductNotFound
+		return missingIDsError(common.ErrHasProductNotFound, req.Ids, getIDsFromProducts(products))
 	}
 
 	updateData := map[string]any{
 		"is_deleted":    false,
+		"version":       gorm.Expr("version + 1"),
 		"updated_by_id": req.UserId,
 	}
-	if err = s.productRepo.UpdateAllByID(ctx, req.Ids, updateData); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.productRepo.UpdateAllByIDTx(ctx, tx, req.Ids, updateData)
+	}); err != nil {
 		return fmt.Errorf("khôi phục danh sách sản phẩm thất bại: %w", err)
 	}
 
@@ -1896,13 +2655,21 @@ func (s *productServiceImpl) RestoreColor(ctx context.Context, req *productpb.Re
 		"is_deleted":    false,
 		"updated_by_id": req.UserId,
 	}
-	if err = s.colorRepo.Update(ctx, req.Id, updateData); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.colorRepo.UpdateTx(ctx, tx, req.Id, updateData)
+	}); err != nil {
 		if errors.Is(err, common.ErrColorNotFound) {
 			return err
 		}
 		return fmt.Errorf("khôi phục màu sắc thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.ColorsCacheKey)
+
 	return nil
 }
 
@@ -1912,17 +2679,25 @@ func (s *productServiceImpl) RestoreColors(ctx context.Context, req *productpb.R
 		return fmt.Errorf("tìm kiếm màu sắc thất bại: %w", err)
 	}
 	if len(colors) != len(req.Ids) {
-		return common.ErrHasColorNotFound
+		return missingIDsError(common.ErrHasColorNotFound, req.Ids, getIDsFromColors(colors))
 	}
 
 	updateData := map[string]any{
 		"is_deleted":    false,
 		"updated_by_id": req.UserId,
 	}
-	if err = s.colorRepo.UpdateAllByID(ctx, req.Ids, updateData); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.colorRepo.UpdateAllByIDTx(ctx, tx, req.Ids, updateData)
+	}); err != nil {
 		return fmt.Errorf("khôi phục danh sách màu sắc thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.ColorsCacheKey)
+
 	return nil
 }
 
@@ -1939,13 +2714,21 @@ func (s *productServiceImpl) RestoreSize(ctx context.Context, req *productpb.Res
 		"is_deleted":    false,
 		"updated_by_id": req.UserId,
 	}
-	if err = s.sizeRepo.Update(ctx, req.Id, updateData); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.sizeRepo.UpdateTx(ctx, tx, req.Id, updateData)
+	}); err != nil {
 		if errors.Is(err, common.ErrSizeNotFound) {
 			return err
 		}
 		return fmt.Errorf("khôi phục kích cỡ thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.SizesCacheKey)
+
 	return nil
 }
 
@@ -1955,17 +2738,25 @@ func (s *productServiceImpl) RestoreSizes(ctx context.Context, req *productpb.Re
 		return fmt.Errorf("tìm kiếm kích cỡ thất bại: %w", err)
 	}
 	if len(sizes) != len(req.Ids) {
-		return common.ErrHasSizeNotFound
+		return missingIDsError(common.ErrHasSizeNotFound, req.Ids, getIDsFromSizes(sizes))
 	}
 
 	updateData := map[string]any{
 		"is_deleted":    false,
 		"updated_by_id": req.UserId,
 	}
-	if err = s.sizeRepo.UpdateAllByID(ctx, req.Ids, updateData); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.sizeRepo.UpdateAllByIDTx(ctx, tx, req.Ids, updateData)
+	}); err != nil {
 		return fmt.Errorf("khôi phục danh sách kích cỡ thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.SizesCacheKey)
+
 	return nil
 }
 
@@ -1982,13 +2773,21 @@ func (s *productServiceImpl) RestoreTag(ctx context.Context, req *productpb.Rest
 		"is_deleted":    false,
 		"updated_by_id": req.UserId,
 	}
-	if err = s.tagRepo.Update(ctx, req.Id, updateData); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.tagRepo.UpdateTx(ctx, tx, req.Id, updateData)
+	}); err != nil {
 		if errors.Is(err, common.ErrSizeNotFound) {
 			return err
 		}
 		return fmt.Errorf("khôi phục tag thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.TagsCacheKey)
+
 	return nil
 }
 
@@ -1998,17 +2797,25 @@ func (s *productServiceImpl) RestoreTags(ctx context.Context, req *productpb.Res
 		return fmt.Errorf("tìm kiếm tag thất bại: %w", err)
 	}
 	if len(tags) != len(req.Ids) {
-		return common.ErrHasTagNotFound
+		return missingIDsError(common.ErrHasTagNotFound, req.Ids, getIDsFromTags(tags))
 	}
 
 	updateData := map[string]any{
 		"is_deleted":    false,
 		"updated_by_id": req.UserId,
 	}
-	if err = s.tagRepo.UpdateAllByID(ctx, req.Ids, updateData); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.tagRepo.UpdateAllByIDTx(ctx, tx, req.Ids, updateData)
+	}); err != nil {
 		return fmt.Errorf("khôi phục danh sách tag thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.TagsCacheKey)
+
 	return nil
 }
 
@@ -2021,7 +2828,17 @@ func (s *productServiceImpl) PermanentlyDeleteProduct(ctx context.Context, req *
 		return common.ErrProductNotFound
 	}
 
-	if err = s.productRepo.Delete(ctx, req.Id); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		if err := s.wishlistRepo.DeleteAllByProductIDTx(ctx, tx, []string{product.ID}); err != nil {
+			return fmt.Errorf("xóa sản phẩm khỏi danh sách yêu thích thất bại: %w", err)
+		}
+
+		return s.productRepo.DeleteTx(ctx, tx, req.Id)
+	}); err != nil {
 		if errors.Is(err, common.ErrProductNotFound) {
 			return err
 		}
@@ -2039,7 +2856,7 @@ func (s *productServiceImpl) PermanentlyDeleteProduct(ctx context.Context, req *
 	go func() {
 		for deleteReq := range publishChan {
 			body := []byte(deleteReq)
-			if err := mq.PublishMessage(s.publisher, common.DeleteTopic, body); err != nil {
+			if err := mq.PublishMessage(ctx, s.publisher, common.DeleteTopic, body); err != nil {
 				log.Printf("publish delete image msg thất bại: %v", err)
 			}
 		}
@@ -2054,10 +2871,20 @@ func (s *productServiceImpl) PermanentlyDeleteProducts(ctx context.Context, req
 		return fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
 	}
 	if len(products) != len(req.Ids) {
-		return common.ErrHasProductNotFound
+		return missingIDsError(common.ErrHasProductNotFound, req.Ids, getIDsFromProducts(products))
 	}
 
-	if err = s.productRepo.DeleteAllByID(ctx, req.Ids); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		if err := s.wishlistRepo.DeleteAllByProductIDTx(ctx, tx, req.Ids); err != nil {
+			return fmt.Errorf("xóa sản phẩm khỏi danh sách yêu thích thất bại: %w", err)
+		}
+
+		return s.productRepo.DeleteAllByIDTx(ctx, tx, req.Ids)
+	}); err != nil {
 		return fmt.Errorf("xóa danh sách sản phẩm thất bại: %w", err)
 	}
 
@@ -2082,7 +2909,7 @@ func (s *productServiceImpl) PermanentlyDeleteProducts(ctx context.Context, req
 	go func() {
 		for deleteReq := range publishChan {
 			body := []byte(deleteReq)
-			if err := mq.PublishMessage(s.publisher, common.DeleteTopic, body); err != nil {
+			if err := mq.PublishMessage(ctx, s.publisher, common.DeleteTopic, body); err != nil {
 				log.Printf("publish delete image msg thất bại: %v", err)
 			}
 		}
@@ -2092,13 +2919,21 @@ func (s *productServiceImpl) PermanentlyDeleteProducts(ctx context.Context, req
 }
 
 func (s *productServiceImpl) PermanentlyDeleteColor(ctx context.Context, req *productpb.PermanentlyDeleteOneRequest) error {
-	if err := s.colorRepo.Delete(ctx, req.Id); err != nil {
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.colorRepo.DeleteTx(ctx, tx, req.Id)
+	}); err != nil {
 		if errors.Is(err, common.ErrColorNotFound) {
 			return err
 		}
 		return fmt.Errorf("xóa màu sắc thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.ColorsCacheKey)
+
 	return nil
 }
 
@@ -2108,24 +2943,40 @@ func (s *productServiceImpl) PermanentlyDeleteColors(ctx context.Context, req *p
 		return fmt.Errorf("tìm kiếm màu sắc thất bại: %w", err)
 	}
 	if len(colors) != len(req.Ids) {
-		return common.ErrHasColorNotFound
+		return missingIDsError(common.ErrHasColorNotFound, req.Ids, getIDsFromColors(colors))
 	}
 
-	if err = s.colorRepo.DeleteAllByID(ctx, req.Ids); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.colorRepo.DeleteAllByIDTx(ctx, tx, req.Ids)
+	}); err != nil {
 		return fmt.Errorf("xóa danh sách màu sắc thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.ColorsCacheKey)
+
 	return nil
 }
 
 func (s *productServiceImpl) PermanentlyDeleteSize(ctx context.Context, req *productpb.PermanentlyDeleteOneRequest) error {
-	if err := s.sizeRepo.Delete(ctx, req.Id); err != nil {
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.sizeRepo.DeleteTx(ctx, tx, req.Id)
+	}); err != nil {
 		if errors.Is(err, common.ErrSizeNotFound) {
 			return err
 		}
 		return fmt.Errorf("xóa kích cỡ thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.SizesCacheKey)
+
 	return nil
 }
 
@@ -2135,24 +2986,40 @@ func (s *productServiceImpl) PermanentlyDeleteSizes(ctx context.Context, req *pr
 		return fmt.Errorf("tìm kiếm kích cỡ thất bại: %w", err)
 	}
 	if len(sizes) != len(req.Ids) {
-		return common.ErrHasSizeNotFound
+		return missingIDsError(common.ErrHasSizeNotFound, req.Ids, getIDsFromSizes(sizes))
 	}
 
-	if err = s.sizeRepo.DeleteAllByID(ctx, req.Ids); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.sizeRepo.DeleteAllByIDTx(ctx, tx, req.Ids)
+	}); err != nil {
 		return fmt.Errorf("xóa danh sách kích cỡ thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.SizesCacheKey)
+
 	return nil
 }
 
 func (s *productServiceImpl) PermanentlyDeleteTag(ctx context.Context, req *productpb.PermanentlyDeleteOneRequest) error {
-	if err := s.tagRepo.Delete(ctx, req.Id); err != nil {
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.tagRepo.DeleteTx(ctx, tx, req.Id)
+	}); err != nil {
 		if errors.Is(err, common.ErrTagNotFound) {
 			return err
 		}
 		return fmt.Errorf("xóa tag thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.TagsCacheKey)
+
 	return nil
 }
 
@@ -2162,13 +3029,21 @@ func (s *productServiceImpl) PermanentlyDeleteTags(ctx context.Context, req *pro
 		return fmt.Errorf("tìm kiếm tag thất bại: %w", err)
 	}
 	if len(tags) != len(req.Ids) {
-		return common.ErrHasTagNotFound
+		return missingIDsError(common.ErrHasTagNotFound, req.Ids, getIDsFromTags(tags))
 	}
 
-	if err = s.tagRepo.DeleteAllByID(ctx, req.Ids); err != nil {
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.tagRepo.DeleteAllByIDTx(ctx, tx, req.Ids)
+	}); err != nil {
 		return fmt.Errorf("xóa danh sách tag thất bại: %w", err)
 	}
 
+	s.invalidateCache(ctx, common.TagsCacheKey)
+
 	return nil
 }
 
@@ -2178,67 +3053,2458 @@ func (s *productServiceImpl) GetImagesByProductID(ctx context.Context, productID
 		return nil, fmt.Errorf("lấy ảnh của sản phẩm thất bại: %w", err)
 	}
 
-	return images, nil
+	return images, nil
+}
+
+func (s *productServiceImpl) UpdateBundleItems(ctx context.Context, req *productpb.UpdateBundleItemsRequest) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		product, err := s.productRepo.FindByIDWithCategoriesAndTagsTx(ctx, tx, req.BundleId)
+		if err != nil {
+			if strings.Contains(err.Error(), "lock") {
+				return fmt.Errorf("sản phẩm đang được cập nhật bởi người dùng khác, vui lòng thử lại: %w", err)
+			}
+			return fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
+		}
+		if product == nil {
+			return common.ErrProductNotFound
+		}
+		if err = common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+		if !product.IsBundle() {
+			return common.ErrNotBundleProduct
+		}
+
+		bundleItems, err := s.buildBundleItemsTx(ctx, tx, product.ID, req.Items)
+		if err != nil {
+			return err
+		}
+
+		if err = s.bundleRepo.DeleteAllByBundleIDTx(ctx, tx, product.ID); err != nil {
+			return fmt.Errorf("xóa thành phần combo thất bại: %w", err)
+		}
+
+		if err = s.bundleRepo.CreateAllTx(ctx, tx, bundleItems); err != nil {
+			return fmt.Errorf("tạo thành phần combo thất bại: %w", err)
+		}
+
+		updateData := map[string]any{
+			"version":       product.Version + 1,
+			"updated_by_id": req.UserId,
+		}
+		if err = s.productRepo.UpdateTx(ctx, tx, product.ID, updateData); err != nil {
+			return fmt.Errorf("cập nhật sản phẩm thất bại: %w", err)
+		}
+
+		_, err = s.createProductRevisionTx(ctx, tx, product.ID, req.UserId)
+		return err
+	})
+}
+
+func (s *productServiceImpl) GetBundleItems(ctx context.Context, bundleID string) (*model.Product, error) {
+	product, err := s.productRepo.FindPublishedByID(ctx, bundleID)
+	if err != nil {
+		return nil, fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
+	}
+	if product == nil {
+		return nil, common.ErrProductNotFound
+	}
+	if !product.IsBundle() {
+		return nil, common.ErrNotBundleProduct
+	}
+
+	bundleItems, err := s.bundleRepo.FindAllByBundleIDWithDetails(ctx, product.ID)
+	if err != nil {
+		return nil, fmt.Errorf("lấy thành phần combo thất bại: %w", err)
+	}
+	product.BundleItems = bundleItems
+	product.Localize(common.LocalesFromContext(ctx))
+
+	return product, nil
+}
+
+func (s *productServiceImpl) SellBundle(ctx context.Context, req *productpb.SellBundleRequest) error {
+	return s.updateBundleStock(ctx, req.BundleId, req.Quantity, func(inv *model.Inventory, required int) error {
+		if inv.Stock < required {
+			return common.ErrInsufficientStock
+		}
+		inv.SoldQuantity += required
+
+		return nil
+	})
+}
+
+func (s *productServiceImpl) ReserveBundle(ctx context.Context, req *productpb.ReserveBundleRequest) error {
+	return s.updateBundleStock(ctx, req.BundleId, req.Quantity, func(inv *model.Inventory, required int) error {
+		if inv.Stock < required {
+			return common.ErrInsufficientStock
+		}
+		inv.ReservedQuantity += required
+
+		return nil
+	})
+}
+
+func (s *productServiceImpl) ReleaseBundle(ctx context.Context, req *productpb.ReleaseBundleRequest) error {
+	return s.updateBundleStock(ctx, req.BundleId, req.Quantity, func(inv *model.Inventory, required int) error {
+		if inv.ReservedQuantity < required {
+			return common.ErrInsufficientReservedStock
+		}
+		inv.ReservedQuantity -= required
+
+		return nil
+	})
+}
+
+func (s *productServiceImpl) updateBundleStock(ctx context.Context, bundleID string, quantity int32, apply func(inv *model.Inventory, required int) error) error {
+	if quantity <= 0 {
+		return common.ErrInvalidBundleItem
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		product, err := s.productRepo.FindByIDTx(ctx, tx, bundleID)
+		if err != nil {
+			return fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
+		}
+		if product == nil {
+			return common.ErrProductNotFound
+		}
+		if !product.IsBundle() {
+			return common.ErrNotBundleProduct
+		}
+
+		bundleItems, err := s.bundleRepo.FindAllByBundleIDTx(ctx, tx, product.ID)
+		if err != nil {
+			return fmt.Errorf("lấy thành phần combo thất bại: %w", err)
+		}
+		if len(bundleItems) == 0 {
+			return common.ErrInvalidBundleItem
+		}
+
+		variantIDs := make([]string, 0, len(bundleItems))
+		for _, item := range bundleItems {
+			variantIDs = append(variantIDs, item.VariantID)
+		}
+
+		inventories, err := s.inventoryRepo.FindAllByVariantIDTx(ctx, tx, variantIDs)
+		if err != nil {
+			return fmt.Errorf("lấy tồn kho thành phần combo thất bại: %w", err)
+		}
+
+		invMap := make(map[string]*model.Inventory, len(inventories))
+		for _, inv := range inventories {
+			invMap[inv.VariantID] = inv
+		}
+
+		for _, item := range bundleItems {
+			inv, ok := invMap[item.VariantID]
+			if !ok {
+				return common.ErrInventoryNotFound
+			}
+
+			if err = apply(inv, item.Quantity*int(quantity)); err != nil {
+				return err
+			}
+			inv.IsStock = true
+			inv.SetStock()
+
+			updateData := map[string]any{
+				"sold_quantity":     inv.SoldQuantity,
+				"reserved_quantity": inv.ReservedQuantity,
+				"stock":             inv.Stock,
+				"is_stock":          inv.IsStock,
+			}
+			if err = s.inventoryRepo.UpdateByVariantIDTx(ctx, tx, item.VariantID, updateData); err != nil {
+				if errors.Is(err, common.ErrInventoryNotFound) {
+					return err
+				}
+				return fmt.Errorf("cập nhật tồn kho biến thể %s thất bại: %w", item.VariantID, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *productServiceImpl) AddProductRelations(ctx context.Context, req *productpb.AddProductRelationsRequest) error {
+	product, err := s.productRepo.FindByID(ctx, req.ProductId)
+	if err != nil {
+		return fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
+	}
+	if product == nil {
+		return common.ErrProductNotFound
+	}
+
+	relatedIDMap := map[string]struct{}{}
+	for _, rel := range req.Relations {
+		if !isValidRelationType(rel.Type) {
+			return common.ErrInvalidRelationType
+		}
+		if rel.RelatedProductId == product.ID {
+			return common.ErrSelfRelation
+		}
+		relatedIDMap[rel.RelatedProductId] = struct{}{}
+	}
+
+	var relatedIDs []string
+	for id := range relatedIDMap {
+		relatedIDs = append(relatedIDs, id)
+	}
+
+	relatedProducts, err := s.productRepo.FindAllByID(ctx, relatedIDs)
+	if err != nil {
+		return fmt.Errorf("tìm kiếm sản phẩm liên quan thất bại: %w", err)
+	}
+	if len(relatedProducts) != len(relatedIDs) {
+		return missingIDsError(common.ErrHasProductNotFound, relatedIDs, getIDsFromProducts(relatedProducts))
+	}
+
+	relations := make([]*model.ProductRelation, 0, len(req.Relations))
+	for _, rel := range req.Relations {
+		relations = append(relations, &model.ProductRelation{
+			ID:               uuid.NewString(),
+			ProductID:        product.ID,
+			RelatedProductID: rel.RelatedProductId,
+			Type:             rel.Type,
+			SortOrder:        int(rel.SortOrder),
+			CreatedByID:      req.UserId,
+		})
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		if err = s.relationRepo.CreateAllTx(ctx, tx, relations); err != nil {
+			if isUniqueViolation(err) {
+				return uniqueViolationError(err, common.ErrRelationAlreadyExists)
+			}
+			return fmt.Errorf("tạo liên kết sản phẩm thất bại: %w", err)
+		}
+
+		return s.touchProductTx(ctx, tx, product.ID, req.UserId)
+	})
+}
+
+func (s *productServiceImpl) RemoveProductRelations(ctx context.Context, req *productpb.RemoveProductRelationsRequest) error {
+	relations, err := s.relationRepo.FindAllByIDAndProductID(ctx, req.Ids, req.ProductId)
+	if err != nil {
+		return fmt.Errorf("tìm kiếm liên kết sản phẩm thất bại: %w", err)
+	}
+	if len(relations) != len(req.Ids) {
+		return missingIDsError(common.ErrHasRelationNotFound, req.Ids, getIDsFromRelations(relations))
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		if err = s.relationRepo.DeleteAllByIDTx(ctx, tx, req.Ids); err != nil {
+			return fmt.Errorf("xóa liên kết sản phẩm thất bại: %w", err)
+		}
+
+		return s.touchProductTx(ctx, tx, req.ProductId, req.UserId)
+	})
+}
+
+func (s *productServiceImpl) touchProductTx(ctx context.Context, tx *gorm.DB, productID, userID string) error {
+	updateData := map[string]any{
+		"version":       gorm.Expr("version + 1"),
+		"updated_by_id": userID,
+	}
+	if err := s.productRepo.UpdateTx(ctx, tx, productID, updateData); err != nil {
+		return fmt.Errorf("cập nhật sản phẩm thất bại: %w", err)
+	}
+
+	return nil
+}
+
+func (s *productServiceImpl) GetProductRelations(ctx context.Context, productID string) ([]*model.ProductRelation, error) {
+	exists, err := s.productRepo.ExistsByID(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
+	}
+	if !exists {
+		return nil, common.ErrProductNotFound
+	}
+
+	relations, err := s.relationRepo.FindAllByProductIDWithRelated(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("lấy danh sách liên kết sản phẩm thất bại: %w", err)
+	}
+
+	return relations, nil
+}
+
+func (s *productServiceImpl) GetRelatedProducts(ctx context.Context, req *productpb.GetRelatedProductsRequest) ([]*model.ProductRelation, error) {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Limit > 50 {
+		req.Limit = 50
+	}
+
+	var relationType string
+	if req.Type != nil {
+		if !isValidRelationType(*req.Type) {
+			return nil, common.ErrInvalidRelationType
+		}
+		relationType = *req.Type
+	}
+
+	product, err := s.productRepo.FindByID(ctx, req.ProductId)
+	if err != nil {
+		return nil, fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
+	}
+	if product == nil || !product.IsPublished() {
+		return nil, common.ErrProductNotFound
+	}
+
+	relations, err := s.relationRepo.FindAllActiveByProductIDAndType(ctx, product.ID, relationType)
+	if err != nil {
+		return nil, fmt.Errorf("lấy danh sách sản phẩm liên quan thất bại: %w", err)
+	}
+
+	limit := int(req.Limit)
+	if len(relations) > 0 || relationType != "" {
+		if len(relations) > limit {
+			relations = relations[:limit]
+		}
+		localizeRelatedProducts(ctx, relations)
+		return relations, nil
+	}
+
+	similarProducts, err := s.productRepo.FindAllSimilarWithThumbnail(ctx, product.ID, nil, limit)
+	if err != nil {
+		return nil, fmt.Errorf("lấy danh sách sản phẩm tương tự thất bại: %w", err)
+	}
+
+	for _, p := range similarProducts {
+		relations = append(relations, &model.ProductRelation{
+			ProductID:        product.ID,
+			RelatedProductID: p.ID,
+			Type:             common.RelationTypeSimilar,
+			RelatedProduct:   p,
+		})
+	}
+	localizeRelatedProducts(ctx, relations)
+
+	return relations, nil
+}
+
+func (s *productServiceImpl) CreateReview(ctx context.Context, req *productpb.CreateReviewRequest) (string, error) {
+	if req.Rating < 1 || req.Rating > 5 {
+		return "", common.ErrInvalidRating
+	}
+
+	product, err := s.productRepo.FindPublishedByID(ctx, req.ProductId)
+	if err != nil {
+		return "", fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
+	}
+	if product == nil {
+		return "", common.ErrProductNotFound
+	}
+
+	if _, err = s.getUser(ctx, req.UserId); err != nil {
+		return "", err
+	}
+
+	review := &model.Review{
+		ID:        uuid.NewString(),
+		ProductID: product.ID,
+		UserID:    req.UserId,
+		Rating:    int(req.Rating),
+		Title:     req.Title,
+		Content:   req.Content,
+		Status:    common.ReviewStatusPending,
+	}
+
+	if err = s.reviewRepo.Create(ctx, review); err != nil {
+		if isUniqueViolation(err) {
+			return "", uniqueViolationError(err, common.ErrReviewAlreadyExists)
+		}
+		return "", fmt.Errorf("tạo đánh giá sản phẩm thất bại: %w", err)
+	}
+
+	return review.ID, nil
+}
+
+func (s *productServiceImpl) GetProductReviews(ctx context.Context, req *productpb.GetProductReviewsRequest) (*productpb.ReviewsPublicResponse, error) {
+	query := toPaginationQuery(req.Page, req.Limit, req.Sort, req.Order, "")
+
+	rating, err := toRatingFilter(req.Rating)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := s.productRepo.FindPublishedByID(ctx, req.ProductId)
+	if err != nil {
+		return nil, fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
+	}
+	if product == nil {
+		return nil, common.ErrProductNotFound
+	}
+
+	reviews, total, err := s.reviewRepo.FindAllPaginated(ctx, product.ID, common.ReviewStatusApproved, rating, query)
+	if err != nil {
+		return nil, fmt.Errorf("lấy danh sách đánh giá sản phẩm thất bại: %w", err)
+	}
+
+	userIDMap := map[string]struct{}{}
+	for _, review := range reviews {
+		userIDMap[review.UserID] = struct{}{}
+	}
+
+	userMap, err := s.getUserMap(ctx, userIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewResponses := make([]*productpb.ReviewPublicResponse, 0, len(reviews))
+	for _, review := range reviews {
+		reviewResponses = append(reviewResponses, &productpb.ReviewPublicResponse{
+			Id:                 review.ID,
+			Rating:             uint32(review.Rating),
+			Title:              review.Title,
+			Content:            review.Content,
+			IsVerifiedPurchase: &review.IsVerifiedPurchase,
+			CreatedAt:          review.CreatedAt.Format(time.RFC3339),
+			User:               toBaseUserResponse(userMap[review.UserID]),
+		})
+	}
+
+	return &productpb.ReviewsPublicResponse{
+		Reviews:       reviewResponses,
+		Meta:          toPaginationMetaResponse(buildPaginationMeta(query, total)),
+		AverageRating: product.AverageRating,
+		ReviewCount:   uint32(product.ReviewCount),
+	}, nil
+}
+
+func (s *productServiceImpl) GetAllReviewsAdmin(ctx context.Context, req *productpb.GetAllReviewsAdminRequest) (*productpb.ReviewsAdminResponse, error) {
+	if req.Status != "" && !isValidReviewStatus(req.Status) {
+		return nil, common.ErrInvalidReviewStatus
+	}
+
+	query := toPaginationQuery(req.Page, req.Limit, req.Sort, req.Order, req.Search)
+
+	rating, err := toRatingFilter(req.Rating)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, total, err := s.reviewRepo.FindAllPaginated(ctx, req.ProductId, req.Status, rating, query)
+	if err != nil {
+		return nil, fmt.Errorf("lấy danh sách đánh giá sản phẩm thất bại: %w", err)
+	}
+
+	userIDMap := map[string]struct{}{}
+	for _, review := range reviews {
+		userIDMap[review.UserID] = struct{}{}
+		if review.ModeratedByID != nil {
+			userIDMap[*review.ModeratedByID] = struct{}{}
+		}
+	}
+
+	userMap, err := s.getUserMap(ctx, userIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewResponses := make([]*productpb.ReviewAdminResponse, 0, len(reviews))
+	for _, review := range reviews {
+		moderatedBy, moderatedAt := toModerationResponse(userMap, review.ModeratedByID, review.ModeratedAt)
+		reviewResponses = append(reviewResponses, &productpb.ReviewAdminResponse{
+			Id:                 review.ID,
+			ProductId:          review.ProductID,
+			Rating:             uint32(review.Rating),
+			Title:              review.Title,
+			Content:            review.Content,
+			IsVerifiedPurchase: &review.IsVerifiedPurchase,
+			Status:             review.Status,
+			CreatedAt:          review.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:          review.UpdatedAt.Format(time.RFC3339),
+			User:               toBaseUserResponse(userMap[review.UserID]),
+			ModeratedBy:        moderatedBy,
+			ModeratedAt:        moderatedAt,
+		})
+	}
+
+	return &productpb.ReviewsAdminResponse{
+		Reviews: reviewResponses,
+		Meta:    toPaginationMetaResponse(buildPaginationMeta(query, total)),
+	}, nil
+}
+
+func (s *productServiceImpl) ModerateReview(ctx context.Context, req *productpb.ModerateReviewRequest) error {
+	if !isValidReviewStatus(req.Status) {
+		return common.ErrInvalidReviewStatus
+	}
+
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		review, err := s.reviewRepo.FindByIDTx(ctx, tx, req.Id)
+		if err != nil {
+			return fmt.Errorf("tìm kiếm đánh giá sản phẩm thất bại: %w", err)
+		}
+		if review == nil {
+			return common.ErrReviewNotFound
+		}
+		if err = common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		verifiedChanged := req.IsVerifiedPurchase != nil && review.IsVerifiedPurchase != *req.IsVerifiedPurchase
+		if review.Status == req.Status && !verifiedChanged {
+			return nil
+		}
+
+		updateData := map[string]any{
+			"status":          req.Status,
+			"moderated_by_id": req.UserId,
+			"moderated_at":    time.Now(),
+		}
+		if verifiedChanged {
+			updateData["is_verified_purchase"] = *req.IsVerifiedPurchase
+		}
+
+		if err = s.reviewRepo.UpdateTx(ctx, tx, review.ID, updateData); err != nil {
+			if errors.Is(err, common.ErrReviewNotFound) {
+				return err
+			}
+			return fmt.Errorf("cập nhật đánh giá sản phẩm thất bại: %w", err)
+		}
+
+		averageRating, reviewCount, err := s.reviewRepo.GetRatingSummaryByProductIDTx(ctx, tx, review.ProductID)
+		if err != nil {
+			return fmt.Errorf("tính điểm đánh giá sản phẩm thất bại: %w", err)
+		}
+
+		if err = s.productRepo.UpdateRatingTx(ctx, tx, review.ProductID, averageRating, reviewCount); err != nil {
+			return fmt.Errorf("cập nhật điểm đánh giá sản phẩm thất bại: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *productServiceImpl) CreateQuestion(ctx context.Context, req *productpb.CreateQuestionRequest) (string, error) {
+	product, err := s.productRepo.FindPublishedByID(ctx, req.ProductId)
+	if err != nil {
+		return "", fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
+	}
+	if product == nil {
+		return "", common.ErrProductNotFound
+	}
+
+	user, err := s.getUser(ctx, req.UserId)
+	if err != nil {
+		return "", err
+	}
+
+	question := &model.Question{
+		ID:        uuid.NewString(),
+		ProductID: product.ID,
+		UserID:    user.Id,
+		Content:   req.Content,
+		Status:    common.ModerationStatusPending,
+	}
+	if isStaff(user) {
+		question.Status = common.ModerationStatusApproved
+	}
+
+	if err = s.questionRepo.Create(ctx, question); err != nil {
+		return "", fmt.Errorf("tạo câu hỏi sản phẩm thất bại: %w", err)
+	}
+
+	return question.ID, nil
+}
+
+func (s *productServiceImpl) CreateAnswer(ctx context.Context, req *productpb.CreateAnswerRequest) (string, error) {
+	user, err := s.getUser(ctx, req.UserId)
+	if err != nil {
+		return "", err
+	}
+
+	answer := &model.Answer{
+		ID:         uuid.NewString(),
+		QuestionID: req.QuestionId,
+		UserID:     user.Id,
+		Content:    req.Content,
+		IsStaff:    isStaff(user),
+		Status:     common.ModerationStatusPending,
+	}
+	if answer.IsStaff {
+		answer.Status = common.ModerationStatusApproved
+	}
+
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		question, err := s.questionRepo.FindByIDTx(ctx, tx, req.QuestionId)
+		if err != nil {
+			return fmt.Errorf("tìm kiếm câu hỏi sản phẩm thất bại: %w", err)
+		}
+		if question == nil || question.Status == common.ModerationStatusRejected {
+			return common.ErrQuestionNotFound
+		}
+
+		if err = s.answerRepo.CreateTx(ctx, tx, answer); err != nil {
+			return fmt.Errorf("tạo câu trả lời thất bại: %w", err)
+		}
+
+		if answer.Status == common.ModerationStatusApproved {
+			if err = s.syncAnswerCountTx(ctx, tx, question.ID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return "", err
+	}
+
+	return answer.ID, nil
+}
+
+func (s *productServiceImpl) UpvoteQuestion(ctx context.Context, req *productpb.UpvoteRequest) error {
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		question, err := s.questionRepo.FindByIDTx(ctx, tx, req.Id)
+		if err != nil {
+			return fmt.Errorf("tìm kiếm câu hỏi sản phẩm thất bại: %w", err)
+		}
+		if question == nil || question.Status != common.ModerationStatusApproved {
+			return common.ErrQuestionNotFound
+		}
+
+		if err = s.questionRepo.CreateVoteTx(ctx, tx, &model.QuestionVote{
+			QuestionID: question.ID,
+			UserID:     req.UserId,
+		}); err != nil {
+			if isUniqueViolation(err) {
+				return uniqueViolationError(err, common.ErrAlreadyUpvoted)
+			}
+			return fmt.Errorf("bình chọn câu hỏi thất bại: %w", err)
+		}
+
+		if err = s.questionRepo.IncreaseUpvoteCountTx(ctx, tx, question.ID); err != nil {
+			return fmt.Errorf("cập nhật lượt bình chọn câu hỏi thất bại: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *productServiceImpl) UpvoteAnswer(ctx context.Context, req *productpb.UpvoteRequest) error {
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		answer, err := s.answerRepo.FindByIDTx(ctx, tx, req.Id)
+		if err != nil {
+			return fmt.Errorf("tìm kiếm câu trả lời thất bại: %w", err)
+		}
+		if answer == nil || answer.Status != common.ModerationStatusApproved {
+			return common.ErrAnswerNotFound
+		}
+
+		if err = s.answerRepo.CreateVoteTx(ctx, tx, &model.AnswerVote{
+			AnswerID: answer.ID,
+			UserID:   req.UserId,
+		}); err != nil {
+			if isUniqueViolation(err) {
+				return uniqueViolationError(err, common.ErrAlreadyUpvoted)
+			}
+			return fmt.Errorf("bình chọn câu trả lời thất bại: %w", err)
+		}
+
+		if err = s.answerRepo.IncreaseUpvoteCountTx(ctx, tx, answer.ID); err != nil {
+			return fmt.Errorf("cập nhật lượt bình chọn câu trả lời thất bại: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *productServiceImpl) GetProductQuestions(ctx context.Context, req *productpb.GetProductQuestionsRequest) (*productpb.QuestionsPublicResponse, error) {
+	query := toPaginationQuery(req.Page, req.Limit, req.Sort, req.Order, "")
+
+	product, err := s.productRepo.FindPublishedByID(ctx, req.ProductId)
+	if err != nil {
+		return nil, fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
+	}
+	if product == nil {
+		return nil, common.ErrProductNotFound
+	}
+
+	questions, total, err := s.questionRepo.FindAllPaginated(ctx, product.ID, common.ModerationStatusApproved, query)
+	if err != nil {
+		return nil, fmt.Errorf("lấy danh sách câu hỏi sản phẩm thất bại: %w", err)
+	}
+
+	userIDMap := map[string]struct{}{}
+	for _, question := range questions {
+		userIDMap[question.UserID] = struct{}{}
+	}
+
+	userMap, err := s.getUserMap(ctx, userIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	questionResponses := make([]*productpb.QuestionPublicResponse, 0, len(questions))
+	for _, question := range questions {
+		questionResponses = append(questionResponses, &productpb.QuestionPublicResponse{
+			Id:          question.ID,
+			Content:     question.Content,
+			UpvoteCount: uint32(question.UpvoteCount),
+			AnswerCount: uint32(question.AnswerCount),
+			CreatedAt:   question.CreatedAt.Format(time.RFC3339),
+			User:        toBaseUserResponse(userMap[question.UserID]),
+		})
+	}
+
+	return &productpb.QuestionsPublicResponse{
+		Questions: questionResponses,
+		Meta:      toPaginationMetaResponse(buildPaginationMeta(query, total)),
+	}, nil
+}
+
+func (s *productServiceImpl) GetQuestionAnswers(ctx context.Context, req *productpb.GetQuestionAnswersRequest) (*productpb.AnswersPublicResponse, error) {
+	query := toPaginationQuery(req.Page, req.Limit, req.Sort, req.Order, "")
+
+	question, err := s.questionRepo.FindByID(ctx, req.QuestionId)
+	if err != nil {
+		return nil, fmt.Errorf("tìm kiếm câu hỏi sản phẩm thất bại: %w", err)
+	}
+	if question == nil || question.Status != common.ModerationStatusApproved {
+		return nil, common.ErrQuestionNotFound
+	}
+
+	answers, total, err := s.answerRepo.FindAllPaginated(ctx, question.ID, common.ModerationStatusApproved, query)
+	if err != nil {
+		return nil, fmt.Errorf("lấy danh sách câu trả lời thất bại: %w", err)
+	}
+
+	userIDMap := map[string]struct{}{}
+	for _, answer := range answers {
+		userIDMap[answer.UserID] = struct{}{}
+	}
+
+	userMap, err := s.getUserMap(ctx, userIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	answerResponses := make([]*productpb.AnswerPublicResponse, 0, len(answers))
+	for _, answer := range answers {
+		answerResponses = append(answerResponses, &productpb.AnswerPublicResponse{
+			Id:          answer.ID,
+			Content:     answer.Content,
+			IsStaff:     &answer.IsStaff,
+			UpvoteCount: uint32(answer.UpvoteCount),
+			CreatedAt:   answer.CreatedAt.Format(time.RFC3339),
+			User:        toBaseUserResponse(userMap[answer.UserID]),
+		})
+	}
+
+	return &productpb.AnswersPublicResponse{
+		Answers: answerResponses,
+		Meta:    toPaginationMetaResponse(buildPaginationMeta(query, total)),
+	}, nil
+}
+
+func (s *productServiceImpl) GetAllQuestionsAdmin(ctx context.Context, req *productpb.GetAllQuestionsAdminRequest) (*productpb.QuestionsAdminResponse, error) {
+	if req.Status != "" && !isValidModerationStatus(req.Status) {
+		return nil, common.ErrInvalidModerationStatus
+	}
+
+	query := toPaginationQuery(req.Page, req.Limit, req.Sort, req.Order, req.Search)
+
+	questions, total, err := s.questionRepo.FindAllPaginated(ctx, req.ProductId, req.Status, query)
+	if err != nil {
+		return nil, fmt.Errorf("lấy danh sách câu hỏi sản phẩm thất bại: %w", err)
+	}
+
+	userIDMap := map[string]struct{}{}
+	for _, question := range questions {
+		userIDMap[question.UserID] = struct{}{}
+		if question.ModeratedByID != nil {
+			userIDMap[*question.ModeratedByID] = struct{}{}
+		}
+	}
+
+	userMap, err := s.getUserMap(ctx, userIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	questionResponses := make([]*productpb.QuestionAdminResponse, 0, len(questions))
+	for _, question := range questions {
+		moderatedBy, moderatedAt := toModerationResponse(userMap, question.ModeratedByID, question.ModeratedAt)
+		questionResponses = append(questionResponses, &productpb.QuestionAdminResponse{
+			Id:          question.ID,
+			ProductId:   question.ProductID,
+			Content:     question.Content,
+			Status:      question.Status,
+			UpvoteCount: uint32(question.UpvoteCount),
+			AnswerCount: uint32(question.AnswerCount),
+			CreatedAt:   question.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:   question.UpdatedAt.Format(time.RFC3339),
+			User:        toBaseUserResponse(userMap[question.UserID]),
+			ModeratedBy: moderatedBy,
+			ModeratedAt: moderatedAt,
+		})
+	}
+
+	return &productpb.QuestionsAdminResponse{
+		Questions: questionResponses,
+		Meta:      toPaginationMetaResponse(buildPaginationMeta(query, total)),
+	}, nil
+}
+
+func (s *productServiceImpl) GetAllAnswersAdmin(ctx context.Context, req *productpb.GetAllAnswersAdminRequest) (*productpb.AnswersAdminResponse, error) {
+	if req.Status != "" && !isValidModerationStatus(req.Status) {
+		return nil, common.ErrInvalidModerationStatus
+	}
+
+	query := toPaginationQuery(req.Page, req.Limit, req.Sort, req.Order, req.Search)
+
+	answers, total, err := s.answerRepo.FindAllPaginated(ctx, req.QuestionId, req.Status, query)
+	if err != nil {
+		return nil, fmt.Errorf("lấy danh sách câu trả lời thất bại: %w", err)
+	}
+
+	userIDMap := map[string]struct{}{}
+	for _, answer := range answers {
+		userIDMap[answer.UserID] = struct{}{}
+		if answer.ModeratedByID != nil {
+			userIDMap[*answer.ModeratedByID] = struct{}{}
+		}
+	}
+
+	userMap, err := s.getUserMap(ctx, userIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	answerResponses := make([]*productpb.AnswerAdminResponse, 0, len(answers))
+	for _, answer := range answers {
+		moderatedBy, moderatedAt := toModerationResponse(userMap, answer.ModeratedByID, answer.ModeratedAt)
+		answerResponses = append(answerResponses, &productpb.AnswerAdminResponse{
+			Id:          answer.ID,
+			QuestionId:  answer.QuestionID,
+			Content:     answer.Content,
+			IsStaff:     &answer.IsStaff,
+			Status:      answer.Status,
+			UpvoteCount: uint32(answer.UpvoteCount),
+			CreatedAt:   answer.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:   answer.UpdatedAt.Format(time.RFC3339),
+			User:        toBaseUserResponse(userMap[answer.UserID]),
+			ModeratedBy: moderatedBy,
+			ModeratedAt: moderatedAt,
+		})
+	}
+
+	return &productpb.AnswersAdminResponse{
+		Answers: answerResponses,
+		Meta:    toPaginationMetaResponse(buildPaginationMeta(query, total)),
+	}, nil
+}
+
+func (s *productServiceImpl) ModerateQuestion(ctx context.Context, req *productpb.ModerateRequest) error {
+	if !isValidModerationStatus(req.Status) {
+		return common.ErrInvalidModerationStatus
+	}
+
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		question, err := s.questionRepo.FindByIDTx(ctx, tx, req.Id)
+		if err != nil {
+			return fmt.Errorf("tìm kiếm câu hỏi sản phẩm thất bại: %w", err)
+		}
+		if question == nil {
+			return common.ErrQuestionNotFound
+		}
+		if err = common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		if question.Status == req.Status {
+			return nil
+		}
+
+		if err = s.questionRepo.UpdateTx(ctx, tx, question.ID, map[string]any{
+			"status":          req.Status,
+			"moderated_by_id": req.UserId,
+			"moderated_at":    time.Now(),
+		}); err != nil {
+			if errors.Is(err, common.ErrQuestionNotFound) {
+				return err
+			}
+			return fmt.Errorf("cập nhật câu hỏi sản phẩm thất bại: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *productServiceImpl) ModerateAnswer(ctx context.Context, req *productpb.ModerateRequest) error {
+	if !isValidModerationStatus(req.Status) {
+		return common.ErrInvalidModerationStatus
+	}
+
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		answer, err := s.answerRepo.FindByIDTx(ctx, tx, req.Id)
+		if err != nil {
+			return fmt.Errorf("tìm kiếm câu trả lời thất bại: %w", err)
+		}
+		if answer == nil {
+			return common.ErrAnswerNotFound
+		}
+		if err = common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		if answer.Status == req.Status {
+			return nil
+		}
+
+		if err = s.answerRepo.UpdateTx(ctx, tx, answer.ID, map[string]any{
+			"status":          req.Status,
+			"moderated_by_id": req.UserId,
+			"moderated_at":    time.Now(),
+		}); err != nil {
+			if errors.Is(err, common.ErrAnswerNotFound) {
+				return err
+			}
+			return fmt.Errorf("cập nhật câu trả lời thất bại: %w", err)
+		}
+
+		return s.syncAnswerCountTx(ctx, tx, answer.QuestionID)
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *productServiceImpl) AddToWishlist(ctx context.Context, req *productpb.AddToWishlistRequest) (string, error) {
+	product, err := s.productRepo.FindByID(ctx, req.ProductId)
+	if err != nil {
+		return "", fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
+	}
+	if product == nil || !product.IsPublished() {
+		return "", common.ErrProductNotFound
+	}
+
+	if req.VariantId != nil {
+		variant, err := s.variantRepo.FindByID(ctx, *req.VariantId)
+		if err != nil {
+			return "", fmt.Errorf("tìm kiếm biến thể sản phẩm thất bại: %w", err)
+		}
+		if variant == nil || variant.ProductID != product.ID {
+			return "", common.ErrVariantNotFound
+		}
+	}
+
+	wishlist := &model.Wishlist{
+		ID:        uuid.NewString(),
+		UserID:    req.UserId,
+		ProductID: product.ID,
+		VariantID: req.VariantId,
+	}
+
+	if err = s.wishlistRepo.Create(ctx, wishlist); err != nil {
+		if isUniqueViolation(err) {
+			return "", uniqueViolationError(err, common.ErrWishlistItemAlreadyExists)
+		}
+		return "", fmt.Errorf("thêm sản phẩm vào danh sách yêu thích thất bại: %w", err)
+	}
+
+	return wishlist.ID, nil
+}
+
+func (s *productServiceImpl) RemoveFromWishlist(ctx context.Context, req *productpb.RemoveFromWishlistRequest) error {
+	if err := s.wishlistRepo.DeleteByIDAndUserID(ctx, req.Id, req.UserId); err != nil {
+		if errors.Is(err, common.ErrWishlistItemNotFound) {
+			return err
+		}
+		return fmt.Errorf("xóa sản phẩm khỏi danh sách yêu thích thất bại: %w", err)
+	}
+
+	return nil
+}
+
+func (s *productServiceImpl) GetWishlist(ctx context.Context, userID string) ([]*model.Wishlist, error) {
+	wishlists, err := s.wishlistRepo.FindAllByUserIDWithDetails(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("lấy danh sách yêu thích thất bại: %w", err)
+	}
+
+	locales := common.LocalesFromContext(ctx)
+	for _, item := range wishlists {
+		if item.Product != nil {
+			item.Product.Localize(locales)
+		}
+		if item.Variant != nil {
+			item.Variant.Localize(locales)
+		}
+	}
+
+	return wishlists, nil
+}
+
+func (s *productServiceImpl) publishProductStatusChangedEvent(ctx context.Context, product *model.Product) {
+	if err := mq.PublishProductStatusChanged(ctx, s.publisher, &common.ProductStatusChangedEvent{
+		ProductID: product.ID,
+		Title:     product.Title,
+		Slug:      product.Slug,
+		Status:    product.Status,
+		ChangedAt: product.UpdatedAt,
+	}); err != nil {
+		log.Printf("publish sự kiện thay đổi trạng thái sản phẩm thất bại: %v", err)
+	}
+}
+
+func (s *productServiceImpl) publishPriceDropEvent(ctx context.Context, product *model.Product, oldPrice, newPrice float32) {
+	userIDs, err := s.wishlistRepo.FindAllUserIDsByProductID(ctx, product.ID)
+	if err != nil {
+		log.Printf("lấy danh sách người dùng yêu thích sản phẩm thất bại: %v", err)
+		return
+	}
+	if len(userIDs) == 0 {
+		return
+	}
+
+	if err = mq.PublishPriceDrop(ctx, s.publisher, &common.PriceDropEvent{
+		ProductID: product.ID,
+		Title:     product.Title,
+		Slug:      product.Slug,
+		OldPrice:  oldPrice,
+		NewPrice:  newPrice,
+		UserIDs:   userIDs,
+	}); err != nil {
+		log.Printf("publish price drop msg thất bại: %v", err)
+	}
+}
+
+func (s *productServiceImpl) DeleteCategory(ctx context.Context, req *productpb.DeleteOneRequest) error {
+	updateData := map[string]any{
+		"is_deleted":    true,
+		"version":       gorm.Expr("version + 1"),
+		"updated_by_id": req.UserId,
+	}
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.categoryRepo.UpdateByIDAndIsDeletedTx(ctx, tx, req.Id, false, updateData)
+	}); err != nil {
+		if errors.Is(err, common.ErrCategoryNotFound) {
+			return err
+		}
+		return fmt.Errorf("chuyển danh mục sản phẩm vào thùng rác thất bại: %w", err)
+	}
+
+	s.invalidateCategoryCache(ctx)
+
+	return nil
+}
+
+func (s *productServiceImpl) DeleteCategories(ctx context.Context, req *productpb.DeleteManyRequest) error {
+	categories, err := s.categoryRepo.FindAllByID(ctx, req.Ids)
+	if err != nil {
+		return fmt.Errorf("tìm kiếm danh mục sản phẩm thất bại: %w", err)
+	}
+	if len(categories) != len(req.Ids) {
+		return missingIDsError(common.ErrHasCategoryNotFound, req.Ids, getIDsFromCategories(categories))
+	}
+
+	updateData := map[string]any{
+		"is_deleted":    true,
+		"version":       gorm.Expr("version + 1"),
+		"updated_by_id": req.UserId,
+	}
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.categoryRepo.UpdateAllByIDTx(ctx, tx, req.Ids, updateData)
+	}); err != nil {
+		return fmt.Errorf("chuyển danh sách danh mục sản phẩm vào thùng rác thất bại: %w", err)
+	}
+
+	s.invalidateCategoryCache(ctx)
+
+	return nil
+}
+
+func (s *productServiceImpl) GetDeletedCategories(ctx context.Context) (*productpb.CategoriesAdminResponse, error) {
+	categories, err := s.categoryRepo.FindAllDeletedWithParents(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("lấy danh sách danh mục sản phẩm thất bại: %w", err)
+	}
+
+	userIDMap := map[string]struct{}{}
+	for _, category := range categories {
+		userIDMap[category.CreatedByID] = struct{}{}
+		userIDMap[category.UpdatedByID] = struct{}{}
+	}
+
+	userMap, err := s.getUserMap(ctx, userIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryResponses := make([]*productpb.CategoryAdminResponse, 0, len(categories))
+	for _, category := range categories {
+		categoryResponses = append(categoryResponses, &productpb.CategoryAdminResponse{
+			Id:        category.ID,
+			Name:      category.Name,
+			Slug:      category.Slug,
+			Parents:   toBaseCategoriesResponse(category.Parents),
+			CreatedAt: category.CreatedAt.Format(time.RFC3339),
+			UpdatedAt: category.UpdatedAt.Format(time.RFC3339),
+			CreatedBy: toBaseUserResponse(userMap[category.CreatedByID]),
+			UpdatedBy: toBaseUserResponse(userMap[category.UpdatedByID]),
+		})
+	}
+
+	return &productpb.CategoriesAdminResponse{
+		Categories: categoryResponses,
+	}, nil
+}
+
+func (s *productServiceImpl) RestoreCategory(ctx context.Context, req *productpb.RestoreOneRequest) error {
+	updateData := map[string]any{
+		"is_deleted":    false,
+		"version":       gorm.Expr("version + 1"),
+		"updated_by_id": req.UserId,
+	}
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.categoryRepo.UpdateByIDAndIsDeletedTx(ctx, tx, req.Id, true, updateData)
+	}); err != nil {
+		if errors.Is(err, common.ErrCategoryNotFound) {
+			return err
+		}
+		return fmt.Errorf("khôi phục danh mục sản phẩm thất bại: %w", err)
+	}
+
+	s.invalidateCategoryCache(ctx)
+
+	return nil
+}
+
+func (s *productServiceImpl) RestoreCategories(ctx context.Context, req *productpb.RestoreManyRequest) error {
+	categories, err := s.categoryRepo.FindAllDeletedByID(ctx, req.Ids)
+	if err != nil {
+		return fmt.Errorf("tìm kiếm danh mục sản phẩm thất bại: %w", err)
+	}
+	if len(categories) != len(req.Ids) {
+		return missingIDsError(common.ErrHasCategoryNotFound, req.Ids, getIDsFromCategories(categories))
+	}
+
+	updateData := map[string]any{
+		"is_deleted":    false,
+		"version":       gorm.Expr("version + 1"),
+		"updated_by_id": req.UserId,
+	}
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		return s.categoryRepo.UpdateAllByIDTx(ctx, tx, req.Ids, updateData)
+	}); err != nil {
+		return fmt.Errorf("khôi phục danh sách danh mục sản phẩm thất bại: %w", err)
+	}
+
+	s.invalidateCategoryCache(ctx)
+
+	return nil
+}
+
+func (s *productServiceImpl) MoveCategory(ctx context.Context, req *productpb.MoveCategoryRequest) error {
+	if req.FromParentId == nil && req.ToParentId == nil {
+		return common.ErrInvalidCategoryMove
+	}
+	if req.ToParentId != nil && *req.ToParentId == req.Id {
+		return common.ErrInvalidCategoryMove
+	}
+
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		category, err := s.categoryRepo.FindByIDWithParentsTx(ctx, tx, req.Id)
+		if err != nil {
+			if strings.Contains(err.Error(), "lock") {
+				return fmt.Errorf("danh mục đang được cập nhật bởi người dùng khác, vui lòng thử lại: %w", err)
+			}
+			return fmt.Errorf("tìm kiếm danh mục sản phẩm thất bại: %w", err)
+		}
+		if category == nil {
+			return common.ErrCategoryNotFound
+		}
+		if err = common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		parentIDs := getIDsFromCategories(category.Parents)
+		newParentIDs := make([]string, 0, len(parentIDs)+1)
+		for _, id := range parentIDs {
+			if req.FromParentId == nil || id != *req.FromParentId {
+				newParentIDs = append(newParentIDs, id)
+			}
+		}
+		if req.FromParentId != nil && len(newParentIDs) == len(parentIDs) {
+			return common.ErrCategoryNotChildOfParent
+		}
+
+		if req.ToParentId != nil && !slices.Contains(newParentIDs, *req.ToParentId) {
+			toParents, err := s.categoryRepo.FindAllByIDTx(ctx, tx, []string{*req.ToParentId})
+			if err != nil {
+				return fmt.Errorf("tìm kiếm danh mục sản phẩm cha thất bại: %w", err)
+			}
+			if len(toParents) == 0 {
+				return common.ErrCategoryNotFound
+			}
+
+			newParentIDs = append(newParentIDs, *req.ToParentId)
+			if err = s.validateParentRelations(ctx, newParentIDs); err != nil {
+				return err
+			}
+			if err = s.validateNoCycleWithCategory(ctx, req.Id, newParentIDs); err != nil {
+				return err
+			}
+		}
+
+		if req.FromParentId != nil && (req.ToParentId == nil || *req.FromParentId != *req.ToParentId) {
+			if err = s.categoryRepo.DeleteParentTx(ctx, tx, req.Id, *req.FromParentId); err != nil {
+				return fmt.Errorf("gỡ danh mục cha thất bại: %w", err)
+			}
+		}
+
+		if req.ToParentId != nil {
+			if err = s.categoryRepo.AddParentsTx(ctx, tx, req.Id, []string{*req.ToParentId}); err != nil {
+				return fmt.Errorf("gán danh mục cha thất bại: %w", err)
+			}
+
+			edges, err := s.categoryRepo.FindAllChildEdgesByParentIDTx(ctx, tx, *req.ToParentId)
+			if err != nil {
+				return fmt.Errorf("lấy danh sách danh mục con thất bại: %w", err)
+			}
+
+			childIDs := make([]string, 0, len(edges))
+			for _, e := range edges {
+				if e.ChildID != req.Id {
+					childIDs = append(childIDs, e.ChildID)
+				}
+			}
+			position := min(int(req.Position), len(childIDs))
+			childIDs = slices.Insert(childIDs, position, req.Id)
+
+			if err = s.categoryRepo.UpdateChildrenSortOrderTx(ctx, tx, *req.ToParentId, childIDs); err != nil {
+				return fmt.Errorf("cập nhật thứ tự danh mục con thất bại: %w", err)
+			}
+		}
+
+		updateData := map[string]any{
+			"version":       category.Version + 1,
+			"updated_by_id": req.UserId,
+		}
+		if err = s.categoryRepo.UpdateTx(ctx, tx, req.Id, updateData); err != nil {
+			return fmt.Errorf("cập nhật danh mục sản phẩm thất bại: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	s.invalidateCategoryCache(ctx)
+
+	return nil
+}
+
+func (s *productServiceImpl) ReorderCategoryChildren(ctx context.Context, req *productpb.ReorderCategoryChildrenRequest) error {
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		parent, err := s.categoryRepo.FindByIDTx(ctx, tx, req.ParentId)
+		if err != nil {
+			if strings.Contains(err.Error(), "lock") {
+				return fmt.Errorf("danh mục đang được cập nhật bởi người dùng khác, vui lòng thử lại: %w", err)
+			}
+			return fmt.Errorf("tìm kiếm danh mục sản phẩm thất bại: %w", err)
+		}
+		if parent == nil {
+			return common.ErrCategoryNotFound
+		}
+		if err = common.CaptureAuditBeforeTx(ctx, tx); err != nil {
+			return err
+		}
+
+		edges, err := s.categoryRepo.FindAllChildEdgesByParentIDTx(ctx, tx, req.ParentId)
+		if err != nil {
+			return fmt.Errorf("lấy danh sách danh mục con thất bại: %w", err)
+		}
+		if len(edges) != len(req.ChildIds) {
+			return common.ErrInvalidChildrenOrder
+		}
+
+		childSet := make(map[string]struct{}, len(edges))
+		for _, e := range edges {
+			childSet[e.ChildID] = struct{}{}
+		}
+		for _, id := range req.ChildIds {
+			if _, ok := childSet[id]; !ok {
+				return common.ErrInvalidChildrenOrder
+			}
+			delete(childSet, id)
+		}
+
+		if err = s.categoryRepo.UpdateChildrenSortOrderTx(ctx, tx, req.ParentId, req.ChildIds); err != nil {
+			return fmt.Errorf("cập nhật thứ tự danh mục con thất bại: %w", err)
+		}
+
+		updateData := map[string]any{
+			"version":       parent.Version + 1,
+			"updated_by_id": req.UserId,
+		}
+		if err = s.categoryRepo.UpdateTx(ctx, tx, req.ParentId, updateData); err != nil {
+			return fmt.Errorf("cập nhật danh mục sản phẩm thất bại: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	s.invalidateCategoryCache(ctx)
+
+	return nil
+}
+
+func (s *productServiceImpl) GetCategoryBySlug(ctx context.Context, slug string) (*productpb.CategoryPublicDetailsResponse, error) {
+	category, err := s.categoryRepo.FindBySlugWithFeaturedProducts(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("tìm kiếm danh mục sản phẩm thất bại: %w", err)
+	}
+
+	var redirectTo *string
+	if category == nil {
+		history, err := s.slugHistoryRepo.FindCategorySlugWithCategory(ctx, slug)
+		if err != nil {
+			return nil, fmt.Errorf("tìm kiếm lịch sử slug danh mục sản phẩm thất bại: %w", err)
+		}
+		if history == nil {
+			return nil, common.ErrCategoryNotFound
+		}
+
+		category, err = s.categoryRepo.FindBySlugWithFeaturedProducts(ctx, history.Category.Slug)
+		if err != nil {
+			return nil, fmt.Errorf("tìm kiếm danh mục sản phẩm thất bại: %w", err)
+		}
+		if category == nil {
+			return nil, common.ErrCategoryNotFound
+		}
+		redirectTo = &category.Slug
+	}
+
+	paths, err := s.buildCategoryBreadcrumbs(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+	breadcrumbs := paths[category.ID]
+
+	children, err := s.categoryRepo.FindAllVisibleChildrenByParentID(ctx, category.ID)
+	if err != nil {
+		return nil, fmt.Errorf("lấy danh sách danh mục con thất bại: %w", err)
+	}
+
+	locales := common.LocalesFromContext(ctx)
+	category.Localize(locales)
+	localizeCategories(ctx, breadcrumbs)
+	localizeCategories(ctx, children)
+	for _, featured := range category.FeaturedProducts {
+		if featured.Product != nil {
+			featured.Product.Localize(locales)
+		}
+	}
+
+	return &productpb.CategoryPublicDetailsResponse{
+		Id:               category.ID,
+		Name:             category.Name,
+		Slug:             category.Slug,
+		Description:      category.Description,
+		BannerUrl:        category.BannerUrl,
+		MetaTitle:        category.MetaTitle,
+		MetaDescription:  category.MetaDescription,
+		Breadcrumbs:      toBaseCategoriesResponse(breadcrumbs),
+		Children:         toBaseCategoriesResponse(children),
+		FeaturedProducts: toFeaturedProductsResponse(category.FeaturedProducts),
+		RedirectTo:       redirectTo,
+	}, nil
+}
+
+func (s *productServiceImpl) DuplicateProduct(ctx context.Context, req *productpb.DuplicateProductRequest) (string, error) {
+	slugMode, err := resolveSlugMode(req.SlugMode, req.Slug != nil)
+	if err != nil {
+		return "", err
+	}
+
+	source, err := s.productRepo.FindByIDWithDetails(ctx, req.Id)
+	if err != nil {
+		return "", fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
+	}
+	if source == nil {
+		return "", common.ErrProductNotFound
+	}
+
+	title := source.Title
+	if req.Title != nil {
+		title = *req.Title
+	}
+	slugSource := title
+	if req.Slug != nil {
+		slugSource = *req.Slug
+	}
+
+	product := &model.Product{
+		ID:                uuid.NewString(),
+		Title:             title,
+		Type:              source.Type,
+		Description:       source.Description,
+		Translations:      source.Translations,
+		Price:             source.Price,
+		IsActive:          false,
+		Status:            common.ProductStatusDraft,
+		IsSale:            source.IsSale,
+		SalePrice:         source.SalePrice,
+		StartSale:         source.StartSale,
+		EndSale:           source.EndSale,
+		PrimaryCategoryID: source.PrimaryCategoryID,
+		Categories:        source.Categories,
+		Tags:              source.Tags,
+		CreatedByID:       req.UserId,
+		UpdatedByID:       req.UserId,
+	}
+
+	skus := make(map[string]struct{}, len(source.Variants))
+	variants := make([]*model.Variant, 0, len(source.Variants))
+	for i, v := range source.Variants {
+		sku, err := buildDuplicateSKU(v, i, req.SkuSuffix, req.SkuTemplate)
+		if err != nil {
+			return "", err
+		}
+		if _, ok := skus[sku]; ok {
+			return "", common.NewDetailedError(common.ErrSKUAlreadyExists, map[string]string{"sku": sku})
+		}
+		skus[sku] = struct{}{}
+
+		exists, err := s.variantRepo.ExistsBySKU(ctx, sku)
+		if err != nil {
+			return "", fmt.Errorf("kiểm tra mã SKU biến thể thất bại: %w", err)
+		}
+		if exists {
+			return "", common.NewDetailedError(common.ErrSKUAlreadyExists, map[string]string{"sku": sku})
+		}
+
+		variant := &model.Variant{
+			ID:        uuid.NewString(),
+			ProductID: product.ID,
+			SKU:       sku,
+			ColorID:   v.ColorID,
+			SizeID:    v.SizeID,
+			Inventory: &model.Inventory{
+				ID: uuid.NewString(),
+			},
+		}
+		variant.Inventory.SetStock()
+
+		variants = append(variants, variant)
+	}
+	product.Variants = variants
+
+	bundleItems := make([]*model.BundleItem, 0, len(source.BundleItems))
+	for _, item := range source.BundleItems {
+		bundleItems = append(bundleItems, &model.BundleItem{
+			ID:        uuid.NewString(),
+			BundleID:  product.ID,
+			VariantID: item.VariantID,
+			Quantity:  item.Quantity,
+		})
+	}
+	product.BundleItems = bundleItems
+
+	sourceImages := make([]*model.Image, 0, len(source.Images))
+	images := make([]*model.Image, 0, len(source.Images))
+	for _, img := range source.Images {
+		if img.Url == "" {
+			continue
+		}
+
+		sourceImages = append(sourceImages, img)
+		images = append(images, &model.Image{
+			ID:          uuid.NewString(),
+			ProductID:   product.ID,
+			ColorID:     img.ColorID,
+			IsThumbnail: img.IsThumbnail,
+			SortOrder:   img.SortOrder,
+		})
+	}
+	product.Images = images
+
+	if err = s.db.Transaction(func(tx *gorm.DB) error {
+		slug, err := s.allocateSlugTx(ctx, tx, common.ProductSlugTarget, slugSource, "", slugMode)
+		if err != nil {
+			return err
+		}
+		product.Slug = slug
+
+		if err = s.productRepo.CreateTx(ctx, tx, product); err != nil {
+			if isUniqueViolation(err) {
+				return uniqueViolationError(err, common.ErrSKUAlreadyExists)
+			}
+			return fmt.Errorf("sao chép sản phẩm thất bại: %w", err)
+		}
+
+		_, err = s.createProductRevisionTx(ctx, tx, product.ID, req.UserId)
+		return err
+	}); err != nil {
+		return "", err
+	}
+
+	imgQuan := len(sourceImages)
+	publishChan := make(chan *common.Base64UploadRequest, imgQuan)
+	for i, img := range sourceImages {
+		ext := strings.ToLower(filepath.Ext(img.Url))
+		if ext == "" {
+			ext = ".jpg"
+		}
+
+		fileName := fmt.Sprintf("%s-%s_%d_%s%s", product.Slug, img.ColorID, img.SortOrder, images[i].ID, ext)
+
+		publishChan <- &common.Base64UploadRequest{
+			ProductID:   product.ID,
+			ImageID:     images[i].ID,
+			SourceURL:   img.Url,
+			FileName:    fileName,
+			Folder:      s.cfg.ImageKit.Folder,
+			UserID:      req.UserId,
+			TotalImages: uint16(imgQuan),
+		}
+	}
+	close(publishChan)
+
+	publishCtx := context.WithoutCancel(ctx)
+	go func() {
+		for uploadReq := range publishChan {
+			body, _ := sonic.Marshal(uploadReq)
+			if err := mq.PublishMessage(publishCtx, s.publisher, common.UploadTopic, body); err != nil {
+				log.Printf("publish upload image msg thất bại: %v", err)
+			}
+		}
+	}()
+
+	return product.ID, nil
+}
+
+func (s *productServiceImpl) GetProductRevisions(ctx context.Context, req *productpb.GetProductRevisionsRequest) (*productpb.ProductRevisionsResponse, error) {
+	query := toPaginationQuery(req.Page, req.Limit, "", "", "")
+
+	product, err := s.productRepo.FindByID(ctx, req.ProductId)
+	if err != nil {
+		return nil, fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
+	}
+	if product == nil {
+		return nil, common.ErrProductNotFound
+	}
+
+	revisions, total, err := s.revisionRepo.FindAllPaginatedByProductID(ctx, product.ID, query)
+	if err != nil {
+		return nil, fmt.Errorf("lấy danh sách phiên bản sản phẩm thất bại: %w", err)
+	}
+
+	userIDMap := map[string]struct{}{}
+	for _, revision := range revisions {
+		userIDMap[revision.CreatedByID] = struct{}{}
+	}
+
+	userMap, err := s.getUserMap(ctx, userIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	revisionResponses := make([]*productpb.ProductRevisionResponse, 0, len(revisions))
+	for _, revision := range revisions {
+		revisionResponses = append(revisionResponses, &productpb.ProductRevisionResponse{
+			Id:        revision.ID,
+			Revision:  uint32(revision.Revision),
+			Title:     revision.Snapshot.Title,
+			Slug:      revision.Snapshot.Slug,
+			Status:    revision.Snapshot.Status,
+			CreatedAt: revision.CreatedAt.Format(time.RFC3339),
+			CreatedBy: toBaseUserResponse(userMap[revision.CreatedByID]),
+		})
+	}
+
+	return &productpb.ProductRevisionsResponse{
+		Revisions: revisionResponses,
+		Meta:      toPaginationMetaResponse(buildPaginationMeta(query, total)),
+	}, nil
+}
+
+func (s *productServiceImpl) DiffProductRevisions(ctx context.Context, req *productpb.DiffProductRevisionsRequest) (*productpb.ProductRevisionDiffResponse, error) {
+	from, err := s.revisionRepo.FindByIDAndProductID(ctx, req.FromRevisionId, req.ProductId)
+	if err != nil {
+		return nil, fmt.Errorf("tìm kiếm phiên bản sản phẩm thất bại: %w", err)
+	}
+	if from == nil {
+		return nil, common.ErrProductRevisionNotFound
+	}
+
+	to, err := s.revisionRepo.FindByIDAndProductID(ctx, req.ToRevisionId, req.ProductId)
+	if err != nil {
+		return nil, fmt.Errorf("tìm kiếm phiên bản sản phẩm thất bại: %w", err)
+	}
+	if to == nil {
+		return nil, common.ErrProductRevisionNotFound
+	}
+
+	fromFields := from.Snapshot.Flatten()
+	toFields := to.Snapshot.Flatten()
+
+	fields := make([]string, 0, len(fromFields)+len(toFields))
+	for field := range fromFields {
+		fields = append(fields, field)
+	}
+	for field := range toFields {
+		if _, ok := fromFields[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	slices.Sort(fields)
+
+	changes := make([]*productpb.ProductRevisionFieldDiffResponse, 0)
+	for _, field := range fields {
+		fromValue, inFrom := fromFields[field]
+		toValue, inTo := toFields[field]
+		if inFrom && inTo && fromValue == toValue {
+			continue
+		}
+
+		change := &productpb.ProductRevisionFieldDiffResponse{Field: field}
+		if inFrom {
+			change.FromValue = &fromValue
+		}
+		if inTo {
+			change.ToValue = &toValue
+		}
+		changes = append(changes, change)
+	}
+
+	return &productpb.ProductRevisionDiffResponse{
+		ProductId:    req.ProductId,
+		FromRevision: uint32(from.Revision),
+		ToRevision:   uint32(to.Revision),
+		Changes:      changes,
+	}, nil
+}
+
+func (s *productServiceImpl) RollbackProductRevision(ctx context.Context, req *productpb.RollbackProductRevisionRequest) (*productpb.ProductAdminDetailsResponse, error) {
+	revision, err := s.revisionRepo.FindByIDAndProductID(ctx, req.RevisionId, req.ProductId)
+	if err != nil {
+		return nil, fmt.Errorf("tìm kiếm phiên bản sản phẩm thất bại: %w", err)
+	}
+	if revision == nil {
+		return nil, common.ErrProductRevisionNotFound
+	}
+
+	product, err := s.productRepo.FindByIDWithDetails(ctx, req.ProductId)
+	if err != nil {
+		return nil, fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
+	}
+	if product == nil {
+		return nil, common.ErrProductNotFound
+	}
+
+	currentImageIDs := make([]string, 0, len(product.Images))
+	for _, image := range product.Images {
+		currentImageIDs = append(currentImageIDs, image.ID)
+	}
+	snapshotImageIDs := make([]string, 0, len(revision.Snapshot.Images))
+	for _, image := range revision.Snapshot.Images {
+		snapshotImageIDs = append(snapshotImageIDs, image.ID)
+	}
+	for _, id := range snapshotImageIDs {
+		if !slices.Contains(currentImageIDs, id) {
+			return nil, missingIDsError(common.ErrRevisionImagesMissing, snapshotImageIDs, currentImageIDs)
+		}
+	}
+
+	currentVariantIDs := getIDsFromVariants(product.Variants)
+	snapshotVariantIDs := make([]string, 0, len(revision.Snapshot.Variants))
+	for _, variant := range revision.Snapshot.Variants {
+		snapshotVariantIDs = append(snapshotVariantIDs, variant.ID)
+	}
+	for _, id := range snapshotVariantIDs {
+		if !slices.Contains(currentVariantIDs, id) {
+			return nil, missingIDsError(common.ErrRevisionVariantsMissing, snapshotVariantIDs, currentVariantIDs)
+		}
+	}
+
+	return s.updateProduct(ctx, buildRollbackUpdateRequest(product, revision.Snapshot, req.UserId, req.RestoreLifecycle), func(tx *gorm.DB, product *model.Product) error {
+		if !product.IsBundle() {
+			return nil
+		}
+
+		if err := s.bundleRepo.DeleteAllByBundleIDTx(ctx, tx, product.ID); err != nil {
+			return fmt.Errorf("xóa thành phần combo thất bại: %w", err)
+		}
+		if len(revision.Snapshot.BundleItems) == 0 {
+			return nil
+		}
+
+		items := make([]*productpb.BundleItemRequest, 0, len(revision.Snapshot.BundleItems))
+		for _, item := range revision.Snapshot.BundleItems {
+			items = append(items, &productpb.BundleItemRequest{VariantId: item.VariantID, Quantity: int32(item.Quantity)})
+		}
+		bundleItems, err := s.buildBundleItemsTx(ctx, tx, product.ID, items)
+		if err != nil {
+			return err
+		}
+
+		if err = s.bundleRepo.CreateAllTx(ctx, tx, bundleItems); err != nil {
+			return fmt.Errorf("tạo thành phần combo thất bại: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (s *productServiceImpl) GetAuditLogs(ctx context.Context, req *productpb.GetAuditLogsRequest) (*productpb.AuditLogsResponse, error) {
+	query := toPaginationQuery(req.Page, req.Limit, "", req.Order, "")
+
+	from, err := parseOptionalDateTime(req.From)
+	if err != nil {
+		return nil, fmt.Errorf("chuyển đổi kiểu dữ liệu thời gian bắt đầu thất bại: %w", err)
+	}
+	to, err := parseOptionalDateTime(req.To)
+	if err != nil {
+		return nil, fmt.Errorf("chuyển đổi kiểu dữ liệu thời gian kết thúc thất bại: %w", err)
+	}
+
+	filter := common.AuditLogFilter{
+		ActorID:    req.ActorId,
+		Action:     req.Action,
+		EntityType: req.EntityType,
+		EntityID:   req.EntityId,
+		RequestID:  req.RequestId,
+		From:       from,
+		To:         to,
+	}
+
+	logs, total, err := s.auditRepo.FindAllPaginated(ctx, filter, query)
+	if err != nil {
+		return nil, fmt.Errorf("lấy danh sách nhật ký thao tác thất bại: %w", err)
+	}
+
+	userIDMap := map[string]struct{}{}
+	for _, auditLog := range logs {
+		if auditLog.ActorID != "" {
+			userIDMap[auditLog.ActorID] = struct{}{}
+		}
+	}
+
+	userMap, err := s.getUserMap(ctx, userIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	logResponses := make([]*productpb.AuditLogResponse, 0, len(logs))
+	for _, auditLog := range logs {
+		logResponses = append(logResponses, &productpb.AuditLogResponse{
+			Id:         auditLog.ID,
+			RequestId:  auditLog.RequestID,
+			Action:     auditLog.Action,
+			EntityType: auditLog.EntityType,
+			EntityId:   auditLog.EntityID,
+			Method:     auditLog.Method,
+			Changes:    toAuditLogChangesResponse(auditLog.Changes),
+			CreatedAt:  auditLog.CreatedAt.Format(time.RFC3339),
+			Actor:      toBaseUserResponse(userMap[auditLog.ActorID]),
+		})
+	}
+
+	return &productpb.AuditLogsResponse{
+		Logs: logResponses,
+		Meta: toPaginationMetaResponse(buildPaginationMeta(query, total)),
+	}, nil
+}
+
+func (s *productServiceImpl) syncAnswerCountTx(ctx context.Context, tx *gorm.DB, questionID string) error {
+	answerCount, err := s.answerRepo.CountApprovedByQuestionIDTx(ctx, tx, questionID)
+	if err != nil {
+		return fmt.Errorf("đếm số câu trả lời thất bại: %w", err)
+	}
+
+	if err = s.questionRepo.UpdateTx(ctx, tx, questionID, map[string]any{
+		"answer_count": answerCount,
+	}); err != nil {
+		if errors.Is(err, common.ErrQuestionNotFound) {
+			return err
+		}
+		return fmt.Errorf("cập nhật số câu trả lời thất bại: %w", err)
+	}
+
+	return nil
+}
+
+func (s *productServiceImpl) getUser(ctx context.Context, userID string) (*userpb.UserPublicResponse, error) {
+	user, err := s.userClient.GetUserPublicById(ctx, &userpb.GetOneRequest{
+		Id: userID,
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return nil, common.ErrUserNotFound
+			default:
+				return nil, fmt.Errorf("lỗi từ user service: %s", st.Message())
+			}
+		}
+		return nil, fmt.Errorf("lỗi không xác định: %w", err)
+	}
+
+	return user, nil
+}
+
+func (s *productServiceImpl) getUserMap(ctx context.Context, userIDMap map[string]struct{}) (map[string]*userpb.UserPublicResponse, error) {
+	userMap := make(map[string]*userpb.UserPublicResponse)
+	if len(userIDMap) == 0 {
+		return userMap, nil
+	}
+
+	var userIDs []string
+	for id := range userIDMap {
+		userIDs = append(userIDs, id)
+	}
+
+	userRes, err := s.userClient.GetUsersPublicById(ctx, &userpb.GetManyRequest{
+		Ids: userIDs,
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return nil, common.ErrHasUserNotFound
+			default:
+				return nil, fmt.Errorf("lỗi từ user service: %s", st.Message())
+			}
+		}
+		return nil, fmt.Errorf("lỗi không xác định: %w", err)
+	}
+
+	for _, user := range userRes.Users {
+		userMap[user.Id] = user
+	}
+
+	return userMap, nil
+}
+
+func (s *productServiceImpl) buildBundleItemsTx(ctx context.Context, tx *gorm.DB, bundleID string, items []*productpb.BundleItemRequest) ([]*model.BundleItem, error) {
+	if len(items) == 0 {
+		return nil, common.ErrInvalidBundleItem
+	}
+
+	variantIDs := make([]string, 0, len(items))
+	seen := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, common.ErrInvalidBundleItem
+		}
+		if _, ok := seen[item.VariantId]; ok {
+			return nil, common.ErrInvalidBundleItem
+		}
+		seen[item.VariantId] = struct{}{}
+		variantIDs = append(variantIDs, item.VariantId)
+	}
+
+	variants, err := s.variantRepo.FindAllByIDWithProductTx(ctx, tx, variantIDs)
+	if err != nil {
+		return nil, fmt.Errorf("tìm kiếm biến thể sản phẩm thất bại: %w", err)
+	}
+	if len(variants) != len(variantIDs) {
+		return nil, missingIDsError(common.ErrHasVariantNotFound, variantIDs, getIDsFromVariants(variants))
+	}
+
+	for _, v := range variants {
+		if v.ProductID == bundleID || (v.Product != nil && v.Product.IsBundle()) {
+			return nil, common.ErrInvalidBundleItem
+		}
+	}
+
+	bundleItems := make([]*model.BundleItem, 0, len(items))
+	for _, item := range items {
+		bundleItems = append(bundleItems, &model.BundleItem{
+			ID:        uuid.NewString(),
+			BundleID:  bundleID,
+			VariantID: item.VariantId,
+			Quantity:  int(item.Quantity),
+		})
+	}
+
+	return bundleItems, nil
+}
+
+func isPreferredBreadcrumbParent(parent, next *model.Category, parentSortOrder, nextSortOrder int) bool {
+	if parentSortOrder != nextSortOrder {
+		return parentSortOrder < nextSortOrder
+	}
+	if !parent.CreatedAt.Equal(next.CreatedAt) {
+		return parent.CreatedAt.Before(next.CreatedAt)
+	}
+	return parent.ID < next.ID
+}
+
+func (s *productServiceImpl) allocateSlugTx(ctx context.Context, tx *gorm.DB, target common.SlugTarget, source, excludeID, mode string) (string, error) {
+	base := truncateSlug(common.GenerateSlug(source), target.MaxLength)
+	if base == "" {
+		return "", common.ErrInvalidSlug
+	}
+
+	stem := truncateSlug(base, target.MaxLength-common.SlugHashLength-1)
+	if err := s.slugRepo.LockTx(ctx, tx, target, stem); err != nil {
+		return "", fmt.Errorf("khóa slug thất bại: %w", err)
+	}
+
+	slugs, err := s.slugRepo.FindAllByPrefixTx(ctx, tx, target, stem, excludeID)
+	if err != nil {
+		return "", fmt.Errorf("kiểm tra slug thất bại: %w", err)
+	}
+
+	taken := make(map[string]struct{}, len(slugs))
+	for _, slug := range slugs {
+		taken[slug] = struct{}{}
+	}
+
+	if _, ok := taken[base]; !ok {
+		return base, nil
+	}
+	if mode == common.SlugModeStrict {
+		return "", common.NewDetailedError(common.ErrSlugAlreadyExists, map[string]string{"slug": base})
+	}
+
+	for i := 2; i <= common.MaxSlugNumericSuffix; i++ {
+		suffix := "-" + strconv.Itoa(i)
+		candidate := truncateSlug(base, target.MaxLength-len(suffix)) + suffix
+		if _, ok := taken[candidate]; !ok {
+			return candidate, nil
+		}
+	}
+
+	for range common.MaxSlugHashAttempts {
+		sum := sha1.Sum([]byte(uuid.NewString()))
+		candidate := stem + "-" + hex.EncodeToString(sum[:])[:common.SlugHashLength]
+		if _, ok := taken[candidate]; !ok {
+			return candidate, nil
+		}
+	}
+
+	return "", common.NewDetailedError(common.ErrSlugAlreadyExists, map[string]string{"slug": base})
+}
+
+func (s *productServiceImpl) changeProductSlugTx(ctx context.Context, tx *gorm.DB, productID, oldSlug, newSlug string) error {
+	if err := s.slugHistoryRepo.DeleteProductSlugTx(ctx, tx, productID, newSlug); err != nil {
+		return fmt.Errorf("xóa lịch sử slug sản phẩm thất bại: %w", err)
+	}
+
+	if err := s.slugHistoryRepo.CreateProductSlugTx(ctx, tx, &model.ProductSlugHistory{
+		ID:        uuid.NewString(),
+		ProductID: productID,
+		Slug:      oldSlug,
+	}); err != nil {
+		return fmt.Errorf("lưu lịch sử slug sản phẩm thất bại: %w", err)
+	}
+
+	return nil
+}
+
+func (s *productServiceImpl) changeCategorySlugTx(ctx context.Context, tx *gorm.DB, categoryID, oldSlug, newSlug string) error {
+	if err := s.slugHistoryRepo.DeleteCategorySlugTx(ctx, tx, categoryID, newSlug); err != nil {
+		return fmt.Errorf("xóa lịch sử slug danh mục sản phẩm thất bại: %w", err)
+	}
+
+	if err := s.slugHistoryRepo.CreateCategorySlugTx(ctx, tx, &model.CategorySlugHistory{
+		ID:         uuid.NewString(),
+		CategoryID: categoryID,
+		Slug:       oldSlug,
+	}); err != nil {
+		return fmt.Errorf("lưu lịch sử slug danh mục sản phẩm thất bại: %w", err)
+	}
+
+	return nil
+}
+
+func (s *productServiceImpl) getCache(ctx context.Context, key string, dest any) bool {
+	found, err := s.cache.Get(ctx, key, dest)
+	if err != nil {
+		log.Printf("đọc cache %s thất bại: %v", key, err)
+		return false
+	}
+
+	return found
+}
+
+func (s *productServiceImpl) setCache(ctx context.Context, key string, value any) {
+	ttl := s.cfg.Cache.TTL
+	if ttl <= 0 {
+		ttl = 10 * time.Minute
+	}
+
+	if err := s.cache.Set(ctx, key, value, ttl); err != nil {
+		log.Printf("ghi cache %s thất bại: %v", key, err)
+	}
+}
+
+func (s *productServiceImpl) invalidateCache(ctx context.Context, keys ...string) {
+	if err := s.cache.Delete(ctx, keys...); err != nil {
+		log.Printf("xóa cache %v thất bại: %v", keys, err)
+	}
+}
+
+func (s *productServiceImpl) invalidateCategoryCache(ctx context.Context) {
+	cache.InvalidateCategoryCache(ctx, s.cache)
+}
+
+func (s *productServiceImpl) validateFeaturedProducts(ctx context.Context, productIDs []string) error {
+	if len(productIDs) == 0 {
+		return nil
+	}
+
+	products, err := s.productRepo.FindAllByID(ctx, productIDs)
+	if err != nil {
+		return fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
+	}
+	if len(products) != len(productIDs) {
+		return missingIDsError(common.ErrHasProductNotFound, productIDs, getIDsFromProducts(products))
+	}
+
+	return nil
+}
+
+func (s *productServiceImpl) buildCategoryBreadcrumbs(ctx context.Context, categories ...*model.Category) (map[string][]*model.Category, error) {
+	categoryIDs := getIDsFromCategories(categories)
+	if len(categoryIDs) == 0 {
+		return map[string][]*model.Category{}, nil
+	}
+
+	ancestorIDs, err := s.categoryRepo.GetAllAncestors(ctx, categoryIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("lấy danh mục tổ tiên thất bại: %w", err)
+	}
+
+	related, err := s.categoryRepo.FindAllByIDWithParents(ctx, append(ancestorIDs, categoryIDs...))
+	if err != nil {
+		return nil, fmt.Errorf("tìm kiếm danh mục sản phẩm thất bại: %w", err)
+	}
+
+	catMap := make(map[string]*model.Category, len(related))
+	for _, c := range related {
+		catMap[c.ID] = c
+	}
+
+	edges, err := s.categoryRepo.FindAllParentEdgesByChildID(ctx, getIDsFromCategories(related))
+	if err != nil {
+		return nil, fmt.Errorf("lấy liên kết danh mục cha thất bại: %w", err)
+	}
+	sortOrders := make(map[[2]string]int, len(edges))
+	for _, edge := range edges {
+		sortOrders[[2]string{edge.ChildID, edge.ParentID}] = edge.SortOrder
+	}
+
+	paths := make(map[string][]*model.Category, len(categories))
+	for _, category := range categories {
+		breadcrumbs := []*model.Category{category}
+		visited := map[string]struct{}{category.ID: {}}
+		current, ok := catMap[category.ID]
+		for ok {
+			var next *model.Category
+			for _, p := range current.Parents {
+				parent, exists := catMap[p.ID]
+				if !exists || !parent.IsVisible {
+					continue
+				}
+				if _, seen := visited[parent.ID]; seen {
+					continue
+				}
+				if next == nil || isPreferredBreadcrumbParent(parent, next, sortOrders[[2]string{current.ID, parent.ID}], sortOrders[[2]string{current.ID, next.ID}]) {
+					next = parent
+				}
+			}
+			if next == nil {
+				break
+			}
+
+			visited[next.ID] = struct{}{}
+			breadcrumbs = append(breadcrumbs, next)
+			current = next
+		}
+
+		slices.Reverse(breadcrumbs)
+		paths[category.ID] = breadcrumbs
+	}
+
+	return paths, nil
+}
+
+func (s *productServiceImpl) buildProductBreadcrumbs(ctx context.Context, products ...*model.Product) error {
+	productCategories := make([][]*model.Category, len(products))
+	var categories []*model.Category
+	for i, product := range products {
+		for _, c := range product.Categories {
+			if !c.IsVisible {
+				continue
+			}
+			if product.PrimaryCategoryID != nil && c.ID == *product.PrimaryCategoryID {
+				productCategories[i] = append([]*model.Category{c}, productCategories[i]...)
+				product.PrimaryCategory = c
+			} else {
+				productCategories[i] = append(productCategories[i], c)
+			}
+		}
+		categories = append(categories, productCategories[i]...)
+	}
+
+	paths, err := s.buildCategoryBreadcrumbs(ctx, categories...)
+	if err != nil {
+		return err
+	}
+
+	for i, product := range products {
+		product.Breadcrumbs = make([][]*model.Category, 0, len(productCategories[i]))
+		for _, c := range productCategories[i] {
+			product.Breadcrumbs = append(product.Breadcrumbs, paths[c.ID])
+		}
+	}
+
+	return nil
+}
+
+func (s *productServiceImpl) publishCategoryBannerUpload(ctx context.Context, category *model.Category, banner *productpb.CategoryBannerRequest, userID, oldBannerFileID string) {
+	ext := strings.ToLower(filepath.Ext(banner.FileName))
+	if ext == "" {
+		ext = ".jpg"
+	}
+
+	uploadFileRequest := &common.Base64UploadRequest{
+		CategoryID:  category.ID,
+		Base64Data:  banner.Base64Data,
+		FileName:    fmt.Sprintf("%s-banner%s", category.Slug, ext),
+		Folder:      s.cfg.ImageKit.Folder,
+		UserID:      userID,
+		TotalImages: 1,
+	}
+
+	go func() {
+		body, _ := sonic.Marshal(uploadFileRequest)
+		if err := mq.PublishMessage(ctx, s.publisher, common.UploadTopic, body); err != nil {
+			log.Printf("publish upload image msg thất bại: %v", err)
+			return
+		}
+
+		if strings.TrimSpace(oldBannerFileID) != "" {
+			if err := mq.PublishMessage(ctx, s.publisher, common.DeleteTopic, []byte(oldBannerFileID)); err != nil {
+				log.Printf("publish delete image msg thất bại: %v", err)
+			}
+		}
+	}()
+}
+
+func (s *productServiceImpl) validateParentRelations(ctx context.Context, parentIDs []string) error {
+	if len(parentIDs) <= 1 {
+		return nil
+	}
+
+	idSet := make(map[string]struct{}, len(parentIDs))
+	for _, id := range parentIDs {
+		idSet[id] = struct{}{}
+	}
+
+	for _, id := range parentIDs {
+		ancestors, err := s.categoryRepo.GetAllAncestors(ctx, id)
+		if err != nil {
+			return fmt.Errorf("xác thực mối quan hệ thất bại: %w", err)
+		}
+		for _, anc := range ancestors {
+			if _, ok := idSet[anc]; ok {
+				return fmt.Errorf("ID %s và %s có mối quan hệ", anc, id)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *productServiceImpl) validateNoCycleWithCategory(ctx context.Context, categoryID string, parentIDs []string) error {
+	if len(parentIDs) == 0 {
+		return nil
+	}
+
+	descendants, err := s.categoryRepo.GetAllDescendants(ctx, categoryID)
+	if err != nil {
+		return fmt.Errorf("xác thực chu kỳ danh mục thất bại: %w", err)
+	}
+	descSet := make(map[string]struct{}, len(descendants))
+	for _, d := range descendants {
+		descSet[d] = struct{}{}
+	}
+
+	ancestors, err := s.categoryRepo.GetAllAncestors(ctx, categoryID)
+	if err != nil {
+		return fmt.Errorf("xác thực chu kỳ danh mục thất bại: %w", err)
+	}
+	ancSet := make(map[string]struct{}, len(ancestors))
+	for _, a := range ancestors {
+		ancSet[a] = struct{}{}
+	}
+
+	for _, pid := range parentIDs {
+		if _, ok := descSet[pid]; ok {
+			return fmt.Errorf("ID cha %s không hợp lệ có thể tạo ra chu kỳ", pid)
+		}
+		if _, ok := ancSet[pid]; ok {
+			return fmt.Errorf("ID cha %s không hợp lệ nó đã có mối quan hệ với danh mục được gán", pid)
+		}
+	}
+
+	return nil
+}
+
+func resolvePrimaryCategoryID(requested, current *string, categoryIDs []string) (*string, error) {
+	if requested != nil {
+		if !slices.Contains(categoryIDs, *requested) {
+			return nil, common.ErrInvalidPrimaryCategory
+		}
+		return requested, nil
+	}
+
+	if current != nil && slices.Contains(categoryIDs, *current) {
+		return current, nil
+	}
+	if len(categoryIDs) > 0 {
+		return &categoryIDs[0], nil
+	}
+
+	return nil, nil
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func equalTimePtr(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func hasAnyKey(data map[string]any, keys ...string) bool {
+	for _, key := range keys {
+		if _, ok := data[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func parseOptionalDateTime(str *string) (*time.Time, error) {
+	if str == nil || *str == "" {
+		return nil, nil
+	}
+
+	parsedTime, err := common.ParseDateTime(*str)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsedTime, nil
+}
+
+func validateProductSchedule(status string, publishAt, unpublishAt *time.Time, now time.Time) error {
+	switch status {
+	case common.ProductStatusDraft, common.ProductStatusScheduled, common.ProductStatusPublished, common.ProductStatusArchived:
+	default:
+		return common.ErrInvalidProductStatus
+	}
+
+	if status == common.ProductStatusScheduled && (publishAt == nil || !publishAt.After(now)) {
+		return common.ErrInvalidProductSchedule
+	}
+
+	if unpublishAt != nil {
+		if publishAt != nil && !unpublishAt.After(*publishAt) {
+			return common.ErrInvalidProductSchedule
+		}
+		if (status == common.ProductStatusScheduled || status == common.ProductStatusPublished) && !unpublishAt.After(now) {
+			return common.ErrInvalidProductSchedule
+		}
+	}
+
+	return nil
+}
+
+func isValidReviewStatus(reviewStatus string) bool {
+	switch reviewStatus {
+	case common.ReviewStatusPending, common.ReviewStatusApproved, common.ReviewStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+func isValidModerationStatus(moderationStatus string) bool {
+	switch moderationStatus {
+	case common.ModerationStatusPending, common.ModerationStatusApproved, common.ModerationStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+func toRatingFilter(rating *uint32) (*int, error) {
+	if rating == nil {
+		return nil, nil
+	}
+	if *rating < 1 || *rating > 5 {
+		return nil, common.ErrInvalidRating
+	}
+
+	value := int(*rating)
+	return &value, nil
+}
+
+func toPaginationQuery(page, limit uint32, sort, order, search string) common.PaginationQuery {
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = 10
+	}
+
+	if limit > 100 {
+		limit = 100
+	}
+
+	return common.PaginationQuery{
+		Page:   int(page),
+		Limit:  int(limit),
+		Sort:   sort,
+		Order:  order,
+		Search: search,
+	}
+}
+
+func buildPaginationMeta(query common.PaginationQuery, total int64) *common.PaginationMeta {
+	totalPages := int(total) / query.Limit
+	if int(total)%query.Limit != 0 {
+		totalPages++
+	}
+
+	return &common.PaginationMeta{
+		Page:       query.Page,
+		Limit:      query.Limit,
+		Total:      total,
+		TotalPages: totalPages,
+		HasNext:    query.Page < totalPages,
+		HasPrev:    query.Page > 1,
+	}
+}
+
+func toPaginationMetaResponse(meta *common.PaginationMeta) *productpb.PaginationMetaResponse {
+	return &productpb.PaginationMetaResponse{
+		Page:       uint32(meta.Page),
+		Limit:      uint32(meta.Limit),
+		Total:      uint32(meta.Total),
+		TotalPages: uint32(meta.TotalPages),
+		HasPrev:    &meta.HasPrev,
+		HasNext:    &meta.HasNext,
+	}
 }
 
-func (s *productServiceImpl) validateParentRelations(ctx context.Context, parentIDs []string) error {
-	if len(parentIDs) <= 1 {
-		return nil
+func isStaff(user *userpb.UserPublicResponse) bool {
+	for _, role := range user.Roles {
+		if role == common.RoleAdmin || role == common.RoleStaff {
+			return true
+		}
 	}
 
-	idSet := make(map[string]struct{}, len(parentIDs))
-	for _, id := range parentIDs {
-		idSet[id] = struct{}{}
+	return false
+}
+
+func toModerationResponse(userMap map[string]*userpb.UserPublicResponse, moderatedByID *string, moderatedAt *time.Time) (*productpb.BaseUserResponse, *string) {
+	var moderatedBy *productpb.BaseUserResponse
+	if moderatedByID != nil {
+		moderatedBy = toBaseUserResponse(userMap[*moderatedByID])
 	}
 
-	for _, id := range parentIDs {
-		ancestors, err := s.categoryRepo.GetAllAncestors(ctx, id)
-		if err != nil {
-			return fmt.Errorf("xác thực mối quan hệ thất bại: %w", err)
-		}
-		for _, anc := range ancestors {
-			if _, ok := idSet[anc]; ok {
-				return fmt.Errorf("ID %s và %s có mối quan hệ", anc, id)
-			}
-		}
+	var formatted *string
+	if moderatedAt != nil {
+		value := moderatedAt.Format(time.RFC3339)
+		formatted = &value
 	}
 
-	return nil
+	return moderatedBy, formatted
 }
 
-func (s *productServiceImpl) validateNoCycleWithCategory(ctx context.Context, categoryID string, parentIDs []string) error {
-	if len(parentIDs) == 0 {
+func toBaseUserResponse(user *userpb.UserPublicResponse) *productpb.BaseUserResponse {
+	if user == nil {
 		return nil
 	}
 
-	descendants, err := s.categoryRepo.GetAllDescendants(ctx, categoryID)
-	if err != nil {
-		return fmt.Errorf("xác thực chu kỳ danh mục thất bại: %w", err)
+	userResponse := &productpb.BaseUserResponse{
+		Id:       user.Id,
+		Username: user.Username,
 	}
-	descSet := make(map[string]struct{}, len(descendants))
-	for _, d := range descendants {
-		descSet[d] = struct{}{}
+	if user.Profile != nil {
+		userResponse.Profile = &productpb.BaseProfileResponse{
+			Id:        user.Profile.Id,
+			FirstName: user.Profile.FirstName,
+			LastName:  user.Profile.LastName,
+		}
 	}
 
-	ancestors, err := s.categoryRepo.GetAllAncestors(ctx, categoryID)
-	if err != nil {
-		return fmt.Errorf("xác thực chu kỳ danh mục thất bại: %w", err)
-	}
-	ancSet := make(map[string]struct{}, len(ancestors))
-	for _, a := range ancestors {
-		ancSet[a] = struct{}{}
-	}
+	return userResponse
+}
 
-	for _, pid := range parentIDs {
-		if _, ok := descSet[pid]; ok {
-			return fmt.Errorf("ID cha %s không hợp lệ có thể tạo ra chu kỳ", pid)
-		}
-		if _, ok := ancSet[pid]; ok {
-			return fmt.Errorf("ID cha %s không hợp lệ nó đã có mối quan hệ với danh mục được gán", pid)
-		}
+func isValidRelationType(relationType string) bool {
+	switch relationType {
+	case common.RelationTypeRelated, common.RelationTypeCrossSell, common.RelationTypeUpSell, common.RelationTypeAccessory:
+		return true
+	default:
+		return false
 	}
-
-	return nil
 }
 
 func isUniqueViolation(err error) bool {
@@ -2250,6 +5516,35 @@ func isUniqueViolation(err error) bool {
 	return false
 }
 
+func uniqueViolationError(err error, fallback error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return fallback
+	}
+
+	match := uniqueViolationDetailPattern.FindStringSubmatch(pgErr.Detail)
+	if match == nil {
+		return common.NewDetailedError(fallback, map[string]string{"constraint": pgErr.ConstraintName})
+	}
+
+	metadata := map[string]string{"constraint": pgErr.ConstraintName}
+	columns := strings.Split(match[1], ", ")
+	values := strings.Split(match[2], ", ")
+	if len(columns) != len(values) {
+		values = []string{match[2]}
+		columns = []string{strings.Join(columns, ",")}
+	}
+	for i, column := range columns {
+		metadata[column] = values[i]
+	}
+
+	if _, ok := metadata["sku"]; ok && !errors.Is(fallback, common.ErrSKUAlreadyExists) && !errors.Is(fallback, common.ErrHasSKUAlreadyExists) {
+		fallback = common.ErrSKUAlreadyExists
+	}
+
+	return common.NewDetailedError(fallback, metadata)
+}
+
 func getIDsFromTags(tags []*model.Tag) []string {
 	var tagIDs []string
 	for _, tag := range tags {
@@ -2268,6 +5563,76 @@ func getIDsFromCategories(categories []*model.Category) []string {
 	return categoryIDs
 }
 
+func getIDsFromProducts(products []*model.Product) []string {
+	var productIDs []string
+	for _, product := range products {
+		productIDs = append(productIDs, product.ID)
+	}
+
+	return productIDs
+}
+
+func getIDsFromColors(colors []*model.Color) []string {
+	var colorIDs []string
+	for _, color := range colors {
+		colorIDs = append(colorIDs, color.ID)
+	}
+
+	return colorIDs
+}
+
+func getIDsFromSizes(sizes []*model.Size) []string {
+	var sizeIDs []string
+	for _, size := range sizes {
+		sizeIDs = append(sizeIDs, size.ID)
+	}
+
+	return sizeIDs
+}
+
+func getIDsFromVariants(variants []*model.Variant) []string {
+	var variantIDs []string
+	for _, variant := range variants {
+		variantIDs = append(variantIDs, variant.ID)
+	}
+
+	return variantIDs
+}
+
+func getIDsFromImages(images []*model.Image) []string {
+	var imageIDs []string
+	for _, image := range images {
+		imageIDs = append(imageIDs, image.ID)
+	}
+
+	return imageIDs
+}
+
+func getIDsFromRelations(relations []*model.ProductRelation) []string {
+	var relationIDs []string
+	for _, relation := range relations {
+		relationIDs = append(relationIDs, relation.ID)
+	}
+
+	return relationIDs
+}
+
+func missingIDsError(err error, requestedIDs, foundIDs []string) error {
+	found := make(map[string]struct{}, len(foundIDs))
+	for _, id := range foundIDs {
+		found[id] = struct{}{}
+	}
+
+	var missing []string
+	for _, id := range requestedIDs {
+		if _, ok := found[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+
+	return common.NewDetailedError(err, map[string]string{"ids": strings.Join(missing, ",")})
+}
+
 func toProductAdminDetailsResponse(product *model.Product, cRes *userpb.UserPublicResponse, uRes *userpb.UserPublicResponse) *productpb.ProductAdminDetailsResponse {
 	var startSalePtr, endSalePtr *string
 	if product.StartSale != nil {
@@ -2278,24 +5643,41 @@ func toProductAdminDetailsResponse(product *model.Product, cRes *userpb.UserPubl
 		formatted := product.EndSale.Format("2006-01-02")
 		endSalePtr = &formatted
 	}
+	var publishAtPtr, unpublishAtPtr *string
+	if product.PublishAt != nil {
+		formatted := product.PublishAt.Format(time.RFC3339)
+		publishAtPtr = &formatted
+	}
+	if product.UnpublishAt != nil {
+		formatted := product.UnpublishAt.Format(time.RFC3339)
+		unpublishAtPtr = &formatted
+	}
 
 	return &productpb.ProductAdminDetailsResponse{
-		Id:          product.ID,
-		Title:       product.Title,
-		Slug:        product.Slug,
-		Description: product.Description,
-		Price:       product.Price,
-		IsActive:    &product.IsActive,
-		IsSale:      &product.IsSale,
-		SalePrice:   product.SalePrice,
-		StartSale:   startSalePtr,
-		EndSale:     endSalePtr,
-		Categories:  toBaseCategoriesResponse(product.Categories),
-		Tags:        toBaseTagsResponse(product.Tags),
-		Variants:    toBaseVariantsResponse(product.Variants),
-		Images:      toBaseImagesResponse(product.Images),
-		CreatedAt:   product.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:   product.UpdatedAt.Format(time.RFC3339),
+		Id:                product.ID,
+		Title:             product.Title,
+		Slug:              product.Slug,
+		Description:       product.Description,
+		Price:             product.Price,
+		IsActive:          &product.IsActive,
+		IsSale:            &product.IsSale,
+		SalePrice:         product.SalePrice,
+		StartSale:         startSalePtr,
+		EndSale:           endSalePtr,
+		Categories:        toBaseCategoriesResponse(product.Categories),
+		Tags:              toBaseTagsResponse(product.Tags),
+		Variants:          toBaseVariantsResponse(product.Variants),
+		Images:            toBaseImagesResponse(product.Images),
+		IsBundle:          proto.Bool(product.IsBundle()),
+		BundleItems:       ToBaseBundleItemsResponse(product.BundleItems),
+		PrimaryCategoryId: product.PrimaryCategoryID,
+		Status:            product.Status,
+		Version:           int32(product.Version),
+		PublishAt:         publishAtPtr,
+		UnpublishAt:       unpublishAtPtr,
+		Translations:      toTranslationsResponse(product.Translations),
+		CreatedAt:         product.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         product.UpdatedAt.Format(time.RFC3339),
 		CreatedBy: &productpb.BaseUserResponse{
 			Id:       cRes.Id,
 			Username: cRes.Username,
@@ -2365,6 +5747,34 @@ func toBaseVariantsResponse(variants []*model.Variant) []*productpb.BaseVariantR
 	return variantResponses
 }
 
+func ToBaseBundleItemsResponse(items []*model.BundleItem) []*productpb.BaseBundleItemResponse {
+	var itemResponses []*productpb.BaseBundleItemResponse
+	for _, item := range items {
+		var product *productpb.BaseProductResponse
+		if item.Variant != nil && item.Variant.Product != nil {
+			product = &productpb.BaseProductResponse{
+				Id:    item.Variant.Product.ID,
+				Title: item.Variant.Product.Title,
+				Slug:  item.Variant.Product.Slug,
+			}
+		}
+
+		var variant *productpb.BaseVariantResponse
+		if item.Variant != nil && item.Variant.Inventory != nil {
+			variant = toBaseVariantsResponse([]*model.Variant{item.Variant})[0]
+		}
+
+		itemResponses = append(itemResponses, &productpb.BaseBundleItemResponse{
+			Id:       item.ID,
+			Quantity: int32(item.Quantity),
+			Variant:  variant,
+			Product:  product,
+		})
+	}
+
+	return itemResponses
+}
+
 func toBaseImagesResponse(images []*model.Image) []*productpb.BaseImageResponse {
 	var imageResponses []*productpb.BaseImageResponse
 	for _, img := range images {
@@ -2380,12 +5790,14 @@ func toBaseImagesResponse(images []*model.Image) []*productpb.BaseImageResponse
 
 func toCategoryAdminDetailsResponse(category *model.Category, productResponses []*productpb.BaseProductResponse, cRes *userpb.UserPublicResponse, uRes *userpb.UserPublicResponse) *productpb.CategoryAdminDetailsResponse {
 	return &productpb.CategoryAdminDetailsResponse{
-		Id:        category.ID,
-		Name:      category.Name,
-		Slug:      category.Slug,
-		Parents:   toBaseCategoriesResponse(category.Parents),
-		CreatedAt: category.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: category.UpdatedAt.Format(time.RFC3339),
+		Id:           category.ID,
+		Name:         category.Name,
+		Slug:         category.Slug,
+		Translations: toTranslationsResponse(category.Translations),
+		Version:      int32(category.Version),
+		Parents:      toBaseCategoriesResponse(category.Parents),
+		CreatedAt:    category.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    category.UpdatedAt.Format(time.RFC3339),
 		CreatedBy: &productpb.BaseUserResponse{
 			Id:       cRes.Id,
 			Username: cRes.Username,
@@ -2404,7 +5816,13 @@ func toCategoryAdminDetailsResponse(category *model.Category, productResponses [
 				LastName:  uRes.Profile.LastName,
 			},
 		},
-		Products: productResponses,
+		Products:         productResponses,
+		Description:      category.Description,
+		BannerUrl:        category.BannerUrl,
+		MetaTitle:        category.MetaTitle,
+		MetaDescription:  category.MetaDescription,
+		IsVisible:        category.IsVisible,
+		FeaturedProducts: toFeaturedProductsResponse(category.FeaturedProducts),
 	}
 }
 
@@ -2434,6 +5852,17 @@ func toBaseProductResponse(category *model.Category) []*productpb.BaseProductRes
 	return productResponses
 }
 
+func toFeaturedProductsResponse(featuredProducts []*model.CategoryFeaturedProduct) []*productpb.BaseProductResponse {
+	products := make([]*model.Product, 0, len(featuredProducts))
+	for _, fp := range featuredProducts {
+		if fp.Product != nil {
+			products = append(products, fp.Product)
+		}
+	}
+
+	return toBaseProductResponse(&model.Category{Products: products})
+}
+
 func toBaseCategoriesResponse(categories []*model.Category) []*productpb.BaseCategoryResponse {
 	var baseCategories []*productpb.BaseCategoryResponse
 	for _, category := range categories {
@@ -2445,3 +5874,394 @@ func toBaseCategoriesResponse(categories []*model.Category) []*productpb.BaseCat
 	}
 	return baseCategories
 }
+
+func resolveSlugMode(mode string, explicitSlug bool) (string, error) {
+	switch mode {
+	case "":
+		if explicitSlug {
+			return common.SlugModeStrict, nil
+		}
+		return common.SlugModeAutoSuffix, nil
+	case common.SlugModeStrict:
+		return mode, nil
+	case common.SlugModeAutoSuffix:
+		if explicitSlug {
+			return "", common.ErrInvalidSlugMode
+		}
+		return mode, nil
+	default:
+		return "", common.ErrInvalidSlugMode
+	}
+}
+
+func truncateSlug(slug string, maxLength int) string {
+	if maxLength <= 0 {
+		return ""
+	}
+	if len(slug) > maxLength {
+		slug = slug[:maxLength]
+	}
+
+	return strings.TrimRight(slug, "-")
+}
+
+func buildDuplicateSKU(variant *model.Variant, index int, suffix, template string) (string, error) {
+	var sku string
+	switch {
+	case template != "":
+		var colorSlug, sizeSlug string
+		if variant.Color != nil {
+			colorSlug = variant.Color.Slug
+		}
+		if variant.Size != nil {
+			sizeSlug = variant.Size.Slug
+		}
+
+		sku = strings.NewReplacer(
+			"{sku}", variant.SKU,
+			"{color}", colorSlug,
+			"{size}", sizeSlug,
+			"{index}", strconv.Itoa(index+1),
+		).Replace(template)
+	case suffix != "":
+		sku = variant.SKU + suffix
+	default:
+		return "", common.ErrInvalidSKUTemplate
+	}
+
+	if sku == "" || len(sku) > 50 {
+		return "", common.ErrInvalidSKUTemplate
+	}
+
+	return sku, nil
+}
+
+func (s *productServiceImpl) createProductRevisionTx(ctx context.Context, tx *gorm.DB, productID, userID string) (*model.ProductSnapshot, error) {
+	product, err := s.productRepo.FindByIDWithDetailsTx(ctx, tx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("tìm kiếm sản phẩm thất bại: %w", err)
+	}
+	if product == nil {
+		return nil, common.ErrProductNotFound
+	}
+
+	latest, err := s.revisionRepo.FindLatestRevisionTx(ctx, tx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("lấy phiên bản sản phẩm mới nhất thất bại: %w", err)
+	}
+
+	revision := &model.ProductRevision{
+		ID:          uuid.NewString(),
+		ProductID:   productID,
+		Revision:    latest + 1,
+		Snapshot:    model.NewProductSnapshot(product),
+		CreatedByID: userID,
+	}
+	if err = s.revisionRepo.CreateTx(ctx, tx, revision); err != nil {
+		return nil, fmt.Errorf("lưu phiên bản sản phẩm thất bại: %w", err)
+	}
+
+	return revision.Snapshot, nil
+}
+
+func buildRollbackUpdateRequest(product *model.Product, snapshot *model.ProductSnapshot, userID string, restoreLifecycle bool) *productpb.UpdateProductRequest {
+	req := &productpb.UpdateProductRequest{
+		Id:                product.ID,
+		Title:             &snapshot.Title,
+		Slug:              &snapshot.Slug,
+		SlugMode:          common.SlugModeStrict,
+		Description:       &snapshot.Description,
+		Price:             &snapshot.Price,
+		IsSale:            &snapshot.IsSale,
+		SalePrice:         snapshot.SalePrice,
+		PrimaryCategoryId: snapshot.PrimaryCategoryID,
+		CategoryIds:       snapshot.CategoryIDs,
+		TagIds:            snapshot.TagIDs,
+		UserId:            userID,
+	}
+	if restoreLifecycle {
+		publishAt := ""
+		if snapshot.PublishAt != nil {
+			publishAt = snapshot.PublishAt.Format(time.RFC3339)
+		}
+		unpublishAt := ""
+		if snapshot.UnpublishAt != nil {
+			unpublishAt = snapshot.UnpublishAt.Format(time.RFC3339)
+		}
+
+		req.Status = &snapshot.Status
+		req.PublishAt = &publishAt
+		req.UnpublishAt = &unpublishAt
+		if snapshot.StartSale != nil {
+			startSale := snapshot.StartSale.Format("2006-01-02")
+			req.StartSale = &startSale
+		}
+		if snapshot.EndSale != nil {
+			endSale := snapshot.EndSale.Format("2006-01-02")
+			req.EndSale = &endSale
+		}
+	}
+	for locale, fields := range snapshot.Translations {
+		req.Translations = append(req.Translations, &productpb.TranslationRequest{Locale: locale, Fields: fields})
+	}
+	for locale := range product.Translations {
+		if _, ok := snapshot.Translations[locale]; !ok {
+			req.Translations = append(req.Translations, &productpb.TranslationRequest{Locale: locale})
+		}
+	}
+
+	snapshotVariants := make(map[string]struct{}, len(snapshot.Variants))
+	for _, variant := range snapshot.Variants {
+		snapshotVariants[variant.ID] = struct{}{}
+		req.UpdateVariants = append(req.UpdateVariants, &productpb.UpdateVariantRequest{
+			Id:      variant.ID,
+			Sku:     &variant.SKU,
+			ColorId: &variant.ColorID,
+			SizeId:  &variant.SizeID,
+		})
+	}
+	for _, variant := range product.Variants {
+		if _, ok := snapshotVariants[variant.ID]; !ok {
+			req.DeleteVariantIds = append(req.DeleteVariantIds, variant.ID)
+		}
+	}
+
+	snapshotImages := make(map[string]struct{}, len(snapshot.Images))
+	maxSortOrder := 0
+	hasThumbnail := false
+	for _, image := range snapshot.Images {
+		snapshotImages[image.ID] = struct{}{}
+		maxSortOrder = max(maxSortOrder, image.SortOrder)
+		hasThumbnail = hasThumbnail || image.IsThumbnail
+
+		sortOrder := int32(image.SortOrder)
+		req.UpdateImages = append(req.UpdateImages, &productpb.UpdateImageRequest{
+			Id:          image.ID,
+			IsThumbnail: &image.IsThumbnail,
+			SortOrder:   &sortOrder,
+		})
+	}
+	for _, image := range product.Images {
+		if _, ok := snapshotImages[image.ID]; ok {
+			continue
+		}
+
+		maxSortOrder++
+		sortOrder := int32(maxSortOrder)
+		isThumbnail := image.IsThumbnail && !hasThumbnail
+		req.UpdateImages = append(req.UpdateImages, &productpb.UpdateImageRequest{
+			Id:          image.ID,
+			IsThumbnail: &isThumbnail,
+			SortOrder:   &sortOrder,
+		})
+	}
+
+	return req
+}
+
+func toAuditLogChangesResponse(changes map[string]*model.AuditChange) []*productpb.AuditLogChangeResponse {
+	fields := make([]string, 0, len(changes))
+	for field := range changes {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	changeResponses := make([]*productpb.AuditLogChangeResponse, 0, len(fields))
+	for _, field := range fields {
+		fromValue, _ := sonic.MarshalString(changes[field].From)
+		toValue, _ := sonic.MarshalString(changes[field].To)
+		changeResponses = append(changeResponses, &productpb.AuditLogChangeResponse{
+			Field:     field,
+			FromValue: fromValue,
+			ToValue:   toValue,
+		})
+	}
+
+	return changeResponses
+}
+
+type variantReference struct {
+	field   string
+	sku     *string
+	colorID *string
+	sizeID  *string
+}
+
+func (s *productServiceImpl) validateVariantReferences(ctx context.Context, refs []*variantReference) error {
+	var violations []*common.FieldViolation
+
+	skuFields := make(map[string]string)
+	colorIDMap := make(map[string]struct{})
+	sizeIDMap := make(map[string]struct{})
+	for _, ref := range refs {
+		if ref.sku != nil {
+			if field, ok := skuFields[*ref.sku]; ok {
+				violations = append(violations, &common.FieldViolation{
+					Field:       ref.field + ".sku",
+					Description: fmt.Sprintf("SKU %s bị trùng với %s.sku", *ref.sku, field),
+					Reason:      "DUPLICATE_SKU",
+				})
+			} else {
+				skuFields[*ref.sku] = ref.field
+			}
+		}
+		if ref.colorID != nil {
+			colorIDMap[*ref.colorID] = struct{}{}
+		}
+		if ref.sizeID != nil {
+			sizeIDMap[*ref.sizeID] = struct{}{}
+		}
+	}
+
+	foundColors := make(map[string]struct{})
+	if len(colorIDMap) > 0 {
+		colors, err := s.colorRepo.FindAllByID(ctx, slices.Collect(maps.Keys(colorIDMap)))
+		if err != nil {
+			return fmt.Errorf("tìm kiếm màu sắc thất bại: %w", err)
+		}
+		for _, color := range colors {
+			foundColors[color.ID] = struct{}{}
+		}
+	}
+
+	foundSizes := make(map[string]struct{})
+	if len(sizeIDMap) > 0 {
+		sizes, err := s.sizeRepo.FindAllByID(ctx, slices.Collect(maps.Keys(sizeIDMap)))
+		if err != nil {
+			return fmt.Errorf("tìm kiếm kích thước thất bại: %w", err)
+		}
+		for _, size := range sizes {
+			foundSizes[size.ID] = struct{}{}
+		}
+	}
+
+	for _, ref := range refs {
+		if ref.colorID != nil {
+			if _, ok := foundColors[*ref.colorID]; !ok {
+				violations = append(violations, &common.FieldViolation{
+					Field:       ref.field + ".color_id",
+					Description: fmt.Sprintf("không tìm thấy màu sắc %s", *ref.colorID),
+					Reason:      "COLOR_NOT_FOUND",
+				})
+			}
+		}
+		if ref.sizeID != nil {
+			if _, ok := foundSizes[*ref.sizeID]; !ok {
+				violations = append(violations, &common.FieldViolation{
+					Field:       ref.field + ".size_id",
+					Description: fmt.Sprintf("không tìm thấy kích thước %s", *ref.sizeID),
+					Reason:      "SIZE_NOT_FOUND",
+				})
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		return common.NewDetailedError(common.ErrInvalidRequest, nil, violations...)
+	}
+
+	return nil
+}
+
+func toTranslations(entity string, reqs []*productpb.TranslationRequest) (model.Translations, error) {
+	translations := model.Translations{}
+	var violations []*common.FieldViolation
+	for i, t := range reqs {
+		locale := common.NormalizeLocale(t.Locale)
+		if locale == common.DefaultLocale {
+			violations = append(violations, &common.FieldViolation{
+				Field:       fmt.Sprintf("translations[%d].locale", i),
+				Description: fmt.Sprintf("nội dung gốc đã là ngôn ngữ %s, không cần bản dịch", locale),
+				Reason:      "DEFAULT_LOCALE_TRANSLATION",
+			})
+			continue
+		}
+
+		fields := make(map[string]string, len(t.Fields))
+		for field, value := range t.Fields {
+			if !common.IsTranslatableField(entity, field) {
+				violations = append(violations, &common.FieldViolation{
+					Field:       fmt.Sprintf("translations[%d].fields[%s]", i, field),
+					Description: fmt.Sprintf("trường %s không hỗ trợ bản dịch", field),
+					Reason:      "UNSUPPORTED_TRANSLATION_FIELD",
+				})
+				continue
+			}
+			if value = strings.TrimSpace(value); value != "" {
+				fields[field] = value
+			}
+		}
+		translations[locale] = fields
+	}
+
+	if len(violations) > 0 {
+		return nil, common.NewDetailedError(common.ErrInvalidTranslation, nil, violations...)
+	}
+
+	return translations, nil
+}
+
+func toTranslationsResponse(translations model.Translations) []*productpb.TranslationResponse {
+	locales := slices.Sorted(maps.Keys(translations))
+
+	translationResponses := make([]*productpb.TranslationResponse, 0, len(locales))
+	for _, locale := range locales {
+		translationResponses = append(translationResponses, &productpb.TranslationResponse{
+			Locale: locale,
+			Fields: translations[locale],
+		})
+	}
+
+	return translationResponses
+}
+
+func localizeProducts(ctx context.Context, products []*model.Product) {
+	locales := common.LocalesFromContext(ctx)
+	for _, product := range products {
+		product.Localize(locales)
+	}
+}
+
+func localizeRelatedProducts(ctx context.Context, relations []*model.ProductRelation) {
+	locales := common.LocalesFromContext(ctx)
+	for _, relation := range relations {
+		if relation.RelatedProduct != nil {
+			relation.RelatedProduct.Localize(locales)
+		}
+	}
+}
+
+func localizeCategories(ctx context.Context, categories []*model.Category) {
+	locales := common.LocalesFromContext(ctx)
+	for _, category := range categories {
+		category.Localize(locales)
+	}
+}
+
+func localizeColors(ctx context.Context, colors []*model.Color) {
+	locales := common.LocalesFromContext(ctx)
+	for _, color := range colors {
+		color.Localize(locales)
+	}
+}
+
+func localizeSizes(ctx context.Context, sizes []*model.Size) {
+	locales := common.LocalesFromContext(ctx)
+	for _, size := range sizes {
+		size.Localize(locales)
+	}
+}
+
+func localizeTags(ctx context.Context, tags []*model.Tag) {
+	locales := common.LocalesFromContext(ctx)
+	for _, tag := range tags {
+		tag.Localize(locales)
+	}
+}
+
+func versionConflictError(err error, expectedVersion int32, currentVersion int) error {
+	return common.NewDetailedError(err, map[string]string{
+		"expected_version": strconv.Itoa(int(expectedVersion)),
+		"current_version":  strconv.Itoa(currentVersion),
+	})
+}
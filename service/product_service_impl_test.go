@@ -0,0 +1,430 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/SomeHowMicroservice/product/cache"
+	"github.com/SomeHowMicroservice/product/common"
+	"github.com/SomeHowMicroservice/product/config"
+	"github.com/SomeHowMicroservice/product/model"
+	productpb "github.com/SomeHowMicroservice/product/protobuf/product"
+	userpb "github.com/SomeHowMicroservice/product/protobuf/user"
+	categoryRepo "github.com/SomeHowMicroservice/product/repository/category"
+	colorRepo "github.com/SomeHowMicroservice/product/repository/color"
+	productRepo "github.com/SomeHowMicroservice/product/repository/product"
+	slugRepo "github.com/SomeHowMicroservice/product/repository/slug"
+	"google.golang.org/grpc"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var errUnexpectedQuery = errors.New("không được truy vấn database trong test")
+
+type fakeConnPool struct{}
+
+func (*fakeConnPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return nil, errUnexpectedQuery
+}
+
+func (*fakeConnPool) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return nil, errUnexpectedQuery
+}
+
+func (*fakeConnPool) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return nil, errUnexpectedQuery
+}
+
+func (*fakeConnPool) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return nil
+}
+
+func (*fakeConnPool) BeginTx(ctx context.Context, opts *sql.TxOptions) (gorm.ConnPool, error) {
+	return &fakeTx{}, nil
+}
+
+type fakeTx struct {
+	fakeConnPool
+}
+
+func (*fakeTx) Commit() error {
+	return nil
+}
+
+func (*fakeTx) Rollback() error {
+	return nil
+}
+
+type fakeCategoryRepo struct {
+	categoryRepo.CategoryRepository
+	categories   map[string]*model.Category
+	findAllCalls int
+}
+
+func (r *fakeCategoryRepo) FindAll(ctx context.Context) ([]*model.Category, error) {
+	r.findAllCalls++
+
+	categories := make([]*model.Category, 0, len(r.categories))
+	for _, category := range r.categories {
+		if !category.IsDeleted {
+			categories = append(categories, category)
+		}
+	}
+
+	return categories, nil
+}
+
+func (r *fakeCategoryRepo) FindAllParentEdges(ctx context.Context) ([]*model.CategoryParent, error) {
+	return []*model.CategoryParent{{ParentID: "root", ChildID: "child"}}, nil
+}
+
+func (r *fakeCategoryRepo) CreateTx(ctx context.Context, tx *gorm.DB, category *model.Category) error {
+	r.categories[category.ID] = category
+	return nil
+}
+
+func (r *fakeCategoryRepo) AddParentsTx(ctx context.Context, tx *gorm.DB, childID string, parentIDs []string) error {
+	return nil
+}
+
+func (r *fakeCategoryRepo) UpdateFeaturedProductsTx(ctx context.Context, tx *gorm.DB, categoryID string, productIDs []string) error {
+	return nil
+}
+
+func (r *fakeCategoryRepo) FindByIDTx(ctx context.Context, tx *gorm.DB, id string) (*model.Category, error) {
+	return r.categories[id], nil
+}
+
+func (r *fakeCategoryRepo) FindByIDWithParentsTx(ctx context.Context, tx *gorm.DB, id string) (*model.Category, error) {
+	category, ok := r.categories[id]
+	if !ok {
+		return nil, nil
+	}
+	if id == "child" {
+		category.Parents = []*model.Category{r.categories["root"]}
+	}
+
+	return category, nil
+}
+
+func (r *fakeCategoryRepo) FindByIDWithParentsAndProducts(ctx context.Context, id string) (*model.Category, error) {
+	return r.categories[id], nil
+}
+
+func (r *fakeCategoryRepo) UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error {
+	if name, ok := updateData["name"].(string); ok {
+		r.categories[id].Name = name
+	}
+
+	return nil
+}
+
+func (r *fakeCategoryRepo) UpdateByIDAndIsDeletedTx(ctx context.Context, tx *gorm.DB, id string, isDeleted bool, updateData map[string]any) error {
+	category, ok := r.categories[id]
+	if !ok || category.IsDeleted != isDeleted {
+		return common.ErrCategoryNotFound
+	}
+	category.IsDeleted = updateData["is_deleted"].(bool)
+
+	return nil
+}
+
+func (r *fakeCategoryRepo) DeleteParentTx(ctx context.Context, tx *gorm.DB, childID, parentID string) error {
+	return nil
+}
+
+func (r *fakeCategoryRepo) FindAllChildEdgesByParentIDTx(ctx context.Context, tx *gorm.DB, parentID string) ([]*model.CategoryParent, error) {
+	return []*model.CategoryParent{{ParentID: parentID, ChildID: "child"}}, nil
+}
+
+func (r *fakeCategoryRepo) UpdateChildrenSortOrderTx(ctx context.Context, tx *gorm.DB, parentID string, childIDs []string) error {
+	return nil
+}
+
+type fakeColorRepo struct {
+	colorRepo.ColorRepository
+	colors       map[string]*model.Color
+	findAllCalls int
+}
+
+func (r *fakeColorRepo) FindAll(ctx context.Context) ([]*model.Color, error) {
+	r.findAllCalls++
+
+	colors := make([]*model.Color, 0, len(r.colors))
+	for _, color := range r.colors {
+		if !color.IsDeleted {
+			colors = append(colors, color)
+		}
+	}
+
+	return colors, nil
+}
+
+func (r *fakeColorRepo) CreateTx(ctx context.Context, tx *gorm.DB, color *model.Color) error {
+	r.colors[color.ID] = color
+	return nil
+}
+
+func (r *fakeColorRepo) FindByIDTx(ctx context.Context, tx *gorm.DB, id string) (*model.Color, error) {
+	return r.colors[id], nil
+}
+
+func (r *fakeColorRepo) FindDeletedByID(ctx context.Context, id string) (*model.Color, error) {
+	if color, ok := r.colors[id]; ok && color.IsDeleted {
+		return color, nil
+	}
+
+	return nil, nil
+}
+
+func (r *fakeColorRepo) UpdateTx(ctx context.Context, tx *gorm.DB, id string, updateData map[string]any) error {
+	color, ok := r.colors[id]
+	if !ok {
+		return common.ErrColorNotFound
+	}
+	if name, ok := updateData["name"].(string); ok {
+		color.Name = name
+	}
+	if isDeleted, ok := updateData["is_deleted"].(bool); ok {
+		color.IsDeleted = isDeleted
+	}
+
+	return nil
+}
+
+type fakeSlugRepo struct {
+	slugRepo.SlugRepository
+}
+
+func (fakeSlugRepo) LockTx(ctx context.Context, tx *gorm.DB, target common.SlugTarget, stem string) error {
+	return nil
+}
+
+func (fakeSlugRepo) FindAllByPrefixTx(ctx context.Context, tx *gorm.DB, target common.SlugTarget, prefix, excludeID string) ([]string, error) {
+	return nil, nil
+}
+
+type fakeProductRepo struct {
+	productRepo.ProductRepository
+
+	products map[string]*model.Product
+}
+
+func (r *fakeProductRepo) FindByID(ctx context.Context, id string) (*model.Product, error) {
+	return r.products[id], nil
+}
+
+func (r *fakeProductRepo) FindPublishedByID(ctx context.Context, id string) (*model.Product, error) {
+	product := r.products[id]
+	if product == nil || !product.IsPublished() {
+		return nil, nil
+	}
+
+	return product, nil
+}
+
+type fakeUserClient struct {
+	userpb.UserServiceClient
+}
+
+func (fakeUserClient) GetUsersPublicById(ctx context.Context, in *userpb.GetManyRequest, opts ...grpc.CallOption) (*userpb.UsersPublicResponse, error) {
+	users := make([]*userpb.UserPublicResponse, 0, len(in.Ids))
+	for _, id := range in.Ids {
+		users = append(users, &userpb.UserPublicResponse{Id: id, Profile: &userpb.ProfileResponse{}})
+	}
+
+	return &userpb.UsersPublicResponse{Users: users}, nil
+}
+
+func newCachedTestService(t *testing.T) (*productServiceImpl, *fakeCategoryRepo, *fakeColorRepo) {
+	t.Helper()
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: &fakeConnPool{}}), &gorm.Config{DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+
+	categories := &fakeCategoryRepo{categories: map[string]*model.Category{
+		"root":    {ID: "root", Name: "Áo", Slug: "ao", IsVisible: true, Version: 1, CreatedByID: "admin", UpdatedByID: "admin"},
+		"child":   {ID: "child", Name: "Áo thun", Slug: "ao-thun", IsVisible: true, Version: 1, CreatedByID: "admin", UpdatedByID: "admin"},
+		"deleted": {ID: "deleted", Name: "Quần", Slug: "quan", IsVisible: true, IsDeleted: true, Version: 1, CreatedByID: "admin", UpdatedByID: "admin"},
+	}}
+	colors := &fakeColorRepo{colors: map[string]*model.Color{
+		"red":   {ID: "red", Name: "Đỏ", Slug: "do", CreatedByID: "admin", UpdatedByID: "admin"},
+		"black": {ID: "black", Name: "Đen", Slug: "den", IsDeleted: true, CreatedByID: "admin", UpdatedByID: "admin"},
+	}}
+
+	svc := &productServiceImpl{
+		cfg:          &config.Config{},
+		db:           db,
+		userClient:   fakeUserClient{},
+		categoryRepo: categories,
+		colorRepo:    colors,
+		slugRepo:     fakeSlugRepo{},
+		cache:        cache.NewMemoryCache(),
+	}
+
+	return svc, categories, colors
+}
+
+func TestGetCategoryTreeCache(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(ctx context.Context, svc *productServiceImpl) error
+	}{
+		{"create", func(ctx context.Context, svc *productServiceImpl) error {
+			_, err := svc.CreateCategory(ctx, &productpb.CreateCategoryRequest{Name: "Váy", UserId: "admin"})
+			return err
+		}},
+		{"update", func(ctx context.Context, svc *productServiceImpl) error {
+			_, err := svc.UpdateCategory(ctx, &productpb.UpdateCategoryRequest{Id: "root", Name: "Áo nam", Slug: "ao", UserId: "admin"})
+			return err
+		}},
+		{"delete", func(ctx context.Context, svc *productServiceImpl) error {
+			return svc.DeleteCategory(ctx, &productpb.DeleteOneRequest{Id: "child", UserId: "admin"})
+		}},
+		{"restore", func(ctx context.Context, svc *productServiceImpl) error {
+			return svc.RestoreCategory(ctx, &productpb.RestoreOneRequest{Id: "deleted", UserId: "admin"})
+		}},
+		{"move", func(ctx context.Context, svc *productServiceImpl) error {
+			fromParentID := "root"
+			return svc.MoveCategory(ctx, &productpb.MoveCategoryRequest{Id: "child", FromParentId: &fromParentID, UserId: "admin"})
+		}},
+		{"reorder", func(ctx context.Context, svc *productServiceImpl) error {
+			return svc.ReorderCategoryChildren(ctx, &productpb.ReorderCategoryChildrenRequest{ParentId: "root", ChildIds: []string{"child"}, UserId: "admin"})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc, categories, _ := newCachedTestService(t)
+
+			for range 2 {
+				roots, err := svc.GetCategoryTree(ctx)
+				if err != nil {
+					t.Fatalf("GetCategoryTree() error = %v", err)
+				}
+				if len(roots) != 1 || roots[0].ID != "root" || len(roots[0].Children) != 1 {
+					t.Fatalf("GetCategoryTree() = %+v, want root with one child", roots)
+				}
+			}
+			if categories.findAllCalls != 1 {
+				t.Fatalf("FindAll() calls = %d, want 1 when served from cache", categories.findAllCalls)
+			}
+
+			if err := tt.mutate(ctx, svc); err != nil {
+				t.Fatalf("%s error = %v", tt.name, err)
+			}
+
+			if _, err := svc.GetCategoryTree(ctx); err != nil {
+				t.Fatalf("GetCategoryTree() error = %v", err)
+			}
+			if categories.findAllCalls != 2 {
+				t.Errorf("FindAll() calls = %d, want 2 after %s invalidates the cache", categories.findAllCalls, tt.name)
+			}
+		})
+	}
+}
+
+func TestGetAllColorsCache(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(ctx context.Context, svc *productServiceImpl) error
+	}{
+		{"create", func(ctx context.Context, svc *productServiceImpl) error {
+			_, err := svc.CreateColor(ctx, &productpb.CreateColorRequest{Name: "Xanh", UserId: "admin"})
+			return err
+		}},
+		{"update", func(ctx context.Context, svc *productServiceImpl) error {
+			return svc.UpdateColor(ctx, &productpb.UpdateColorRequest{Id: "red", Name: "Đỏ tươi", UserId: "admin"})
+		}},
+		{"delete", func(ctx context.Context, svc *productServiceImpl) error {
+			return svc.DeleteColor(ctx, &productpb.DeleteOneRequest{Id: "red", UserId: "admin"})
+		}},
+		{"restore", func(ctx context.Context, svc *productServiceImpl) error {
+			return svc.RestoreColor(ctx, &productpb.RestoreOneRequest{Id: "black", UserId: "admin"})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc, _, colors := newCachedTestService(t)
+
+			for range 2 {
+				got, err := svc.GetAllColors(ctx)
+				if err != nil {
+					t.Fatalf("GetAllColors() error = %v", err)
+				}
+				if len(got) != 1 || got[0].ID != "red" {
+					t.Fatalf("GetAllColors() = %+v, want only red", got)
+				}
+			}
+			if colors.findAllCalls != 1 {
+				t.Fatalf("FindAll() calls = %d, want 1 when served from cache", colors.findAllCalls)
+			}
+
+			if err := tt.mutate(ctx, svc); err != nil {
+				t.Fatalf("%s error = %v", tt.name, err)
+			}
+
+			if _, err := svc.GetAllColors(ctx); err != nil {
+				t.Fatalf("GetAllColors() error = %v", err)
+			}
+			if colors.findAllCalls != 2 {
+				t.Errorf("FindAll() calls = %d, want 2 after %s invalidates the cache", colors.findAllCalls, tt.name)
+			}
+		})
+	}
+}
+
+func TestPublicProductLookupHidesDraft(t *testing.T) {
+	products := &fakeProductRepo{products: map[string]*model.Product{
+		"draft":  {ID: "draft", Title: "Áo nháp", Slug: "ao-nhap", Type: common.ProductTypeBundle, Status: common.ProductStatusDraft},
+		"hidden": {ID: "hidden", Title: "Áo đã xóa", Slug: "ao-da-xoa", Type: common.ProductTypeBundle, Status: common.ProductStatusPublished, IsDeleted: true},
+	}}
+	svc := &productServiceImpl{
+		cfg:         &config.Config{},
+		userClient:  fakeUserClient{},
+		productRepo: products,
+	}
+
+	tests := []struct {
+		name string
+		call func(ctx context.Context, productID string) error
+	}{
+		{"GetBundleItems", func(ctx context.Context, productID string) error {
+			_, err := svc.GetBundleItems(ctx, productID)
+			return err
+		}},
+		{"GetProductReviews", func(ctx context.Context, productID string) error {
+			_, err := svc.GetProductReviews(ctx, &productpb.GetProductReviewsRequest{ProductId: productID})
+			return err
+		}},
+		{"GetProductQuestions", func(ctx context.Context, productID string) error {
+			_, err := svc.GetProductQuestions(ctx, &productpb.GetProductQuestionsRequest{ProductId: productID})
+			return err
+		}},
+		{"CreateReview", func(ctx context.Context, productID string) error {
+			_, err := svc.CreateReview(ctx, &productpb.CreateReviewRequest{ProductId: productID, UserId: "user", Rating: 5, Content: "Đẹp"})
+			return err
+		}},
+		{"CreateQuestion", func(ctx context.Context, productID string) error {
+			_, err := svc.CreateQuestion(ctx, &productpb.CreateQuestionRequest{ProductId: productID, UserId: "user", Content: "Còn hàng không?"})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		for _, productID := range []string{"draft", "hidden"} {
+			t.Run(tt.name+"/"+productID, func(t *testing.T) {
+				if err := tt.call(context.Background(), productID); !errors.Is(err, common.ErrProductNotFound) {
+					t.Errorf("%s() error = %v, want %v", tt.name, err, common.ErrProductNotFound)
+				}
+			})
+		}
+	}
+}
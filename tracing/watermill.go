@@ -0,0 +1,71 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/SomeHowMicroservice/product/tracing"
+
+func StartPublishSpan(ctx context.Context, topic string, msg *message.Message) trace.Span {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, topic+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemRabbitMQ,
+			semconv.MessagingOperationTypePublish,
+			semconv.MessagingDestinationName(topic),
+			semconv.MessagingMessageID(msg.UUID),
+		),
+	)
+	injectMessage(ctx, msg)
+
+	return span
+}
+
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+func HandlerMiddleware(h message.HandlerFunc) message.HandlerFunc {
+	return func(msg *message.Message) ([]*message.Message, error) {
+		ctx := otel.GetTextMapPropagator().Extract(msg.Context(), propagation.MapCarrier(msg.Metadata))
+		topic := message.SubscribeTopicFromCtx(ctx)
+
+		ctx, span := otel.Tracer(tracerName).Start(ctx, topic+" process",
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(
+				semconv.MessagingSystemRabbitMQ,
+				semconv.MessagingOperationTypeProcess,
+				semconv.MessagingDestinationName(topic),
+				semconv.MessagingMessageID(msg.UUID),
+				semconv.MessagingConsumerGroupName(message.HandlerNameFromCtx(ctx)),
+			),
+		)
+		defer span.End()
+
+		msg.SetContext(ctx)
+		msgs, err := h(msg)
+		RecordError(span, err)
+
+		for _, out := range msgs {
+			injectMessage(ctx, out)
+		}
+
+		return msgs, err
+	}
+}
+
+func injectMessage(ctx context.Context, msg *message.Message) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(msg.Metadata))
+}